@@ -17,168 +17,4415 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for DocumentType.
+const (
+	BillOfSupply DocumentType = "bill_of_supply"
+	TaxInvoice   DocumentType = "tax_invoice"
+)
+
+// Defines values for EWayBillRequestTransMode.
+const (
+	Air  EWayBillRequestTransMode = "air"
+	Rail EWayBillRequestTransMode = "rail"
+	Road EWayBillRequestTransMode = "road"
+	Ship EWayBillRequestTransMode = "ship"
+)
+
+// Defines values for EWayBillRequestVehicleType.
+const (
+	OverDimensional EWayBillRequestVehicleType = "over_dimensional"
+	Regular         EWayBillRequestVehicleType = "regular"
+)
+
+// Defines values for PriceChangeField.
+const (
+	PriceChangeFieldCgstRate PriceChangeField = "cgstRate"
+	PriceChangeFieldPrice    PriceChangeField = "price"
+	PriceChangeFieldSgstRate PriceChangeField = "sgstRate"
+)
+
+// Defines values for PriceChangeSource.
+const (
+	PriceChangeSourceBulkUpdate    PriceChangeSource = "bulkUpdate"
+	PriceChangeSourceEdit          PriceChangeSource = "edit"
+	PriceChangeSourcePriceSchedule PriceChangeSource = "priceSchedule"
+	PriceChangeSourceTaxRate       PriceChangeSource = "taxRate"
+)
+
+// Defines values for ProductExportColumn.
+const (
+	ProductExportColumnActive             ProductExportColumn = "active"
+	ProductExportColumnBarcodes           ProductExportColumn = "barcodes"
+	ProductExportColumnCategory           ProductExportColumn = "category"
+	ProductExportColumnCategoryId         ProductExportColumn = "categoryId"
+	ProductExportColumnCgstRate           ProductExportColumn = "cgstRate"
+	ProductExportColumnDescription        ProductExportColumn = "description"
+	ProductExportColumnHsnCode            ProductExportColumn = "hsnCode"
+	ProductExportColumnId                 ProductExportColumn = "id"
+	ProductExportColumnName               ProductExportColumn = "name"
+	ProductExportColumnParentId           ProductExportColumn = "parentId"
+	ProductExportColumnPrice              ProductExportColumn = "price"
+	ProductExportColumnPurchaseUnit       ProductExportColumn = "purchaseUnit"
+	ProductExportColumnPurchaseUnitFactor ProductExportColumn = "purchaseUnitFactor"
+	ProductExportColumnRegularPrice       ProductExportColumn = "regularPrice"
+	ProductExportColumnSgstRate           ProductExportColumn = "sgstRate"
+	ProductExportColumnSku                ProductExportColumn = "sku"
+	ProductExportColumnStockOnHand        ProductExportColumn = "stockOnHand"
+	ProductExportColumnUnit               ProductExportColumn = "unit"
+	ProductExportColumnUpdatedAt          ProductExportColumn = "updatedAt"
+	ProductExportColumnVariantLabel       ProductExportColumn = "variantLabel"
+)
+
+// Defines values for ProductImportMode.
+const (
+	Create ProductImportMode = "create"
+	Upsert ProductImportMode = "upsert"
+)
+
+// Defines values for PromotionActionType.
+const (
+	Flat       PromotionActionType = "flat"
+	FreeItem   PromotionActionType = "free_item"
+	Percentage PromotionActionType = "percentage"
+)
+
+// Defines values for PurchaseOrderStatus.
+const (
+	Closed            PurchaseOrderStatus = "closed"
+	Draft             PurchaseOrderStatus = "draft"
+	Open              PurchaseOrderStatus = "open"
+	PartiallyReceived PurchaseOrderStatus = "partially_received"
+	Received          PurchaseOrderStatus = "received"
+)
+
+// Defines values for StockMovementReason.
+const (
+	StockMovementReasonAdjustment StockMovementReason = "adjustment"
+	StockMovementReasonPurchase   StockMovementReason = "purchase"
+	StockMovementReasonReturn     StockMovementReason = "return"
+	StockMovementReasonSale       StockMovementReason = "sale"
+	StockMovementReasonTransfer   StockMovementReason = "transfer"
+	StockMovementReasonVoid       StockMovementReason = "void"
+)
+
+// Defines values for StockMovementRequestReason.
+const (
+	StockMovementRequestReasonAdjustment StockMovementRequestReason = "adjustment"
+	StockMovementRequestReasonPurchase   StockMovementRequestReason = "purchase"
+	StockMovementRequestReasonReturn     StockMovementRequestReason = "return"
+)
+
+// Defines values for StocktakeStatus.
+const (
+	Approved  StocktakeStatus = "approved"
+	Cancelled StocktakeStatus = "cancelled"
+	Counting  StocktakeStatus = "counting"
+)
+
+// Defines values for SupplyType.
+const (
+	B2B SupplyType = "B2B"
+	B2C SupplyType = "B2C"
+)
+
+// Defines values for TransferDirection.
+const (
+	Inbound  TransferDirection = "inbound"
+	Outbound TransferDirection = "outbound"
+)
+
+// Defines values for TransferStatus.
+const (
+	TransferCancelled TransferStatus = "cancelled"
+	TransferDraft     TransferStatus = "draft"
+	TransferInTransit TransferStatus = "in_transit"
+	TransferReceived  TransferStatus = "received"
+)
+
+// Defines values for Unit.
+const (
+	G   Unit = "g"
+	Kg  Unit = "kg"
+	L   Unit = "l"
+	M   Unit = "m"
+	Ml  Unit = "ml"
+	Pcs Unit = "pcs"
+)
+
+// Defines values for ValuationMethod.
+const (
+	Fifo            ValuationMethod = "fifo"
+	WeightedAverage ValuationMethod = "weightedAverage"
+)
+
+// Defines values for Weekday.
+const (
+	Fri Weekday = "fri"
+	Mon Weekday = "mon"
+	Sat Weekday = "sat"
+	Sun Weekday = "sun"
+	Thu Weekday = "thu"
+	Tue Weekday = "tue"
+	Wed Weekday = "wed"
+)
+
+// Defines values for GetBarcodesCodeParamsFormat.
+const (
+	Png GetBarcodesCodeParamsFormat = "png"
+	Svg GetBarcodesCodeParamsFormat = "svg"
+)
+
+// Defines values for GetProductsExportParamsFormat.
+const (
+	Csv  GetProductsExportParamsFormat = "csv"
+	Json GetProductsExportParamsFormat = "json"
+	Xlsx GetProductsExportParamsFormat = "xlsx"
+)
+
+// AppliedPromotion defines model for AppliedPromotion.
+type AppliedPromotion struct {
+	Discount    *float32 `json:"discount,omitempty"`
+	Name        *string  `json:"name,omitempty"`
+	PromotionId *int     `json:"promotionId,omitempty"`
+}
+
+// BundleComponent defines model for BundleComponent.
+type BundleComponent struct {
+	Name      *string `json:"name,omitempty"`
+	ProductId int     `json:"productId"`
+
+	// Quantity Quantity of the component in one bundle, in the component's unit
+	Quantity float64 `json:"quantity"`
+
+	// StockOnHand Quantity of the component on hand
+	StockOnHand *float64 `json:"stockOnHand,omitempty"`
+
+	// Unit Unit of measure; quantities in pcs, g and ml are whole numbers, kg and l allow 3 decimals and m allows 2
+	Unit         *Unit   `json:"unit,omitempty"`
+	VariantLabel *string `json:"variantLabel,omitempty"`
+}
+
+// CMP08Report defines model for CMP08Report.
+type CMP08Report struct {
+	Bills      *int     `json:"bills,omitempty"`
+	CentralTax *float32 `json:"centralTax,omitempty"`
+
+	// CompositionRate Composition tax rate (%) applied to the turnover
+	CompositionRate *float32   `json:"compositionRate,omitempty"`
+	FinancialYear   *int       `json:"financialYear,omitempty"`
+	From            *time.Time `json:"from,omitempty"`
+	Quarter         *int       `json:"quarter,omitempty"`
+	StateTax        *float32   `json:"stateTax,omitempty"`
+	TaxPayable      *float32   `json:"taxPayable,omitempty"`
+	To              *time.Time `json:"to,omitempty"`
+
+	// Turnover Value of outward supplies billed under the composition scheme
+	Turnover *float32 `json:"turnover,omitempty"`
+}
+
+// Category defines model for Category.
+type Category struct {
+	// CgstRate Default CGST rate (%) for new products; inherited from the parent when not set
+	CgstRate *float32 `json:"cgstRate,omitempty"`
+
+	// HsnCode Default HSN code for new products; inherited from the parent when not set
+	HsnCode *string `json:"hsnCode,omitempty"`
+	Id      *int    `json:"id,omitempty"`
+	Name    string  `json:"name"`
+
+	// ParentId Parent category; top-level categories have none
+	ParentId *int `json:"parentId,omitempty"`
+
+	// Path Names from the root down, e.g. Grocery > Dairy > Milk
+	Path *string `json:"path,omitempty"`
+
+	// SgstRate Default SGST rate (%) for new products; inherited from the parent when not set
+	SgstRate *float32 `json:"sgstRate,omitempty"`
+}
+
+// Customer defines model for Customer.
+type Customer struct {
+	Address *string `json:"address,omitempty"`
+
+	// GroupId Customer group, whose price list applies unless the customer has one
+	GroupId *int `json:"groupId,omitempty"`
+
+	// Gstin 15-character GST identification number
+	Gstin     *string `json:"gstin,omitempty"`
+	Id        *int    `json:"id,omitempty"`
+	LegalName string  `json:"legalName"`
+	Phone     *string `json:"phone,omitempty"`
+
+	// PriceListId Price list the customer buys at
+	PriceListId *int    `json:"priceListId,omitempty"`
+	State       *string `json:"state,omitempty"`
+
+	// StateCode Two-digit GST state code; derived from the GSTIN when omitted
+	StateCode *string `json:"stateCode,omitempty"`
+}
+
+// CustomerGroup defines model for CustomerGroup.
+type CustomerGroup struct {
+	Id   *int   `json:"id,omitempty"`
+	Name string `json:"name"`
+
+	// PriceListId Price list the customers of the group buy at
+	PriceListId *int `json:"priceListId,omitempty"`
+}
+
+// DocumentType bill_of_supply when the sale was made under the composition scheme, otherwise tax_invoice
+type DocumentType string
+
+// EWayBill defines model for EWayBill.
+type EWayBill struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	EwbDate   *time.Time `json:"ewbDate,omitempty"`
+	EwbNo     *string    `json:"ewbNo,omitempty"`
+
+	// Payload Bulk-upload JSON accepted by the e-way bill portal
+	Payload   *map[string]interface{} `json:"payload,omitempty"`
+	SaleId    *int                    `json:"saleId,omitempty"`
+	ValidUpto *time.Time              `json:"validUpto,omitempty"`
+}
+
+// EWayBillNumber defines model for EWayBillNumber.
+type EWayBillNumber struct {
+	EwbDate   *time.Time `json:"ewbDate,omitempty"`
+	EwbNo     string     `json:"ewbNo"`
+	ValidUpto *time.Time `json:"validUpto,omitempty"`
+}
+
+// EWayBillRequest defines model for EWayBillRequest.
+type EWayBillRequest struct {
+	// ToAddress Delivery address; defaults to the billed-to address
+	ToAddress *string `json:"toAddress,omitempty"`
+	ToPincode string  `json:"toPincode"`
+	ToPlace   string  `json:"toPlace"`
+
+	// TransDistance Approximate distance in km; 0 lets the portal calculate it from the pincodes
+	TransDistance int                 `json:"transDistance"`
+	TransDocDate  *openapi_types.Date `json:"transDocDate,omitempty"`
+
+	// TransDocNo Transport document number; required for rail, air and ship
+	TransDocNo *string                  `json:"transDocNo,omitempty"`
+	TransMode  EWayBillRequestTransMode `json:"transMode"`
+
+	// TransporterId GSTIN or TRANSIN of the transporter
+	TransporterId   *string `json:"transporterId,omitempty"`
+	TransporterName *string `json:"transporterName,omitempty"`
+
+	// VehicleNo Required for road transport unless a transporter ID is given
+	VehicleNo   *string                     `json:"vehicleNo,omitempty"`
+	VehicleType *EWayBillRequestVehicleType `json:"vehicleType,omitempty"`
+}
+
+// EWayBillRequestTransMode defines model for EWayBillRequest.TransMode.
+type EWayBillRequestTransMode string
+
+// EWayBillRequestVehicleType defines model for EWayBillRequest.VehicleType.
+type EWayBillRequestVehicleType string
+
+// GoodsReceipt defines model for GoodsReceipt.
+type GoodsReceipt struct {
+	CgstTotal *float32 `json:"cgstTotal,omitempty"`
+	CreatedBy *string  `json:"createdBy,omitempty"`
+	Id        *int     `json:"id,omitempty"`
+	IgstTotal *float32 `json:"igstTotal,omitempty"`
+
+	// Interstate The supplier is in another state, so integrated GST is charged instead of central and state GST
+	Interstate          *bool               `json:"interstate,omitempty"`
+	Items               []GoodsReceiptItem  `json:"items"`
+	Note                *string             `json:"note,omitempty"`
+	PurchaseOrderId     *int                `json:"purchaseOrderId,omitempty"`
+	ReceivedAt          *time.Time          `json:"receivedAt,omitempty"`
+	SgstTotal           *float32            `json:"sgstTotal,omitempty"`
+	Subtotal            *float32            `json:"subtotal,omitempty"`
+	SupplierId          *int                `json:"supplierId,omitempty"`
+	SupplierInvoiceDate *openapi_types.Date `json:"supplierInvoiceDate,omitempty"`
+
+	// SupplierInvoiceNo Number of the supplier's tax invoice the goods came with
+	SupplierInvoiceNo *string `json:"supplierInvoiceNo,omitempty"`
+
+	// TaxTotal Input GST available as credit
+	TaxTotal *float32 `json:"taxTotal,omitempty"`
+	Total    *float32 `json:"total,omitempty"`
+}
+
+// GoodsReceiptItem defines model for GoodsReceiptItem.
+type GoodsReceiptItem struct {
+	// BatchNo Batch the goods go into; required for batch-tracked products
+	BatchNo    *string  `json:"batchNo,omitempty"`
+	CgstAmount *float32 `json:"cgstAmount,omitempty"`
+	CgstRate   *float32 `json:"cgstRate,omitempty"`
+
+	// ExpiresOn Last day the batch may be sold; required for a new batch
+	ExpiresOn  *openapi_types.Date `json:"expiresOn,omitempty"`
+	Id         *int                `json:"id,omitempty"`
+	IgstAmount *float32            `json:"igstAmount,omitempty"`
+	IgstRate   *float32            `json:"igstRate,omitempty"`
+	LineTotal  *float32            `json:"lineTotal,omitempty"`
+
+	// Mrp Maximum retail price printed on the batch
+	Mrp                 *float32 `json:"mrp,omitempty"`
+	Name                *string  `json:"name,omitempty"`
+	ProductId           *int     `json:"productId,omitempty"`
+	PurchaseOrderItemId int      `json:"purchaseOrderItemId"`
+	Quantity            float64  `json:"quantity"`
+	SgstAmount          *float32 `json:"sgstAmount,omitempty"`
+	SgstRate            *float32 `json:"sgstRate,omitempty"`
+	Subtotal            *float32 `json:"subtotal,omitempty"`
+
+	// Unit Unit of measure; quantities in pcs, g and ml are whole numbers, kg and l allow 3 decimals and m allows 2
+	Unit *Unit `json:"unit,omitempty"`
+
+	// UnitCost Cost per unit before tax as invoiced; the order's unit cost when omitted
+	UnitCost     *float32 `json:"unitCost,omitempty"`
+	VariantLabel *string  `json:"variantLabel,omitempty"`
+}
+
+// InputTaxReport defines model for InputTaxReport.
+type InputTaxReport struct {
+	From    *time.Time           `json:"from,omitempty"`
+	Summary *[]InputTaxReportRow `json:"summary,omitempty"`
+
+	// TaxTotal Input tax credit available for the period
+	TaxTotal     *float32   `json:"taxTotal,omitempty"`
+	TaxableValue *float32   `json:"taxableValue,omitempty"`
+	To           *time.Time `json:"to,omitempty"`
+}
+
+// InputTaxReportRow defines model for InputTaxReportRow.
+type InputTaxReportRow struct {
+	CgstAmount *float32 `json:"cgstAmount,omitempty"`
+	CgstRate   *float32 `json:"cgstRate,omitempty"`
+	IgstAmount *float32 `json:"igstAmount,omitempty"`
+	IgstRate   *float32 `json:"igstRate,omitempty"`
+
+	// Receipts Goods receipt notes with lines at the rate
+	Receipts     *int     `json:"receipts,omitempty"`
+	SgstAmount   *float32 `json:"sgstAmount,omitempty"`
+	SgstRate     *float32 `json:"sgstRate,omitempty"`
+	TaxableValue *float32 `json:"taxableValue,omitempty"`
+}
+
+// LowStockItem defines model for LowStockItem.
+type LowStockItem struct {
+	CostPrice *float32 `json:"costPrice,omitempty"`
+
+	// DailySales Average quantity sold per day over the period
+	DailySales *float64 `json:"dailySales,omitempty"`
+	Name       *string  `json:"name,omitempty"`
+
+	// OnOrderQuantity Quantity outstanding on draft and placed purchase orders
+	OnOrderQuantity *float64 `json:"onOrderQuantity,omitempty"`
+	ProductId       *int     `json:"productId,omitempty"`
+	ReorderLevel    *float64 `json:"reorderLevel,omitempty"`
+	ReorderQuantity *float64 `json:"reorderQuantity,omitempty"`
+
+	// SoldQuantity Quantity sold over the period, net of voids and returns
+	SoldQuantity *float64 `json:"soldQuantity,omitempty"`
+	StockOnHand  *float64 `json:"stockOnHand,omitempty"`
+
+	// SuggestedQuantity Quantity to order to cover the period's sales above the reorder level, at least the reorder quantity; nothing when enough is on order
+	SuggestedQuantity *float64 `json:"suggestedQuantity,omitempty"`
+
+	// SupplierId Preferred supplier of the product, or the supplier it was last ordered from
+	SupplierId   *int    `json:"supplierId,omitempty"`
+	SupplierName *string `json:"supplierName,omitempty"`
+
+	// Unit Unit of measure; quantities in pcs, g and ml are whole numbers, kg and l allow 3 decimals and m allows 2
+	Unit         *Unit   `json:"unit,omitempty"`
+	VariantLabel *string `json:"variantLabel,omitempty"`
+}
+
+// LowStockPurchaseOrders defines model for LowStockPurchaseOrders.
+type LowStockPurchaseOrders struct {
+	PurchaseOrders *[]PurchaseOrder `json:"purchaseOrders,omitempty"`
+
+	// Unassigned Low-stock items with a suggested quantity but no supplier to order from
+	Unassigned *[]LowStockItem `json:"unassigned,omitempty"`
+}
+
+// LowStockReport defines model for LowStockReport.
+type LowStockReport struct {
+	AsOf *openapi_types.Date `json:"asOf,omitempty"`
+
+	// Days Days of sales the velocity is worked out over
+	Days  *int            `json:"days,omitempty"`
+	Items *[]LowStockItem `json:"items,omitempty"`
+}
+
+// NearExpiryReport defines model for NearExpiryReport.
+type NearExpiryReport struct {
+	AsOf    *openapi_types.Date `json:"asOf,omitempty"`
+	Batches *[]ProductBatch     `json:"batches,omitempty"`
+	Days    *int                `json:"days,omitempty"`
+}
+
+// PriceAdjustment Exactly one of set, percent or amount; the new price is rounded to the paisa
+type PriceAdjustment struct {
+	// Amount Amount to add to the price; negative to take it off
+	Amount *float32 `json:"amount,omitempty"`
+
+	// Percent Percentage to raise the price by; negative to lower it
+	Percent *float32 `json:"percent,omitempty"`
+
+	// Set New price
+	Set *float32 `json:"set,omitempty"`
+}
+
+// PriceChange defines model for PriceChange.
+type PriceChange struct {
+	// BulkUpdateId Bulk update the change was made in, if any
+	BulkUpdateId *int       `json:"bulkUpdateId,omitempty"`
+	ChangedAt    *time.Time `json:"changedAt,omitempty"`
+
+	// ChangedBy User named in the X-User header of the request that made the change
+	ChangedBy     *string           `json:"changedBy,omitempty"`
+	EffectiveFrom *time.Time        `json:"effectiveFrom,omitempty"`
+	Field         *PriceChangeField `json:"field,omitempty"`
+	Id            *int              `json:"id,omitempty"`
+	NewValue      *float32          `json:"newValue,omitempty"`
+	OldValue      *float32          `json:"oldValue,omitempty"`
+	ProductId     *int              `json:"productId,omitempty"`
+
+	// Source edit for product updates and imports, taxRate for scheduled tax rates, priceSchedule for scheduled prices, bulkUpdate for PATCH /products
+	Source *PriceChangeSource `json:"source,omitempty"`
+}
+
+// PriceChangeField defines model for PriceChange.Field.
+type PriceChangeField string
+
+// PriceChangeSource edit for product updates and imports, taxRate for scheduled tax rates, priceSchedule for scheduled prices, bulkUpdate for PATCH /products
+type PriceChangeSource string
+
+// PriceList defines model for PriceList.
+type PriceList struct {
+	Description *string `json:"description,omitempty"`
+	Id          *int    `json:"id,omitempty"`
+
+	// IsDefault Used when a sale selects no list, and for products missing from the selected one; only one list can be the default
+	IsDefault *bool  `json:"isDefault,omitempty"`
+	Name      string `json:"name"`
+
+	// Products Number of products with a price on the list
+	Products *int `json:"products,omitempty"`
+}
+
+// PriceListPrice defines model for PriceListPrice.
+type PriceListPrice struct {
+	Name      *string     `json:"name,omitempty"`
+	ProductId *int        `json:"productId,omitempty"`
+	Tiers     []PriceTier `json:"tiers"`
+
+	// Unit Unit of measure; quantities in pcs, g and ml are whole numbers, kg and l allow 3 decimals and m allows 2
+	Unit         *Unit   `json:"unit,omitempty"`
+	VariantLabel *string `json:"variantLabel,omitempty"`
+}
+
+// PriceSchedule defines model for PriceSchedule.
+type PriceSchedule struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	CreatedBy *string    `json:"createdBy,omitempty"`
+
+	// EndsAt When the regular price applies again; open-ended when left out
+	EndsAt    *time.Time `json:"endsAt,omitempty"`
+	Id        *int       `json:"id,omitempty"`
+	Note      *string    `json:"note,omitempty"`
+	Price     float32    `json:"price"`
+	ProductId *int       `json:"productId,omitempty"`
+	StartsAt  time.Time  `json:"startsAt"`
+}
+
+// PriceTier defines model for PriceTier.
+type PriceTier struct {
+	// MinQuantity Least quantity of the product on the sale, in its unit, that gets this price
+	MinQuantity float64 `json:"minQuantity"`
+
+	// Price Unit price before tax
+	Price float32 `json:"price"`
+}
+
 // Product defines model for Product.
 type Product struct {
+	// Active False once the product is archived
+	Active *bool `json:"active,omitempty"`
+
+	// Barcodes EAN-13, UPC-A or EAN-8 barcodes of the product; unique across products
+	Barcodes *[]string `json:"barcodes,omitempty"`
+
+	// BatchTracked Purchases must name a batch and expiry date, e.g. for medicines and food
+	BatchTracked *bool `json:"batchTracked,omitempty"`
+
+	// BundleComponents Products and quantities a bundle is made of; selling the bundle sells its components
+	BundleComponents *[]BundleComponent `json:"bundleComponents,omitempty"`
+
+	// CategoryId Category of the product; HSN code and tax rates left out are inherited from it
+	CategoryId *int `json:"categoryId,omitempty"`
+
 	// CgstRate Central GST rate (%)
-	CgstRate    *float32 `json:"cgstRate,omitempty"`
+	CgstRate *float32 `json:"cgstRate,omitempty"`
+
+	// CostPrice Cost per unit before tax on the latest goods receipt
+	CostPrice   *float32 `json:"costPrice,omitempty"`
 	Description *string  `json:"description,omitempty"`
-	Id          *int     `json:"id,omitempty"`
-	Name        *string  `json:"name,omitempty"`
-	Price       *float32 `json:"price,omitempty"`
+
+	// HsnCode Harmonized System of Nomenclature code
+	HsnCode *string         `json:"hsnCode,omitempty"`
+	Id      *int            `json:"id,omitempty"`
+	Images  *[]ProductImage `json:"images,omitempty"`
+	Name    *string         `json:"name,omitempty"`
+
+	// OptionValues Option name to value for a variant
+	OptionValues *map[string]string `json:"optionValues,omitempty"`
+
+	// ParentId Product this variant was generated from
+	ParentId *int `json:"parentId,omitempty"`
+
+	// Price Selling price, the scheduled price while one is in effect
+	Price *float32 `json:"price,omitempty"`
+
+	// PriceScheduleId Scheduled price in effect, if any
+	PriceScheduleId *int `json:"priceScheduleId,omitempty"`
+
+	// PurchaseUnit Unit the product is bought in, e.g. case
+	PurchaseUnit *string `json:"purchaseUnit,omitempty"`
+
+	// PurchaseUnitFactor Sale units in one purchase unit, e.g. 24 for a case of 24 pcs
+	PurchaseUnitFactor *float64 `json:"purchaseUnitFactor,omitempty"`
+
+	// RegularPrice Price the product sells at outside scheduled prices
+	RegularPrice *float32 `json:"regularPrice,omitempty"`
+
+	// ReorderLevel Stock on hand at or below which the product shows on the low-stock report
+	ReorderLevel *float64 `json:"reorderLevel,omitempty"`
+
+	// ReorderQuantity Least quantity to order when the product is reordered
+	ReorderQuantity *float64 `json:"reorderQuantity,omitempty"`
 
 	// SgstRate State GST rate (%)
 	SgstRate *float32 `json:"sgstRate,omitempty"`
+
+	// Sku Stock keeping unit; unique across products
+	Sku *string `json:"sku,omitempty"`
+
+	// StockOnHand Quantity on hand in the product's unit, from the stock movement ledger
+	StockOnHand *float64 `json:"stockOnHand,omitempty"`
+
+	// SupplierId Preferred supplier, whom low-stock suggestions are ordered from
+	SupplierId *int `json:"supplierId,omitempty"`
+
+	// Unit Unit of measure; quantities in pcs, g and ml are whole numbers, kg and l allow 3 decimals and m allows 2
+	Unit *Unit `json:"unit,omitempty"`
+
+	// UpdatedAt Last change to the product or its stock on hand
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+
+	// VariantLabel Option values of the variant, e.g. M / Red
+	VariantLabel *string `json:"variantLabel,omitempty"`
+
+	// VariantOptions Options a parent product varies by; a product with options is sold through its variants
+	VariantOptions *[]VariantOption `json:"variantOptions,omitempty"`
 }
 
-// Sale defines model for Sale.
-type Sale struct {
-	Id    *int `json:"id,omitempty"`
-	Items *[]struct {
-		CgstAmount *float32 `json:"cgstAmount,omitempty"`
-
-		// CgstRate Central GST rate (%)
-		CgstRate   *float32   `json:"cgstRate,omitempty"`
-		CgstTotal  *float32   `json:"cgstTotal,omitempty"`
-		CreatedAt  *time.Time `json:"createdAt,omitempty"`
-		GrandTotal *float32   `json:"grandTotal,omitempty"`
-		LineTotal  *float32   `json:"lineTotal,omitempty"`
-		ProductId  *int       `json:"productId,omitempty"`
-		Quantity   *int       `json:"quantity,omitempty"`
-		SgstAmount *float32   `json:"sgstAmount,omitempty"`
-
-		// SgstRate State GST rate (%)
-		SgstRate  *float32 `json:"sgstRate,omitempty"`
-		SgstTotal *float32 `json:"sgstTotal,omitempty"`
-		Subtotal  *float32 `json:"subtotal,omitempty"`
-		TaxTotal  *float32 `json:"taxTotal,omitempty"`
-		UnitPrice *float32 `json:"unitPrice,omitempty"`
-	} `json:"items,omitempty"`
+// ProductBatch defines model for ProductBatch.
+type ProductBatch struct {
+	BatchNo *string `json:"batchNo,omitempty"`
+
+	// DaysToExpiry Days left to sell the batch; negative once it has expired
+	DaysToExpiry *int                `json:"daysToExpiry,omitempty"`
+	ExpiresOn    *openapi_types.Date `json:"expiresOn,omitempty"`
+	Id           *int                `json:"id,omitempty"`
+	Mrp          *float32            `json:"mrp,omitempty"`
+
+	// Name Product name
+	Name      *string `json:"name,omitempty"`
+	ProductId *int    `json:"productId,omitempty"`
+
+	// Quantity Quantity of the batch on hand
+	Quantity *float64 `json:"quantity,omitempty"`
+
+	// ReceivedAt When the batch was first received
+	ReceivedAt *time.Time `json:"receivedAt,omitempty"`
+
+	// Unit Unit of measure; quantities in pcs, g and ml are whole numbers, kg and l allow 3 decimals and m allows 2
+	Unit         *Unit   `json:"unit,omitempty"`
+	VariantLabel *string `json:"variantLabel,omitempty"`
 }
 
-// Settings defines model for Settings.
-type Settings struct {
-	Address        *string  `json:"address,omitempty"`
-	BusinessName   *string  `json:"businessName,omitempty"`
-	DefaultTaxRate *float32 `json:"defaultTaxRate,omitempty"`
-	Email          *string  `json:"email,omitempty"`
-	Phone          *string  `json:"phone,omitempty"`
+// ProductBulkChanges Changes to apply; fields left out are not changed
+type ProductBulkChanges struct {
+	// CategoryId Category to move the products to
+	CategoryId *int     `json:"categoryId,omitempty"`
+	CgstRate   *float32 `json:"cgstRate,omitempty"`
+
+	// Price Exactly one of set, percent or amount; the new price is rounded to the paisa
+	Price    *PriceAdjustment `json:"price,omitempty"`
+	SgstRate *float32         `json:"sgstRate,omitempty"`
 }
 
-// PostAuthLoginJSONBody defines parameters for PostAuthLogin.
-type PostAuthLoginJSONBody struct {
-	Password *string `json:"password,omitempty"`
-	Username *string `json:"username,omitempty"`
+// ProductBulkFilter defines model for ProductBulkFilter.
+type ProductBulkFilter struct {
+	// CategoryId Products in this category or any of its descendants
+	CategoryId *int    `json:"categoryId,omitempty"`
+	HsnCode    *string `json:"hsnCode,omitempty"`
+}
+
+// ProductBulkUpdate defines model for ProductBulkUpdate.
+type ProductBulkUpdate struct {
+	ChangedAt *time.Time `json:"changedAt,omitempty"`
+
+	// ChangedBy User named in the X-User header of the request that made the update
+	ChangedBy *string `json:"changedBy,omitempty"`
+
+	// Changes Changes to apply; fields left out are not changed
+	Changes ProductBulkChanges `json:"changes"`
+	DryRun  *bool              `json:"dryRun,omitempty"`
+	Filter  *ProductBulkFilter `json:"filter,omitempty"`
+	Id      *int               `json:"id,omitempty"`
+	Note    *string            `json:"note,omitempty"`
+
+	// ProductIds Products to update; combined with the filter when both are given
+	ProductIds *[]int                   `json:"productIds,omitempty"`
+	Products   *[]ProductBulkUpdateItem `json:"products,omitempty"`
+
+	// Updated Products whose price, rates or category changed, or would change in a dry run
+	Updated *int `json:"updated,omitempty"`
+}
+
+// ProductBulkUpdateItem defines model for ProductBulkUpdateItem.
+type ProductBulkUpdateItem struct {
+	Name          *string  `json:"name,omitempty"`
+	NewCategoryId *int     `json:"newCategoryId,omitempty"`
+	NewCgstRate   *float32 `json:"newCgstRate,omitempty"`
+	NewPrice      *float32 `json:"newPrice,omitempty"`
+	NewSgstRate   *float32 `json:"newSgstRate,omitempty"`
+	OldCategoryId *int     `json:"oldCategoryId,omitempty"`
+	OldCgstRate   *float32 `json:"oldCgstRate,omitempty"`
+	OldPrice      *float32 `json:"oldPrice,omitempty"`
+	OldSgstRate   *float32 `json:"oldSgstRate,omitempty"`
+	ProductId     *int     `json:"productId,omitempty"`
+	VariantLabel  *string  `json:"variantLabel,omitempty"`
+}
+
+// ProductExportColumn category is the category path, e.g. Groceries > Rice; barcodes are separated by spaces
+type ProductExportColumn string
+
+// ProductImage defines model for ProductImage.
+type ProductImage struct {
+	ContentType *string    `json:"contentType,omitempty"`
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+	Height      *int       `json:"height,omitempty"`
+	Id          *int       `json:"id,omitempty"`
+	ProductId   *int       `json:"productId,omitempty"`
+
+	// Size Size of the image file in bytes
+	Size         *int64  `json:"size,omitempty"`
+	ThumbnailUrl *string `json:"thumbnailUrl,omitempty"`
+	Url          *string `json:"url,omitempty"`
+	Width        *int    `json:"width,omitempty"`
+}
+
+// ProductImportError defines model for ProductImportError.
+type ProductImportError struct {
+	// Column Column header of the offending cell, if the error is about one cell
+	Column  *string `json:"column,omitempty"`
+	Message *string `json:"message,omitempty"`
+
+	// Row Row number in the file, the header being row 1
+	Row *int `json:"row,omitempty"`
+}
+
+// ProductImportMode create adds every row as a new product; upsert updates the product whose SKU the row carries
+type ProductImportMode string
+
+// ProductImportReport defines model for ProductImportReport.
+type ProductImportReport struct {
+	// Created Products created, or that would be created in a dry run
+	Created *int                  `json:"created,omitempty"`
+	DryRun  *bool                 `json:"dryRun,omitempty"`
+	Errors  *[]ProductImportError `json:"errors,omitempty"`
+
+	// Mode create adds every row as a new product; upsert updates the product whose SKU the row carries
+	Mode *ProductImportMode `json:"mode,omitempty"`
+
+	// Rows Data rows read, not counting the header and blank rows
+	Rows *int `json:"rows,omitempty"`
+
+	// Updated Products updated, or that would be updated in a dry run
+	Updated *int `json:"updated,omitempty"`
+}
+
+// ProductSearchResult defines model for ProductSearchResult.
+type ProductSearchResult struct {
+	// Highlight Product fields with the matching words wrapped in <mark> tags; the description is cut down to a snippet
+	Highlight *SearchHighlight `json:"highlight,omitempty"`
+	Product   *Product         `json:"product,omitempty"`
+}
+
+// ProductSearchResults defines model for ProductSearchResults.
+type ProductSearchResults struct {
+	// Fuzzy Nothing matched as typed, so the results allow for typos
+	Fuzzy   *bool                  `json:"fuzzy,omitempty"`
+	Limit   *int                   `json:"limit,omitempty"`
+	Offset  *int                   `json:"offset,omitempty"`
+	Query   *string                `json:"query,omitempty"`
+	Results *[]ProductSearchResult `json:"results,omitempty"`
+
+	// Total Number of matching products across all pages
+	Total *int `json:"total,omitempty"`
 }
 
-// PostAuthRegisterJSONBody defines parameters for PostAuthRegister.
-type PostAuthRegisterJSONBody struct {
-	Password *string `json:"password,omitempty"`
-	Username *string `json:"username,omitempty"`
+// Promotion defines model for Promotion.
+type Promotion struct {
+	Action PromotionAction `json:"action"`
+	Active *bool           `json:"active,omitempty"`
+
+	// Conditions All conditions given must hold. Lines qualify when their product, the product it is a variant of, or the category or a parent category of their product is listed; every line qualifies when neither products nor categories are. Lines sold as part of a bundle never do.
+	Conditions  *PromotionConditions `json:"conditions,omitempty"`
+	CreatedAt   *time.Time           `json:"createdAt,omitempty"`
+	Description *string              `json:"description,omitempty"`
+
+	// EndsAt Open-ended when left out
+	EndsAt *time.Time `json:"endsAt,omitempty"`
+	Id     *int       `json:"id,omitempty"`
+	Name   string     `json:"name"`
+
+	// Priority Promotions are applied highest priority first, each to what the ones before left of the line
+	Priority *int `json:"priority,omitempty"`
+
+	// Stackable Whether the promotion combines with others. One that does not applies only when no other has, and no other applies after it.
+	Stackable *bool      `json:"stackable,omitempty"`
+	StartsAt  *time.Time `json:"startsAt,omitempty"`
+}
+
+// PromotionAction defines model for PromotionAction.
+type PromotionAction struct {
+	BuyQuantity  *int `json:"buyQuantity,omitempty"`
+	FreeQuantity *int `json:"freeQuantity,omitempty"`
+
+	// Type percentage takes value percent off each qualifying line; flat takes value off the qualifying lines once per sale, shared by their value; free_item gives freeQuantity of every buyQuantity plus freeQuantity qualifying items free, the cheapest first
+	Type  PromotionActionType `json:"type"`
+	Value *float32            `json:"value,omitempty"`
+}
+
+// PromotionActionType percentage takes value percent off each qualifying line; flat takes value off the qualifying lines once per sale, shared by their value; free_item gives freeQuantity of every buyQuantity plus freeQuantity qualifying items free, the cheapest first
+type PromotionActionType string
+
+// PromotionConditions All conditions given must hold. Lines qualify when their product, the product it is a variant of, or the category or a parent category of their product is listed; every line qualifies when neither products nor categories are. Lines sold as part of a bundle never do.
+type PromotionConditions struct {
+	CategoryIds *[]int     `json:"categoryIds,omitempty"`
+	DaysOfWeek  *[]Weekday `json:"daysOfWeek,omitempty"`
+
+	// EndTime Local time of day until which the promotion applies; before startTime for a window past midnight
+	EndTime *string `json:"endTime,omitempty"`
+
+	// MinBasketTotal Value of the whole sale, before promotions, the sale must reach
+	MinBasketTotal *float32 `json:"minBasketTotal,omitempty"`
+
+	// MinQuantity Quantity of qualifying items the sale must reach
+	MinQuantity *float64 `json:"minQuantity,omitempty"`
+	ProductIds  *[]int   `json:"productIds,omitempty"`
+
+	// StartTime Local time of day from which the promotion applies
+	StartTime *string `json:"startTime,omitempty"`
+}
+
+// PurchaseOrder defines model for PurchaseOrder.
+type PurchaseOrder struct {
+	ClosedAt *time.Time `json:"closedAt,omitempty"`
+
+	// CreatedBy User named in the X-User header of the request that placed the order
+	CreatedBy *string `json:"createdBy,omitempty"`
+
+	// ExpectedOn Date the goods are expected
+	ExpectedOn *openapi_types.Date `json:"expectedOn,omitempty"`
+	Id         *int                `json:"id,omitempty"`
+	Items      []PurchaseOrderItem `json:"items"`
+	Note       *string             `json:"note,omitempty"`
+	OrderedAt  *time.Time          `json:"orderedAt,omitempty"`
+
+	// Status Drafts can still be edited and are not yet placed; orders that are open or partially received are outstanding; closed orders were cancelled before all goods arrived
+	Status *PurchaseOrderStatus `json:"status,omitempty"`
+
+	// Subtotal Value of the ordered quantities before tax
+	Subtotal     *float32 `json:"subtotal,omitempty"`
+	SupplierId   int      `json:"supplierId"`
+	SupplierName *string  `json:"supplierName,omitempty"`
+	TaxTotal     *float32 `json:"taxTotal,omitempty"`
+	Total        *float32 `json:"total,omitempty"`
+}
+
+// PurchaseOrderItem defines model for PurchaseOrderItem.
+type PurchaseOrderItem struct {
+	// CgstRate Central GST rate (%) the supplier charges; the product's rate when omitted
+	CgstRate *float32 `json:"cgstRate,omitempty"`
+	Id       *int     `json:"id,omitempty"`
+	Name     *string  `json:"name,omitempty"`
+
+	// OutstandingQuantity Still to be received; zero once the order is closed
+	OutstandingQuantity *float64 `json:"outstandingQuantity,omitempty"`
+	ProductId           int      `json:"productId"`
+
+	// Quantity In the product's unit, with at most as many decimals as the unit allows
+	Quantity         float64  `json:"quantity"`
+	ReceivedQuantity *float64 `json:"receivedQuantity,omitempty"`
+
+	// SgstRate State GST rate (%) the supplier charges; the product's rate when omitted
+	SgstRate *float32 `json:"sgstRate,omitempty"`
+
+	// Unit Unit of measure; quantities in pcs, g and ml are whole numbers, kg and l allow 3 decimals and m allows 2
+	Unit *Unit `json:"unit,omitempty"`
+
+	// UnitCost Agreed cost per unit before tax
+	UnitCost     float32 `json:"unitCost"`
+	VariantLabel *string `json:"variantLabel,omitempty"`
+}
+
+// PurchaseOrderStatus Drafts can still be edited and are not yet placed; orders that are open or partially received are outstanding; closed orders were cancelled before all goods arrived
+type PurchaseOrderStatus string
+
+// Sale defines model for Sale.
+type Sale struct {
+	BilledTo   *Customer `json:"billedTo,omitempty"`
+	CgstTotal  *float32  `json:"cgstTotal,omitempty"`
+	CustomerId *int      `json:"customerId,omitempty"`
+
+	// DiscountTotal Total taken off the lines by promotions
+	DiscountTotal *float32 `json:"discountTotal,omitempty"`
+
+	// DocumentType bill_of_supply when the sale was made under the composition scheme, otherwise tax_invoice
+	DocumentType *DocumentType `json:"documentType,omitempty"`
+
+	// EwayBillNo E-way bill number recorded for the consignment
+	EwayBillNo *string  `json:"ewayBillNo,omitempty"`
+	GrandTotal *float32 `json:"grandTotal,omitempty"`
+	Id         *int     `json:"id,omitempty"`
+	IgstTotal  *float32 `json:"igstTotal,omitempty"`
+
+	// Interstate The buyer is in another state, so integrated GST is charged instead of central and state GST
+	Interstate *bool       `json:"interstate,omitempty"`
+	Items      *[]SaleItem `json:"items,omitempty"`
+
+	// PriceListId Price list the sale was priced from, if any
+	PriceListId *int `json:"priceListId,omitempty"`
+
+	// Promotions Promotions applied, in the order they were applied
+	Promotions *[]AppliedPromotion `json:"promotions,omitempty"`
+	SgstTotal  *float32            `json:"sgstTotal,omitempty"`
+	SoldAt     *time.Time          `json:"soldAt,omitempty"`
+
+	// Subtotal Taxable value, after promotions
+	Subtotal *float32 `json:"subtotal,omitempty"`
+
+	// SupplyType B2B when the buyer has a GSTIN, otherwise B2C
+	SupplyType *SupplyType `json:"supplyType,omitempty"`
+	TaxTotal   *float32    `json:"taxTotal,omitempty"`
+	VoidedAt   *time.Time  `json:"voidedAt,omitempty"`
+}
+
+// SaleItem defines model for SaleItem.
+type SaleItem struct {
+	// Batches Batches the quantity was taken from, first to expire first
+	Batches *[]SaleItemBatch `json:"batches,omitempty"`
+
+	// Bundle Bundle the line is a component of; the bundle price is spread over its component lines
+	Bundle     *SaleItemBundle `json:"bundle,omitempty"`
+	CgstAmount *float32        `json:"cgstAmount,omitempty"`
+
+	// CgstRate Central GST rate (%) effective at the time of sale
+	CgstRate *float32 `json:"cgstRate,omitempty"`
+
+	// CostOfGoodsSold Cost of the goods that left stock for the line, taken at sale time under the valuation method
+	CostOfGoodsSold *float32 `json:"costOfGoodsSold,omitempty"`
+
+	// Discount Taken off the line by promotions
+	Discount   *float32 `json:"discount,omitempty"`
+	HsnCode    *string  `json:"hsnCode,omitempty"`
+	IgstAmount *float32 `json:"igstAmount,omitempty"`
+
+	// IgstRate Integrated GST rate (%), charged on an inter-state sale at the central and state rates combined
+	IgstRate   *float32 `json:"igstRate,omitempty"`
+	LineTotal  *float32 `json:"lineTotal,omitempty"`
+	Name       *string  `json:"name,omitempty"`
+	ProductId  *int     `json:"productId,omitempty"`
+	Quantity   *float64 `json:"quantity,omitempty"`
+	SgstAmount *float32 `json:"sgstAmount,omitempty"`
+
+	// SgstRate State GST rate (%) effective at the time of sale
+	SgstRate *float32 `json:"sgstRate,omitempty"`
+
+	// Subtotal Taxable value: unit price times quantity, less the discount
+	Subtotal *float32 `json:"subtotal,omitempty"`
+
+	// Unit Unit of measure; quantities in pcs, g and ml are whole numbers, kg and l allow 3 decimals and m allows 2
+	Unit      *Unit    `json:"unit,omitempty"`
+	UnitPrice *float32 `json:"unitPrice,omitempty"`
+
+	// VariantLabel Option values when the product is a variant
+	VariantLabel *string `json:"variantLabel,omitempty"`
+}
+
+// SaleItemBatch defines model for SaleItemBatch.
+type SaleItemBatch struct {
+	BatchId   *int                `json:"batchId,omitempty"`
+	BatchNo   *string             `json:"batchNo,omitempty"`
+	ExpiresOn *openapi_types.Date `json:"expiresOn,omitempty"`
+	Mrp       *float32            `json:"mrp,omitempty"`
+	Quantity  *float64            `json:"quantity,omitempty"`
+}
+
+// SaleItemBundle Bundle the line is a component of; the bundle price is spread over its component lines
+type SaleItemBundle struct {
+	// Id Identifies the bundle line within the sale
+	Id        *int     `json:"id,omitempty"`
+	Name      *string  `json:"name,omitempty"`
+	ProductId *int     `json:"productId,omitempty"`
+	Quantity  *float64 `json:"quantity,omitempty"`
+
+	// Unit Unit of measure; quantities in pcs, g and ml are whole numbers, kg and l allow 3 decimals and m allows 2
+	Unit *Unit `json:"unit,omitempty"`
+
+	// UnitPrice Bundle price before tax
+	UnitPrice *float32 `json:"unitPrice,omitempty"`
+}
+
+// SaleRequest defines model for SaleRequest.
+type SaleRequest struct {
+	// CustomerId Customer billed on the invoice; sales to a customer with a GSTIN are B2B
+	CustomerId *int `json:"customerId,omitempty"`
+	Items      []struct {
+		ProductId int `json:"productId"`
+
+		// Quantity In the product's unit, with at most as many decimals as the unit allows
+		Quantity float64 `json:"quantity"`
+	} `json:"items"`
+
+	// PriceListId Price list to sell at, e.g. staff prices; defaults to the customer's list, then their group's, then the default price list
+	PriceListId *int `json:"priceListId,omitempty"`
+}
+
+// SearchHighlight Product fields with the matching words wrapped in <mark> tags; the description is cut down to a snippet
+type SearchHighlight struct {
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Sku         *string `json:"sku,omitempty"`
+}
+
+// Settings defines model for Settings.
+type Settings struct {
+	Address *string `json:"address,omitempty"`
+
+	// AllowNegativeStock Allow sales and adjustments that take stock below zero (default true)
+	AllowNegativeStock *bool   `json:"allowNegativeStock,omitempty"`
+	BusinessName       *string `json:"businessName,omitempty"`
+	City               *string `json:"city,omitempty"`
+
+	// CompositionRate Composition tax rate (%) on turnover, split equally between central and state tax (default 1)
+	CompositionRate *float32 `json:"compositionRate,omitempty"`
+
+	// CompositionScheme Store is registered under the composition scheme; sales collect no tax and are issued as bills of supply
+	CompositionScheme *bool    `json:"compositionScheme,omitempty"`
+	DefaultTaxRate    *float32 `json:"defaultTaxRate,omitempty"`
+	Email             *string  `json:"email,omitempty"`
+
+	// EwayBillThreshold Consignment value above which an e-way bill is required (default 50000)
+	EwayBillThreshold *float32 `json:"ewayBillThreshold,omitempty"`
+
+	// Gstin GSTIN of the business
+	Gstin *string `json:"gstin,omitempty"`
+
+	// NearExpiryDays Days ahead the near-expiry report looks by default (default 30)
+	NearExpiryDays *int    `json:"nearExpiryDays,omitempty"`
+	Phone          *string `json:"phone,omitempty"`
+	Pincode        *string `json:"pincode,omitempty"`
+
+	// StateCode Two-digit GST state code; derived from the GSTIN when omitted
+	StateCode *string `json:"stateCode,omitempty"`
+
+	// ValuationMethod How goods leaving stock are costed: first in, first out, or at the moving weighted average cost (the default). A change applies to movements posted from then on
+	ValuationMethod *ValuationMethod `json:"valuationMethod,omitempty"`
+}
+
+// StockLevel defines model for StockLevel.
+type StockLevel struct {
+	LastMovementAt *time.Time `json:"lastMovementAt,omitempty"`
+	OnHand         *float64   `json:"onHand,omitempty"`
+	ProductId      *int       `json:"productId,omitempty"`
+
+	// StockValue Cost value of the stock on hand
+	StockValue *float64 `json:"stockValue,omitempty"`
+
+	// Unit Unit of measure; quantities in pcs, g and ml are whole numbers, kg and l allow 3 decimals and m allows 2
+	Unit *Unit `json:"unit,omitempty"`
+
+	// UnitCost Cost per unit of the stock on hand; the latest cost when there is none
+	UnitCost *float64 `json:"unitCost,omitempty"`
+}
+
+// StockMovement defines model for StockMovement.
+type StockMovement struct {
+	// Balance Stock on hand after the movement
+	Balance   *float64   `json:"balance,omitempty"`
+	BatchId   *int       `json:"batchId,omitempty"`
+	BatchNo   *string    `json:"batchNo,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	Id        *int       `json:"id,omitempty"`
+	Note      *string    `json:"note,omitempty"`
+	ProductId *int       `json:"productId,omitempty"`
+
+	// Quantity Signed change in stock in the product's unit; negative for goods leaving the store
+	Quantity *float64             `json:"quantity,omitempty"`
+	Reason   *StockMovementReason `json:"reason,omitempty"`
+	SaleId   *int                 `json:"saleId,omitempty"`
+
+	// StockValue Cost value of the stock on hand after the movement
+	StockValue *float64 `json:"stockValue,omitempty"`
+
+	// TransferId Transfer the goods were dispatched or received on
+	TransferId *int `json:"transferId,omitempty"`
+
+	// Unit Unit of measure; quantities in pcs, g and ml are whole numbers, kg and l allow 3 decimals and m allows 2
+	Unit *Unit `json:"unit,omitempty"`
+
+	// UnitCost Cost per unit the goods came in or went out at
+	UnitCost *float64 `json:"unitCost,omitempty"`
+}
+
+// StockMovementReason defines model for StockMovementReason.
+type StockMovementReason string
+
+// StockMovementRequest defines model for StockMovementRequest.
+type StockMovementRequest struct {
+	// BatchNo Batch the goods go into or come out of; a purchase into a new batch creates it
+	BatchNo *string `json:"batchNo,omitempty"`
+
+	// ExpiresOn Last day the batch may be sold; required for a new batch
+	ExpiresOn *openapi_types.Date `json:"expiresOn,omitempty"`
+
+	// InPurchaseUnits Quantity is counted in the product's purchase unit and converted with its purchaseUnitFactor
+	InPurchaseUnits *bool `json:"inPurchaseUnits,omitempty"`
+
+	// Mrp Maximum retail price printed on the batch
+	Mrp  *float32 `json:"mrp,omitempty"`
+	Note *string  `json:"note,omitempty"`
+
+	// Quantity Positive for purchases and returns; signed for adjustments
+	Quantity float64                    `json:"quantity"`
+	Reason   StockMovementRequestReason `json:"reason"`
+
+	// SaleId Sale the goods were returned from
+	SaleId *int `json:"saleId,omitempty"`
+
+	// UnitCost Cost per unit of goods brought in, in the unit the quantity is given in; the cost of the goods on the sale for a return against a sale, otherwise the current unit cost when left out. Not allowed when stock goes out
+	UnitCost *float64 `json:"unitCost,omitempty"`
+}
+
+// StockMovementRequestReason defines model for StockMovementRequest.Reason.
+type StockMovementRequestReason string
+
+// StockValuationItem defines model for StockValuationItem.
+type StockValuationItem struct {
+	CategoryId *int     `json:"categoryId,omitempty"`
+	Name       *string  `json:"name,omitempty"`
+	ProductId  *int     `json:"productId,omitempty"`
+	Quantity   *float64 `json:"quantity,omitempty"`
+
+	// Unit Unit of measure; quantities in pcs, g and ml are whole numbers, kg and l allow 3 decimals and m allows 2
+	Unit         *Unit    `json:"unit,omitempty"`
+	UnitCost     *float64 `json:"unitCost,omitempty"`
+	Value        *float64 `json:"value,omitempty"`
+	VariantLabel *string  `json:"variantLabel,omitempty"`
+}
+
+// StockValuationReport defines model for StockValuationReport.
+type StockValuationReport struct {
+	AsOf *openapi_types.Date `json:"asOf,omitempty"`
+
+	// InTransitValue Value at cost of the goods dispatched to other stores and not received by the end of the day; not part of totalValue
+	InTransitValue *float64 `json:"inTransitValue,omitempty"`
+
+	// Items Products with stock on hand, or short of it, at the end of the day
+	Items *[]StockValuationItem `json:"items,omitempty"`
+
+	// Method How goods leaving stock are costed: first in, first out, or at the moving weighted average cost (the default). A change applies to movements posted from then on
+	Method     *ValuationMethod `json:"method,omitempty"`
+	TotalValue *float64         `json:"totalValue,omitempty"`
+}
+
+// Stocktake defines model for Stocktake.
+type Stocktake struct {
+	// CategoryId Counts only the products in this category and its subcategories; the whole catalogue when omitted
+	CategoryId      *int             `json:"categoryId,omitempty"`
+	ClosedAt        *time.Time       `json:"closedAt,omitempty"`
+	ClosedBy        *string          `json:"closedBy,omitempty"`
+	CountedProducts *int             `json:"countedProducts,omitempty"`
+	Id              *int             `json:"id,omitempty"`
+	Items           *[]StocktakeItem `json:"items,omitempty"`
+
+	// Name Such as Q3 2026 full count
+	Name string  `json:"name"`
+	Note *string `json:"note,omitempty"`
+
+	// Products Number of products in the stocktake
+	Products *int `json:"products,omitempty"`
+
+	// Reason Reason given on approval, recorded on the adjustments
+	Reason *string `json:"reason,omitempty"`
+
+	// StartedAt When the expected quantities were frozen
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+
+	// StartedBy User named in the X-User header of the request that started the stocktake
+	StartedBy *string `json:"startedBy,omitempty"`
+
+	// Status Counts are recorded while counting; approving posts the variances and cancelling discards them
+	Status *StocktakeStatus `json:"status,omitempty"`
+
+	// VarianceValue Net value of the variances of the counted products at cost; negative for a shortage
+	VarianceValue *float32 `json:"varianceValue,omitempty"`
+}
+
+// StocktakeApproval defines model for StocktakeApproval.
+type StocktakeApproval struct {
+	// Reason Reason recorded on the adjustments, such as quarterly stocktake
+	Reason string `json:"reason"`
+}
+
+// StocktakeCount defines model for StocktakeCount.
+type StocktakeCount struct {
+	// Barcode Barcode scanned, looked up when productId is omitted
+	Barcode   *string    `json:"barcode,omitempty"`
+	CountedAt *time.Time `json:"countedAt,omitempty"`
+	CountedBy *string    `json:"countedBy,omitempty"`
+
+	// Device Scanner the count came from
+	Device *string `json:"device,omitempty"`
+	Id     *int    `json:"id,omitempty"`
+
+	// ProductId Product counted; or give its barcode
+	ProductId *int `json:"productId,omitempty"`
+
+	// Quantity In the product's unit; negative to correct an earlier count
+	Quantity float64 `json:"quantity"`
+}
+
+// StocktakeCountRequest defines model for StocktakeCountRequest.
+type StocktakeCountRequest struct {
+	Counts []StocktakeCount `json:"counts"`
+
+	// Device Name of the scanner or counter sending the counts
+	Device *string `json:"device,omitempty"`
+}
+
+// StocktakeItem defines model for StocktakeItem.
+type StocktakeItem struct {
+	// CountedQuantity Total of the counts recorded; absent until the product is counted
+	CountedQuantity *float64 `json:"countedQuantity,omitempty"`
+
+	// ExpectedQuantity Stock on hand when the stocktake started
+	ExpectedQuantity *float64 `json:"expectedQuantity,omitempty"`
+
+	// MovedSinceStart Net stock movements, such as sales, since the stocktake started, up to its approval or cancellation
+	MovedSinceStart *float64 `json:"movedSinceStart,omitempty"`
+	Name            *string  `json:"name,omitempty"`
+	ProductId       *int     `json:"productId,omitempty"`
+
+	// StockMovementId Adjustment posted for the variance on approval
+	StockMovementId *int `json:"stockMovementId,omitempty"`
+
+	// Unit Unit of measure; quantities in pcs, g and ml are whole numbers, kg and l allow 3 decimals and m allows 2
+	Unit *Unit `json:"unit,omitempty"`
+
+	// UnitCost Unit cost of the stock when the stocktake started
+	UnitCost *float32 `json:"unitCost,omitempty"`
+
+	// VarianceQuantity Counted less expected; absent until the product is counted
+	VarianceQuantity *float64 `json:"varianceQuantity,omitempty"`
+
+	// VarianceValue Variance at the unit cost
+	VarianceValue *float32 `json:"varianceValue,omitempty"`
+	VariantLabel  *string  `json:"variantLabel,omitempty"`
+}
+
+// StocktakeStatus Counts are recorded while counting; approving posts the variances and cancelling discards them
+type StocktakeStatus string
+
+// Store defines model for Store.
+type Store struct {
+	Address *string `json:"address,omitempty"`
+	City    *string `json:"city,omitempty"`
+
+	// Gstin GSTIN the store is registered under; the business's own for an outlet in the same state
+	Gstin *string `json:"gstin,omitempty"`
+	Id    *int    `json:"id,omitempty"`
+
+	// Name Such as the name of the locality the outlet is in
+	Name    string  `json:"name"`
+	Phone   *string `json:"phone,omitempty"`
+	Pincode *string `json:"pincode,omitempty"`
+	State   *string `json:"state,omitempty"`
+
+	// StateCode Two-digit GST state code; derived from the GSTIN when omitted
+	StateCode *string `json:"stateCode,omitempty"`
+}
+
+// Supplier defines model for Supplier.
+type Supplier struct {
+	Address *string `json:"address,omitempty"`
+	Email   *string `json:"email,omitempty"`
+
+	// Gstin 15-character GST identification number; needed to claim input tax credit
+	Gstin     *string `json:"gstin,omitempty"`
+	Id        *int    `json:"id,omitempty"`
+	LegalName string  `json:"legalName"`
+	Phone     *string `json:"phone,omitempty"`
+	State     *string `json:"state,omitempty"`
+
+	// StateCode Two-digit GST state code; derived from the GSTIN when omitted
+	StateCode *string `json:"stateCode,omitempty"`
+}
+
+// SupplyType B2B when the buyer has a GSTIN, otherwise B2C
+type SupplyType string
+
+// TaxRate defines model for TaxRate.
+type TaxRate struct {
+	// CgstRate Central GST rate (%)
+	CgstRate      float32   `json:"cgstRate"`
+	EffectiveFrom time.Time `json:"effectiveFrom"`
+	Id            *int      `json:"id,omitempty"`
+	ProductId     *int      `json:"productId,omitempty"`
+
+	// RateChangeId Bulk tax rate change that created this entry, if any
+	RateChangeId *int `json:"rateChangeId,omitempty"`
+
+	// SgstRate State GST rate (%)
+	SgstRate float32 `json:"sgstRate"`
+}
+
+// TaxRateChange A change to the GST rates of the products with an HSN code, in a category, or both. At least one of hsnCode and categoryId is required.
+type TaxRateChange struct {
+	AffectedProducts *int `json:"affectedProducts,omitempty"`
+
+	// CategoryId Category of the products the change applies to, including those in its subcategories
+	CategoryId *int `json:"categoryId,omitempty"`
+
+	// CgstRate Central GST rate (%)
+	CgstRate      float32    `json:"cgstRate"`
+	CreatedAt     *time.Time `json:"createdAt,omitempty"`
+	EffectiveFrom time.Time  `json:"effectiveFrom"`
+
+	// HsnCode HSN code of the products the change applies to
+	HsnCode *string `json:"hsnCode,omitempty"`
+	Id      *int    `json:"id,omitempty"`
+
+	// SgstRate State GST rate (%)
+	SgstRate float32 `json:"sgstRate"`
+}
+
+// TaxRateChangePreview defines model for TaxRateChangePreview.
+type TaxRateChangePreview struct {
+	HsnCode     *string  `json:"hsnCode,omitempty"`
+	Name        *string  `json:"name,omitempty"`
+	NewCgstRate *float32 `json:"newCgstRate,omitempty"`
+	NewSgstRate *float32 `json:"newSgstRate,omitempty"`
+	OldCgstRate *float32 `json:"oldCgstRate,omitempty"`
+	OldSgstRate *float32 `json:"oldSgstRate,omitempty"`
+	ProductId   *int     `json:"productId,omitempty"`
+}
+
+// TaxReport defines model for TaxReport.
+type TaxReport struct {
+	B2bInvoices *[]TaxReportInvoice `json:"b2bInvoices,omitempty"`
+	From        *time.Time          `json:"from,omitempty"`
+	Summary     *[]TaxReportRow     `json:"summary,omitempty"`
+	To          *time.Time          `json:"to,omitempty"`
+}
+
+// TaxReportInvoice defines model for TaxReportInvoice.
+type TaxReportInvoice struct {
+	Gstin        *string    `json:"gstin,omitempty"`
+	InvoiceValue *float32   `json:"invoiceValue,omitempty"`
+	LegalName    *string    `json:"legalName,omitempty"`
+	SaleId       *int       `json:"saleId,omitempty"`
+	SoldAt       *time.Time `json:"soldAt,omitempty"`
+	StateCode    *string    `json:"stateCode,omitempty"`
+	TaxTotal     *float32   `json:"taxTotal,omitempty"`
+	TaxableValue *float32   `json:"taxableValue,omitempty"`
+}
+
+// TaxReportRow defines model for TaxReportRow.
+type TaxReportRow struct {
+	CgstAmount *float32 `json:"cgstAmount,omitempty"`
+	CgstRate   *float32 `json:"cgstRate,omitempty"`
+	IgstAmount *float32 `json:"igstAmount,omitempty"`
+	IgstRate   *float32 `json:"igstRate,omitempty"`
+	Invoices   *int     `json:"invoices,omitempty"`
+	SgstAmount *float32 `json:"sgstAmount,omitempty"`
+	SgstRate   *float32 `json:"sgstRate,omitempty"`
+
+	// SupplyType B2B when the buyer has a GSTIN, otherwise B2C
+	SupplyType   *SupplyType `json:"supplyType,omitempty"`
+	TaxableValue *float32    `json:"taxableValue,omitempty"`
+}
+
+// Transfer defines model for Transfer.
+type Transfer struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	CreatedBy *string    `json:"createdBy,omitempty"`
+
+	// Direction Outbound transfers send goods from this store to another; inbound transfers bring goods in from another
+	Direction TransferDirection `json:"direction"`
+
+	// DiscrepancyValue Value at cost of the goods received less those dispatched; negative for a shortage
+	DiscrepancyValue *float64       `json:"discrepancyValue,omitempty"`
+	DispatchedAt     *time.Time     `json:"dispatchedAt,omitempty"`
+	DispatchedBy     *string        `json:"dispatchedBy,omitempty"`
+	Id               *int           `json:"id,omitempty"`
+	Items            []TransferItem `json:"items"`
+	Note             *string        `json:"note,omitempty"`
+
+	// ReceiptNote Note made on receipt, such as the cause of a discrepancy
+	ReceiptNote   *string    `json:"receiptNote,omitempty"`
+	ReceivedAt    *time.Time `json:"receivedAt,omitempty"`
+	ReceivedBy    *string    `json:"receivedBy,omitempty"`
+	ReceivedValue *float64   `json:"receivedValue,omitempty"`
+
+	// Reference Number of the sending store's delivery challan, for inbound transfers
+	Reference *string `json:"reference,omitempty"`
+
+	// Status Outbound transfers are drafts until dispatched; goods are in transit from dispatch, or from entry for inbound transfers, until received
+	Status *TransferStatus `json:"status,omitempty"`
+
+	// StoreId Store the goods go to, or come from for an inbound transfer
+	StoreId   int     `json:"storeId"`
+	StoreName *string `json:"storeName,omitempty"`
+
+	// Value Value of the goods dispatched at cost
+	Value *float64 `json:"value,omitempty"`
+}
+
+// TransferDirection Outbound transfers send goods from this store to another; inbound transfers bring goods in from another
+type TransferDirection string
+
+// TransferItem defines model for TransferItem.
+type TransferItem struct {
+	// Batches Batches of a batch-tracked product the goods are in: allocated when an outbound transfer is dispatched, and required from the sending store's challan for an inbound one
+	Batches *[]TransferItemBatch `json:"batches,omitempty"`
+
+	// DiscrepancyQuantity Received less dispatched; negative when goods went missing
+	DiscrepancyQuantity *float64 `json:"discrepancyQuantity,omitempty"`
+	DiscrepancyValue    *float64 `json:"discrepancyValue,omitempty"`
+	HsnCode             *string  `json:"hsnCode,omitempty"`
+	Name                *string  `json:"name,omitempty"`
+	ProductId           int      `json:"productId"`
+
+	// Quantity Quantity dispatched, in the product's unit
+	Quantity         float64  `json:"quantity"`
+	ReceivedQuantity *float64 `json:"receivedQuantity,omitempty"`
+
+	// StockMovementId Transfer movement that took the goods out of stock, or brought them in; the first of them when the goods moved in several batches
+	StockMovementId *int `json:"stockMovementId,omitempty"`
+
+	// Unit Unit of measure; quantities in pcs, g and ml are whole numbers, kg and l allow 3 decimals and m allows 2
+	Unit *Unit `json:"unit,omitempty"`
+
+	// UnitCost Cost per unit the goods moved at; taken from stock when an outbound transfer is dispatched, and required from the sending store's challan for an inbound one
+	UnitCost     *float64 `json:"unitCost,omitempty"`
+	Value        *float64 `json:"value,omitempty"`
+	VariantLabel *string  `json:"variantLabel,omitempty"`
+}
+
+// TransferItemBatch defines model for TransferItemBatch.
+type TransferItemBatch struct {
+	// BatchId Batch here the goods left, or entered on receipt
+	BatchId   *int               `json:"batchId,omitempty"`
+	BatchNo   string             `json:"batchNo"`
+	ExpiresOn openapi_types.Date `json:"expiresOn"`
+	Mrp       *float32           `json:"mrp,omitempty"`
+
+	// Quantity Quantity dispatched in the batch, in the product's unit
+	Quantity float64 `json:"quantity"`
+}
+
+// TransferReceipt defines model for TransferReceipt.
+type TransferReceipt struct {
+	// Items Lines received short or over; lines left out were received in full
+	Items *[]TransferReceiptItem `json:"items,omitempty"`
+	Note  *string                `json:"note,omitempty"`
+}
+
+// TransferReceiptItem defines model for TransferReceiptItem.
+type TransferReceiptItem struct {
+	ProductId        int     `json:"productId"`
+	ReceivedQuantity float64 `json:"receivedQuantity"`
+}
+
+// TransferStatus Outbound transfers are drafts until dispatched; goods are in transit from dispatch, or from entry for inbound transfers, until received
+type TransferStatus string
+
+// Unit Unit of measure; quantities in pcs, g and ml are whole numbers, kg and l allow 3 decimals and m allows 2
+type Unit string
+
+// ValuationMethod How goods leaving stock are costed: first in, first out, or at the moving weighted average cost (the default). A change applies to movements posted from then on
+type ValuationMethod string
+
+// VariantGeneration defines model for VariantGeneration.
+type VariantGeneration struct {
+	// Options Options in label order; values may be added to earlier options but existing variants are kept
+	Options []VariantOption `json:"options"`
+}
+
+// VariantOption defines model for VariantOption.
+type VariantOption struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// Weekday defines model for Weekday.
+type Weekday string
+
+// PostAuthLoginJSONBody defines parameters for PostAuthLogin.
+type PostAuthLoginJSONBody struct {
+	Password *string `json:"password,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+// PostAuthRegisterJSONBody defines parameters for PostAuthRegister.
+type PostAuthRegisterJSONBody struct {
+	Password *string `json:"password,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+// GetBarcodesCodeParams defines parameters for GetBarcodesCode.
+type GetBarcodesCodeParams struct {
+	Format *GetBarcodesCodeParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetBarcodesCodeParamsFormat defines parameters for GetBarcodesCode.
+type GetBarcodesCodeParamsFormat string
+
+// GetProductsParams defines parameters for GetProducts.
+type GetProductsParams struct {
+	// Name Search products by name (partial match allowed)
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// Category Only products in this category or any of its descendants
+	Category *int `form:"category,omitempty" json:"category,omitempty"`
+
+	// IncludeArchived Also list archived products; searches by name never match them
+	IncludeArchived *bool `form:"includeArchived,omitempty" json:"includeArchived,omitempty"`
+}
+
+// PatchProductsParams defines parameters for PatchProducts.
+type PatchProductsParams struct {
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// GetProductsExportParams defines parameters for GetProductsExport.
+type GetProductsExportParams struct {
+	Format *GetProductsExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Columns Columns to export, in order; defaults to the columns the import reads plus stockOnHand
+	Columns *[]ProductExportColumn `form:"columns,omitempty" json:"columns,omitempty"`
+
+	// Category Only products in this category or any of its descendants
+	Category *int `form:"category,omitempty" json:"category,omitempty"`
+
+	// UpdatedSince Only products changed, or whose stock changed, at or after this instant
+	UpdatedSince *time.Time `form:"updatedSince,omitempty" json:"updatedSince,omitempty"`
+
+	// IncludeArchived Also export archived products
+	IncludeArchived *bool `form:"includeArchived,omitempty" json:"includeArchived,omitempty"`
+}
+
+// GetProductsExportParamsFormat defines parameters for GetProductsExport.
+type GetProductsExportParamsFormat string
+
+// PostProductsImportMultipartBody defines parameters for PostProductsImport.
+type PostProductsImportMultipartBody struct {
+	// File CSV (comma, semicolon or tab separated) or XLSX file
+	File openapi_types.File `json:"file"`
+
+	// Mapping JSON object from product field to column header, e.g. {"name": "Item", "price": "MRP"}. Fields are name, description, price, cgstRate, sgstRate, hsnCode, sku, barcodes, category, unit, purchaseUnit and purchaseUnitFactor. Fields left out are read from the column whose header matches the field name, ignoring case, spaces and punctuation.
+	Mapping *string `json:"mapping,omitempty"`
+
+	// Sheet XLSX worksheet to read; defaults to the first
+	Sheet *string `json:"sheet,omitempty"`
+}
+
+// PostProductsImportParams defines parameters for PostProductsImport.
+type PostProductsImportParams struct {
+	DryRun *bool              `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+	Mode   *ProductImportMode `form:"mode,omitempty" json:"mode,omitempty"`
+}
+
+// GetProductsLookupParams defines parameters for GetProductsLookup.
+type GetProductsLookupParams struct {
+	Barcode string `form:"barcode" json:"barcode"`
+}
+
+// GetProductsSearchParams defines parameters for GetProductsSearch.
+type GetProductsSearchParams struct {
+	Q      string `form:"q" json:"q"`
+	Limit  *int   `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int   `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetProductsIdBatchesParams defines parameters for GetProductsIdBatches.
+type GetProductsIdBatchesParams struct {
+	// IncludeEmpty Also list batches with nothing left on hand
+	IncludeEmpty *bool `form:"includeEmpty,omitempty" json:"includeEmpty,omitempty"`
+}
+
+// PostProductsIdImagesMultipartBody defines parameters for PostProductsIdImages.
+type PostProductsIdImagesMultipartBody struct {
+	Image openapi_types.File `json:"image"`
+}
+
+// GetPurchaseOrdersParams defines parameters for GetPurchaseOrders.
+type GetPurchaseOrdersParams struct {
+	Status *PurchaseOrderStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetReportsCmp08Params defines parameters for GetReportsCmp08.
+type GetReportsCmp08Params struct {
+	// FinancialYear First calendar year of the financial year, e.g. 2025 for 2025-26
+	FinancialYear int `form:"financialYear" json:"financialYear"`
+
+	// Quarter Quarter of the financial year; 1 is April to June
+	Quarter int `form:"quarter" json:"quarter"`
+}
+
+// GetReportsInputTaxParams defines parameters for GetReportsInputTax.
+type GetReportsInputTaxParams struct {
+	// From Include goods received at or after this instant
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Include goods received before this instant
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetReportsLowStockParams defines parameters for GetReportsLowStock.
+type GetReportsLowStockParams struct {
+	// Days Days of sales the velocity is worked out over and the suggested quantity covers; 30 when left out
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+}
+
+// PostReportsLowStockPurchaseOrdersParams defines parameters for PostReportsLowStockPurchaseOrders.
+type PostReportsLowStockPurchaseOrdersParams struct {
+	// Days Days of sales the suggested quantities cover; 30 when left out
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+}
+
+// GetReportsNearExpiryParams defines parameters for GetReportsNearExpiry.
+type GetReportsNearExpiryParams struct {
+	// Days Days ahead to look; the nearExpiryDays setting when left out
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+}
+
+// GetReportsStockValuationParams defines parameters for GetReportsStockValuation.
+type GetReportsStockValuationParams struct {
+	// AsOf Value the stock as it stood at the end of this day; today when left out
+	AsOf *openapi_types.Date `form:"asOf,omitempty" json:"asOf,omitempty"`
+
+	// CategoryId Only products in this category or any of its descendants
+	CategoryId *int `form:"categoryId,omitempty" json:"categoryId,omitempty"`
+}
+
+// GetReportsTaxParams defines parameters for GetReportsTax.
+type GetReportsTaxParams struct {
+	// From Include sales at or after this instant
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Include sales before this instant
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// PutSalesIdJSONBody defines parameters for PutSalesId.
+type PutSalesIdJSONBody struct {
+	Items *[]struct {
+		ProductId *int     `json:"productId,omitempty"`
+		Quantity  *float64 `json:"quantity,omitempty"`
+	} `json:"items,omitempty"`
+}
+
+// GetStocktakesParams defines parameters for GetStocktakes.
+type GetStocktakesParams struct {
+	Status *StocktakeStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetSuppliersIdPurchaseOrdersParams defines parameters for GetSuppliersIdPurchaseOrders.
+type GetSuppliersIdPurchaseOrdersParams struct {
+	// Outstanding Only orders still awaiting goods, open or partially received
+	Outstanding *bool `form:"outstanding,omitempty" json:"outstanding,omitempty"`
+}
+
+// GetTransfersParams defines parameters for GetTransfers.
+type GetTransfersParams struct {
+	Status    *TransferStatus    `form:"status,omitempty" json:"status,omitempty"`
+	Direction *TransferDirection `form:"direction,omitempty" json:"direction,omitempty"`
+	StoreId   *int               `form:"storeId,omitempty" json:"storeId,omitempty"`
+}
+
+// PostAuthLoginJSONRequestBody defines body for PostAuthLogin for application/json ContentType.
+type PostAuthLoginJSONRequestBody PostAuthLoginJSONBody
+
+// PostAuthRegisterJSONRequestBody defines body for PostAuthRegister for application/json ContentType.
+type PostAuthRegisterJSONRequestBody PostAuthRegisterJSONBody
+
+// PostCategoriesJSONRequestBody defines body for PostCategories for application/json ContentType.
+type PostCategoriesJSONRequestBody = Category
+
+// PutCategoriesIdJSONRequestBody defines body for PutCategoriesId for application/json ContentType.
+type PutCategoriesIdJSONRequestBody = Category
+
+// PostCustomerGroupsJSONRequestBody defines body for PostCustomerGroups for application/json ContentType.
+type PostCustomerGroupsJSONRequestBody = CustomerGroup
+
+// PutCustomerGroupsIdJSONRequestBody defines body for PutCustomerGroupsId for application/json ContentType.
+type PutCustomerGroupsIdJSONRequestBody = CustomerGroup
+
+// PostCustomersJSONRequestBody defines body for PostCustomers for application/json ContentType.
+type PostCustomersJSONRequestBody = Customer
+
+// PutCustomersIdJSONRequestBody defines body for PutCustomersId for application/json ContentType.
+type PutCustomersIdJSONRequestBody = Customer
+
+// PostPriceListsJSONRequestBody defines body for PostPriceLists for application/json ContentType.
+type PostPriceListsJSONRequestBody = PriceList
+
+// PutPriceListsIdJSONRequestBody defines body for PutPriceListsId for application/json ContentType.
+type PutPriceListsIdJSONRequestBody = PriceList
+
+// PutPriceListsIdPricesProductIdJSONRequestBody defines body for PutPriceListsIdPricesProductId for application/json ContentType.
+type PutPriceListsIdPricesProductIdJSONRequestBody = PriceListPrice
+
+// PatchProductsJSONRequestBody defines body for PatchProducts for application/json ContentType.
+type PatchProductsJSONRequestBody = ProductBulkUpdate
+
+// PostProductsJSONRequestBody defines body for PostProducts for application/json ContentType.
+type PostProductsJSONRequestBody = Product
+
+// PostProductsImportMultipartRequestBody defines body for PostProductsImport for multipart/form-data ContentType.
+type PostProductsImportMultipartRequestBody PostProductsImportMultipartBody
+
+// PutProductsIdJSONRequestBody defines body for PutProductsId for application/json ContentType.
+type PutProductsIdJSONRequestBody = Product
+
+// PostProductsIdImagesMultipartRequestBody defines body for PostProductsIdImages for multipart/form-data ContentType.
+type PostProductsIdImagesMultipartRequestBody PostProductsIdImagesMultipartBody
+
+// PostProductsIdPriceSchedulesJSONRequestBody defines body for PostProductsIdPriceSchedules for application/json ContentType.
+type PostProductsIdPriceSchedulesJSONRequestBody = PriceSchedule
+
+// PostProductsIdStockMovementsJSONRequestBody defines body for PostProductsIdStockMovements for application/json ContentType.
+type PostProductsIdStockMovementsJSONRequestBody = StockMovementRequest
+
+// PostProductsIdTaxRatesJSONRequestBody defines body for PostProductsIdTaxRates for application/json ContentType.
+type PostProductsIdTaxRatesJSONRequestBody = TaxRate
+
+// PostProductsIdVariantsJSONRequestBody defines body for PostProductsIdVariants for application/json ContentType.
+type PostProductsIdVariantsJSONRequestBody = VariantGeneration
+
+// PostPromotionsJSONRequestBody defines body for PostPromotions for application/json ContentType.
+type PostPromotionsJSONRequestBody = Promotion
+
+// PutPromotionsIdJSONRequestBody defines body for PutPromotionsId for application/json ContentType.
+type PutPromotionsIdJSONRequestBody = Promotion
+
+// PostPurchaseOrdersJSONRequestBody defines body for PostPurchaseOrders for application/json ContentType.
+type PostPurchaseOrdersJSONRequestBody = PurchaseOrder
+
+// PutPurchaseOrdersIdJSONRequestBody defines body for PutPurchaseOrdersId for application/json ContentType.
+type PutPurchaseOrdersIdJSONRequestBody = PurchaseOrder
+
+// PostPurchaseOrdersIdGoodsReceiptsJSONRequestBody defines body for PostPurchaseOrdersIdGoodsReceipts for application/json ContentType.
+type PostPurchaseOrdersIdGoodsReceiptsJSONRequestBody = GoodsReceipt
+
+// PostSalesJSONRequestBody defines body for PostSales for application/json ContentType.
+type PostSalesJSONRequestBody = SaleRequest
+
+// PostSalesPreviewJSONRequestBody defines body for PostSalesPreview for application/json ContentType.
+type PostSalesPreviewJSONRequestBody = SaleRequest
+
+// PutSalesIdJSONRequestBody defines body for PutSalesId for application/json ContentType.
+type PutSalesIdJSONRequestBody PutSalesIdJSONBody
+
+// PostSalesIdEwaybillJSONRequestBody defines body for PostSalesIdEwaybill for application/json ContentType.
+type PostSalesIdEwaybillJSONRequestBody = EWayBillRequest
+
+// PutSalesIdEwaybillJSONRequestBody defines body for PutSalesIdEwaybill for application/json ContentType.
+type PutSalesIdEwaybillJSONRequestBody = EWayBillNumber
+
+// PutSettingsJSONRequestBody defines body for PutSettings for application/json ContentType.
+type PutSettingsJSONRequestBody = Settings
+
+// PostStocktakesJSONRequestBody defines body for PostStocktakes for application/json ContentType.
+type PostStocktakesJSONRequestBody = Stocktake
+
+// PostStocktakesIdApproveJSONRequestBody defines body for PostStocktakesIdApprove for application/json ContentType.
+type PostStocktakesIdApproveJSONRequestBody = StocktakeApproval
+
+// PostStocktakesIdCountsJSONRequestBody defines body for PostStocktakesIdCounts for application/json ContentType.
+type PostStocktakesIdCountsJSONRequestBody = StocktakeCountRequest
+
+// PostStoresJSONRequestBody defines body for PostStores for application/json ContentType.
+type PostStoresJSONRequestBody = Store
+
+// PutStoresIdJSONRequestBody defines body for PutStoresId for application/json ContentType.
+type PutStoresIdJSONRequestBody = Store
+
+// PostSuppliersJSONRequestBody defines body for PostSuppliers for application/json ContentType.
+type PostSuppliersJSONRequestBody = Supplier
+
+// PutSuppliersIdJSONRequestBody defines body for PutSuppliersId for application/json ContentType.
+type PutSuppliersIdJSONRequestBody = Supplier
+
+// PostTaxRateChangesJSONRequestBody defines body for PostTaxRateChanges for application/json ContentType.
+type PostTaxRateChangesJSONRequestBody = TaxRateChange
+
+// PostTaxRateChangesPreviewJSONRequestBody defines body for PostTaxRateChangesPreview for application/json ContentType.
+type PostTaxRateChangesPreviewJSONRequestBody = TaxRateChange
+
+// PostTransfersJSONRequestBody defines body for PostTransfers for application/json ContentType.
+type PostTransfersJSONRequestBody = Transfer
+
+// PostTransfersIdReceiveJSONRequestBody defines body for PostTransfersIdReceive for application/json ContentType.
+type PostTransfersIdReceiveJSONRequestBody = TransferReceipt
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Authenticate and return JWT token
+	// (POST /auth/login)
+	PostAuthLogin(c *gin.Context)
+	// Register the business owner account
+	// (POST /auth/register)
+	PostAuthRegister(c *gin.Context)
+	// Render a barcode image
+	// (GET /barcodes/{code})
+	GetBarcodesCode(c *gin.Context, code string, params GetBarcodesCodeParams)
+	// List all categories with their paths
+	// (GET /categories)
+	GetCategories(c *gin.Context)
+	// Add a category
+	// (POST /categories)
+	PostCategories(c *gin.Context)
+	// Delete a category without subcategories or products
+	// (DELETE /categories/{id})
+	DeleteCategoriesId(c *gin.Context, id int)
+	// Get a category
+	// (GET /categories/{id})
+	GetCategoriesId(c *gin.Context, id int)
+	// Update a category
+	// (PUT /categories/{id})
+	PutCategoriesId(c *gin.Context, id int)
+	// List customer groups
+	// (GET /customer-groups)
+	GetCustomerGroups(c *gin.Context)
+	// Add a customer group, e.g. wholesale or staff
+	// (POST /customer-groups)
+	PostCustomerGroups(c *gin.Context)
+	// Delete a customer group that has no customers
+	// (DELETE /customer-groups/{id})
+	DeleteCustomerGroupsId(c *gin.Context, id int)
+	// Update a customer group
+	// (PUT /customer-groups/{id})
+	PutCustomerGroupsId(c *gin.Context, id int)
+	// List all customers
+	// (GET /customers)
+	GetCustomers(c *gin.Context)
+	// Add a new customer
+	// (POST /customers)
+	PostCustomers(c *gin.Context)
+	// Get a customer
+	// (GET /customers/{id})
+	GetCustomersId(c *gin.Context, id int)
+	// Update a customer
+	// (PUT /customers/{id})
+	PutCustomersId(c *gin.Context, id int)
+	// Get a goods receipt note
+	// (GET /goods-receipts/{id})
+	GetGoodsReceiptsId(c *gin.Context, id int)
+	// Fetch a stored image or thumbnail
+	// (GET /images/{key})
+	GetImagesKey(c *gin.Context, key string)
+	// List price lists
+	// (GET /price-lists)
+	GetPriceLists(c *gin.Context)
+	// Add a price list, e.g. retail, wholesale or staff
+	// (POST /price-lists)
+	PostPriceLists(c *gin.Context)
+	// Delete a price list that no customer, group or sale uses
+	// (DELETE /price-lists/{id})
+	DeletePriceListsId(c *gin.Context, id int)
+	// Get a price list
+	// (GET /price-lists/{id})
+	GetPriceListsId(c *gin.Context, id int)
+	// Rename a price list or make it the default
+	// (PUT /price-lists/{id})
+	PutPriceListsId(c *gin.Context, id int)
+	// List the product prices of a price list
+	// (GET /price-lists/{id}/prices)
+	GetPriceListsIdPrices(c *gin.Context, id int)
+	// Remove a product from a price list
+	// (DELETE /price-lists/{id}/prices/{productId})
+	DeletePriceListsIdPricesProductId(c *gin.Context, id int, productId int)
+	// Set the price tiers of a product on a price list
+	// (PUT /price-lists/{id}/prices/{productId})
+	PutPriceListsIdPricesProductId(c *gin.Context, id int, productId int)
+	// List all products
+	// (GET /products)
+	GetProducts(c *gin.Context, params GetProductsParams)
+	// Change the price, tax rates or category of many products at once
+	// (PATCH /products)
+	PatchProducts(c *gin.Context, params PatchProductsParams)
+	// Add a new product
+	// (POST /products)
+	PostProducts(c *gin.Context)
+	// List the bulk updates made to products, oldest first
+	// (GET /products/bulk-updates)
+	GetProductsBulkUpdates(c *gin.Context)
+	// Export the product catalogue
+	// (GET /products/export)
+	GetProductsExport(c *gin.Context, params GetProductsExportParams)
+	// Import products from a CSV or XLSX file
+	// (POST /products/import)
+	PostProductsImport(c *gin.Context, params PostProductsImportParams)
+	// Find the product with a scanned barcode
+	// (GET /products/lookup)
+	GetProductsLookup(c *gin.Context, params GetProductsLookupParams)
+	// Search products by name, description, SKU or barcode
+	// (GET /products/search)
+	GetProductsSearch(c *gin.Context, params GetProductsSearchParams)
+	// Delete a product
+	// (DELETE /products/{id})
+	DeleteProductsId(c *gin.Context, id int)
+	// Update a product
+	// (PUT /products/{id})
+	PutProductsId(c *gin.Context, id int)
+	// Archive a product and its variants
+	// (POST /products/{id}/archive)
+	PostProductsIdArchive(c *gin.Context, id int)
+	// Generate an internal EAN-13 barcode for a product without one
+	// (POST /products/{id}/barcodes)
+	PostProductsIdBarcodes(c *gin.Context, id int)
+	// List the batches of a product, first to expire first
+	// (GET /products/{id}/batches)
+	GetProductsIdBatches(c *gin.Context, id int, params GetProductsIdBatchesParams)
+	// List the images of a product
+	// (GET /products/{id}/images)
+	GetProductsIdImages(c *gin.Context, id int)
+	// Upload an image of a product
+	// (POST /products/{id}/images)
+	PostProductsIdImages(c *gin.Context, id int)
+	// Delete an image of a product
+	// (DELETE /products/{id}/images/{imageId})
+	DeleteProductsIdImagesImageId(c *gin.Context, id int, imageId int)
+	// List the price and tax rate changes of a product
+	// (GET /products/{id}/price-history)
+	GetProductsIdPriceHistory(c *gin.Context, id int)
+	// List the scheduled prices of a product
+	// (GET /products/{id}/price-schedules)
+	GetProductsIdPriceSchedules(c *gin.Context, id int)
+	// Schedule a price for a period, e.g. a festival sale
+	// (POST /products/{id}/price-schedules)
+	PostProductsIdPriceSchedules(c *gin.Context, id int)
+	// Cancel a scheduled price, or end it now if it is in effect
+	// (DELETE /products/{id}/price-schedules/{scheduleId})
+	DeleteProductsIdPriceSchedulesScheduleId(c *gin.Context, id int, scheduleId int)
+	// Get the stock on hand of a product
+	// (GET /products/{id}/stock)
+	GetProductsIdStock(c *gin.Context, id int)
+	// List the stock movements of a product, oldest first
+	// (GET /products/{id}/stock-movements)
+	GetProductsIdStockMovements(c *gin.Context, id int)
+	// Post a purchase, customer return or manual stock adjustment
+	// (POST /products/{id}/stock-movements)
+	PostProductsIdStockMovements(c *gin.Context, id int)
+	// List the tax rate schedule of a product
+	// (GET /products/{id}/tax-rates)
+	GetProductsIdTaxRates(c *gin.Context, id int)
+	// Schedule a tax rate for a product from a given date
+	// (POST /products/{id}/tax-rates)
+	PostProductsIdTaxRates(c *gin.Context, id int)
+	// Return an archived product and its variants to the catalogue
+	// (POST /products/{id}/unarchive)
+	PostProductsIdUnarchive(c *gin.Context, id int)
+	// List the variants of a product
+	// (GET /products/{id}/variants)
+	GetProductsIdVariants(c *gin.Context, id int)
+	// Set the variant options of a product and generate the missing variant combinations
+	// (POST /products/{id}/variants)
+	PostProductsIdVariants(c *gin.Context, id int)
+	// List promotions
+	// (GET /promotions)
+	GetPromotions(c *gin.Context)
+	// Add a promotion
+	// (POST /promotions)
+	PostPromotions(c *gin.Context)
+	// Delete a promotion that has not been applied to a sale
+	// (DELETE /promotions/{id})
+	DeletePromotionsId(c *gin.Context, id int)
+	// Get a promotion
+	// (GET /promotions/{id})
+	GetPromotionsId(c *gin.Context, id int)
+	// Update a promotion
+	// (PUT /promotions/{id})
+	PutPromotionsId(c *gin.Context, id int)
+	// List purchase orders
+	// (GET /purchase-orders)
+	GetPurchaseOrders(c *gin.Context, params GetPurchaseOrdersParams)
+	// Place a purchase order with a supplier
+	// (POST /purchase-orders)
+	PostPurchaseOrders(c *gin.Context)
+	// Get a purchase order
+	// (GET /purchase-orders/{id})
+	GetPurchaseOrdersId(c *gin.Context, id int)
+	// Edit a draft purchase order, replacing its lines
+	// (PUT /purchase-orders/{id})
+	PutPurchaseOrdersId(c *gin.Context, id int)
+	// Close a purchase order, cancelling the quantities not yet received
+	// (POST /purchase-orders/{id}/close)
+	PostPurchaseOrdersIdClose(c *gin.Context, id int)
+	// List the goods receipt notes of a purchase order
+	// (GET /purchase-orders/{id}/goods-receipts)
+	GetPurchaseOrdersIdGoodsReceipts(c *gin.Context, id int)
+	// Receive goods against a purchase order
+	// (POST /purchase-orders/{id}/goods-receipts)
+	PostPurchaseOrdersIdGoodsReceipts(c *gin.Context, id int)
+	// Place a draft purchase order with its supplier
+	// (POST /purchase-orders/{id}/place)
+	PostPurchaseOrdersIdPlace(c *gin.Context, id int)
+	// Quarterly turnover and tax payable for the CMP-08 statement
+	// (GET /reports/cmp08)
+	GetReportsCmp08(c *gin.Context, params GetReportsCmp08Params)
+	// Input GST on goods received, by rate, for input tax credit
+	// (GET /reports/input-tax)
+	GetReportsInputTax(c *gin.Context, params GetReportsInputTaxParams)
+	// Products at or below their reorder level, with sales velocity and a suggested order quantity
+	// (GET /reports/low-stock)
+	GetReportsLowStock(c *gin.Context, params GetReportsLowStockParams)
+	// Draft a purchase order per supplier for the suggested quantities on the low-stock report
+	// (POST /reports/low-stock/purchase-orders)
+	PostReportsLowStockPurchaseOrders(c *gin.Context, params PostReportsLowStockPurchaseOrdersParams)
+	// Batches on hand that expire within a number of days, expired ones included
+	// (GET /reports/near-expiry)
+	GetReportsNearExpiry(c *gin.Context, params GetReportsNearExpiryParams)
+	// Quantity and cost value of the stock on hand at the end of a day
+	// (GET /reports/stock-valuation)
+	GetReportsStockValuation(c *gin.Context, params GetReportsStockValuationParams)
+	// Tax summary by supply type and rate
+	// (GET /reports/tax)
+	GetReportsTax(c *gin.Context, params GetReportsTaxParams)
+	// List all sales
+	// (GET /sales)
+	GetSales(c *gin.Context)
+	// Create a new sale
+	// (POST /sales)
+	PostSales(c *gin.Context)
+	// Price a cart without recording a sale
+	// (POST /sales/preview)
+	PostSalesPreview(c *gin.Context)
+	// Void a sale and return its items to stock
+	// (DELETE /sales/{id})
+	DeleteSalesId(c *gin.Context, id int)
+	// Update a sale
+	// (PUT /sales/{id})
+	PutSalesId(c *gin.Context, id int)
+	// Get the e-way bill generated for a sale
+	// (GET /sales/{id}/ewaybill)
+	GetSalesIdEwaybill(c *gin.Context, id int)
+	// Generate the e-way bill bulk-upload JSON for a sale
+	// (POST /sales/{id}/ewaybill)
+	PostSalesIdEwaybill(c *gin.Context, id int)
+	// Record the e-way bill number issued by the portal
+	// (PUT /sales/{id}/ewaybill)
+	PutSalesIdEwaybill(c *gin.Context, id int)
+	// Generate and download PDF receipt
+	// (GET /sales/{id}/receipt)
+	GetSalesIdReceipt(c *gin.Context, id int)
+	// Get business information
+	// (GET /settings)
+	GetSettings(c *gin.Context)
+	// Update business information
+	// (PUT /settings)
+	PutSettings(c *gin.Context)
+	// List stocktakes
+	// (GET /stocktakes)
+	GetStocktakes(c *gin.Context, params GetStocktakesParams)
+	// Start a stocktake, freezing the expected quantities
+	// (POST /stocktakes)
+	PostStocktakes(c *gin.Context)
+	// Get a stocktake with its variances
+	// (GET /stocktakes/{id})
+	GetStocktakesId(c *gin.Context, id int)
+	// Approve a stocktake, posting its variances as stock adjustments
+	// (POST /stocktakes/{id}/approve)
+	PostStocktakesIdApprove(c *gin.Context, id int)
+	// Cancel a stocktake without adjusting stock
+	// (POST /stocktakes/{id}/cancel)
+	PostStocktakesIdCancel(c *gin.Context, id int)
+	// List the counts recorded for a stocktake, in the order they came in
+	// (GET /stocktakes/{id}/counts)
+	GetStocktakesIdCounts(c *gin.Context, id int)
+	// Record counted quantities from a scanner
+	// (POST /stocktakes/{id}/counts)
+	PostStocktakesIdCounts(c *gin.Context, id int)
+	// List the business's other stores
+	// (GET /stores)
+	GetStores(c *gin.Context)
+	// Add a store stock can be transferred to or from
+	// (POST /stores)
+	PostStores(c *gin.Context)
+	// Get a store
+	// (GET /stores/{id})
+	GetStoresId(c *gin.Context, id int)
+	// Update a store
+	// (PUT /stores/{id})
+	PutStoresId(c *gin.Context, id int)
+	// List all suppliers
+	// (GET /suppliers)
+	GetSuppliers(c *gin.Context)
+	// Add a new supplier
+	// (POST /suppliers)
+	PostSuppliers(c *gin.Context)
+	// Get a supplier
+	// (GET /suppliers/{id})
+	GetSuppliersId(c *gin.Context, id int)
+	// Update a supplier
+	// (PUT /suppliers/{id})
+	PutSuppliersId(c *gin.Context, id int)
+	// List the purchase orders placed with a supplier
+	// (GET /suppliers/{id}/purchase-orders)
+	GetSuppliersIdPurchaseOrders(c *gin.Context, id int, params GetSuppliersIdPurchaseOrdersParams)
+	// List bulk tax rate changes
+	// (GET /tax-rate-changes)
+	GetTaxRateChanges(c *gin.Context)
+	// Schedule a tax rate change for all products matching a HSN code, a category, or both
+	// (POST /tax-rate-changes)
+	PostTaxRateChanges(c *gin.Context)
+	// Preview the products affected by a tax rate change
+	// (POST /tax-rate-changes/preview)
+	PostTaxRateChangesPreview(c *gin.Context)
+	// Cancel a tax rate change that has not yet taken effect
+	// (DELETE /tax-rate-changes/{id})
+	DeleteTaxRateChangesId(c *gin.Context, id int)
+	// List stock transfers
+	// (GET /transfers)
+	GetTransfers(c *gin.Context, params GetTransfersParams)
+	// Create a transfer order to or from another store
+	// (POST /transfers)
+	PostTransfers(c *gin.Context)
+	// Get a transfer with its quantities and values at cost
+	// (GET /transfers/{id})
+	GetTransfersId(c *gin.Context, id int)
+	// Cancel a draft, or an inbound transfer before it is received
+	// (POST /transfers/{id}/cancel)
+	PostTransfersIdCancel(c *gin.Context, id int)
+	// Download the delivery challan of a dispatched outbound transfer
+	// (GET /transfers/{id}/challan)
+	GetTransfersIdChallan(c *gin.Context, id int)
+	// Dispatch an outbound transfer, taking the goods out of stock
+	// (POST /transfers/{id}/dispatch)
+	PostTransfersIdDispatch(c *gin.Context, id int)
+	// Record the receipt of a transfer in transit
+	// (POST /transfers/{id}/receive)
+	PostTransfersIdReceive(c *gin.Context, id int)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// PostAuthLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthLogin(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAuthLogin(c)
+}
+
+// PostAuthRegister operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthRegister(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAuthRegister(c)
+}
+
+// GetBarcodesCode operation middleware
+func (siw *ServerInterfaceWrapper) GetBarcodesCode(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "code", c.Param("code"), &code, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBarcodesCodeParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetBarcodesCode(c, code, params)
+}
+
+// GetCategories operation middleware
+func (siw *ServerInterfaceWrapper) GetCategories(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCategories(c)
+}
+
+// PostCategories operation middleware
+func (siw *ServerInterfaceWrapper) PostCategories(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostCategories(c)
+}
+
+// DeleteCategoriesId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCategoriesId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteCategoriesId(c, id)
+}
+
+// GetCategoriesId operation middleware
+func (siw *ServerInterfaceWrapper) GetCategoriesId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCategoriesId(c, id)
+}
+
+// PutCategoriesId operation middleware
+func (siw *ServerInterfaceWrapper) PutCategoriesId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutCategoriesId(c, id)
+}
+
+// GetCustomerGroups operation middleware
+func (siw *ServerInterfaceWrapper) GetCustomerGroups(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCustomerGroups(c)
+}
+
+// PostCustomerGroups operation middleware
+func (siw *ServerInterfaceWrapper) PostCustomerGroups(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostCustomerGroups(c)
+}
+
+// DeleteCustomerGroupsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCustomerGroupsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteCustomerGroupsId(c, id)
+}
+
+// PutCustomerGroupsId operation middleware
+func (siw *ServerInterfaceWrapper) PutCustomerGroupsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutCustomerGroupsId(c, id)
+}
+
+// GetCustomers operation middleware
+func (siw *ServerInterfaceWrapper) GetCustomers(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCustomers(c)
+}
+
+// PostCustomers operation middleware
+func (siw *ServerInterfaceWrapper) PostCustomers(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostCustomers(c)
+}
+
+// GetCustomersId operation middleware
+func (siw *ServerInterfaceWrapper) GetCustomersId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCustomersId(c, id)
+}
+
+// PutCustomersId operation middleware
+func (siw *ServerInterfaceWrapper) PutCustomersId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutCustomersId(c, id)
+}
+
+// GetGoodsReceiptsId operation middleware
+func (siw *ServerInterfaceWrapper) GetGoodsReceiptsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetGoodsReceiptsId(c, id)
+}
+
+// GetImagesKey operation middleware
+func (siw *ServerInterfaceWrapper) GetImagesKey(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", c.Param("key"), &key, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter key: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetImagesKey(c, key)
+}
+
+// GetPriceLists operation middleware
+func (siw *ServerInterfaceWrapper) GetPriceLists(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPriceLists(c)
+}
+
+// PostPriceLists operation middleware
+func (siw *ServerInterfaceWrapper) PostPriceLists(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPriceLists(c)
+}
+
+// DeletePriceListsId operation middleware
+func (siw *ServerInterfaceWrapper) DeletePriceListsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeletePriceListsId(c, id)
+}
+
+// GetPriceListsId operation middleware
+func (siw *ServerInterfaceWrapper) GetPriceListsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPriceListsId(c, id)
+}
+
+// PutPriceListsId operation middleware
+func (siw *ServerInterfaceWrapper) PutPriceListsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutPriceListsId(c, id)
+}
+
+// GetPriceListsIdPrices operation middleware
+func (siw *ServerInterfaceWrapper) GetPriceListsIdPrices(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPriceListsIdPrices(c, id)
+}
+
+// DeletePriceListsIdPricesProductId operation middleware
+func (siw *ServerInterfaceWrapper) DeletePriceListsIdPricesProductId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "productId" -------------
+	var productId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Param("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeletePriceListsIdPricesProductId(c, id, productId)
+}
+
+// PutPriceListsIdPricesProductId operation middleware
+func (siw *ServerInterfaceWrapper) PutPriceListsIdPricesProductId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "productId" -------------
+	var productId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Param("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutPriceListsIdPricesProductId(c, id, productId)
+}
+
+// GetProducts operation middleware
+func (siw *ServerInterfaceWrapper) GetProducts(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProductsParams
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", c.Request.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter category: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "includeArchived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeArchived", c.Request.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter includeArchived: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProducts(c, params)
+}
+
+// PatchProducts operation middleware
+func (siw *ServerInterfaceWrapper) PatchProducts(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchProductsParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dryRun: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchProducts(c, params)
+}
+
+// PostProducts operation middleware
+func (siw *ServerInterfaceWrapper) PostProducts(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProducts(c)
+}
+
+// GetProductsBulkUpdates operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsBulkUpdates(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProductsBulkUpdates(c)
+}
+
+// GetProductsExport operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsExport(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProductsExportParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "columns" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "columns", c.Request.URL.Query(), &params.Columns)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter columns: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", c.Request.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter category: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "updatedSince" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updatedSince", c.Request.URL.Query(), &params.UpdatedSince)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter updatedSince: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "includeArchived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeArchived", c.Request.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter includeArchived: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProductsExport(c, params)
+}
+
+// PostProductsImport operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsImport(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostProductsImportParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", c.Request.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dryRun: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", c.Request.URL.Query(), &params.Mode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter mode: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProductsImport(c, params)
+}
+
+// GetProductsLookup operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsLookup(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProductsLookupParams
+
+	// ------------- Required query parameter "barcode" -------------
+
+	if paramValue := c.Query("barcode"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument barcode is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "barcode", c.Request.URL.Query(), &params.Barcode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter barcode: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProductsLookup(c, params)
+}
+
+// GetProductsSearch operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsSearch(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProductsSearchParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := c.Query("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument q is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProductsSearch(c, params)
+}
+
+// DeleteProductsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProductsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteProductsId(c, id)
+}
+
+// PutProductsId operation middleware
+func (siw *ServerInterfaceWrapper) PutProductsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutProductsId(c, id)
+}
+
+// PostProductsIdArchive operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsIdArchive(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProductsIdArchive(c, id)
+}
+
+// PostProductsIdBarcodes operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsIdBarcodes(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProductsIdBarcodes(c, id)
+}
+
+// GetProductsIdBatches operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsIdBatches(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProductsIdBatchesParams
+
+	// ------------- Optional query parameter "includeEmpty" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeEmpty", c.Request.URL.Query(), &params.IncludeEmpty)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter includeEmpty: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProductsIdBatches(c, id, params)
+}
+
+// GetProductsIdImages operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsIdImages(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProductsIdImages(c, id)
+}
+
+// PostProductsIdImages operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsIdImages(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProductsIdImages(c, id)
+}
+
+// DeleteProductsIdImagesImageId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProductsIdImagesImageId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "imageId" -------------
+	var imageId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "imageId", c.Param("imageId"), &imageId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter imageId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteProductsIdImagesImageId(c, id, imageId)
+}
+
+// GetProductsIdPriceHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsIdPriceHistory(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProductsIdPriceHistory(c, id)
+}
+
+// GetProductsIdPriceSchedules operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsIdPriceSchedules(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProductsIdPriceSchedules(c, id)
+}
+
+// PostProductsIdPriceSchedules operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsIdPriceSchedules(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProductsIdPriceSchedules(c, id)
+}
+
+// DeleteProductsIdPriceSchedulesScheduleId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProductsIdPriceSchedulesScheduleId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", c.Param("scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scheduleId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteProductsIdPriceSchedulesScheduleId(c, id, scheduleId)
+}
+
+// GetProductsIdStock operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsIdStock(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProductsIdStock(c, id)
+}
+
+// GetProductsIdStockMovements operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsIdStockMovements(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProductsIdStockMovements(c, id)
+}
+
+// PostProductsIdStockMovements operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsIdStockMovements(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProductsIdStockMovements(c, id)
+}
+
+// GetProductsIdTaxRates operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsIdTaxRates(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProductsIdTaxRates(c, id)
+}
+
+// PostProductsIdTaxRates operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsIdTaxRates(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProductsIdTaxRates(c, id)
+}
+
+// PostProductsIdUnarchive operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsIdUnarchive(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProductsIdUnarchive(c, id)
+}
+
+// GetProductsIdVariants operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsIdVariants(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProductsIdVariants(c, id)
+}
+
+// PostProductsIdVariants operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsIdVariants(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProductsIdVariants(c, id)
+}
+
+// GetPromotions operation middleware
+func (siw *ServerInterfaceWrapper) GetPromotions(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPromotions(c)
+}
+
+// PostPromotions operation middleware
+func (siw *ServerInterfaceWrapper) PostPromotions(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPromotions(c)
+}
+
+// DeletePromotionsId operation middleware
+func (siw *ServerInterfaceWrapper) DeletePromotionsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeletePromotionsId(c, id)
+}
+
+// GetPromotionsId operation middleware
+func (siw *ServerInterfaceWrapper) GetPromotionsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPromotionsId(c, id)
+}
+
+// PutPromotionsId operation middleware
+func (siw *ServerInterfaceWrapper) PutPromotionsId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutPromotionsId(c, id)
+}
+
+// GetPurchaseOrders operation middleware
+func (siw *ServerInterfaceWrapper) GetPurchaseOrders(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPurchaseOrdersParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPurchaseOrders(c, params)
+}
+
+// PostPurchaseOrders operation middleware
+func (siw *ServerInterfaceWrapper) PostPurchaseOrders(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPurchaseOrders(c)
+}
+
+// GetPurchaseOrdersId operation middleware
+func (siw *ServerInterfaceWrapper) GetPurchaseOrdersId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPurchaseOrdersId(c, id)
+}
+
+// PutPurchaseOrdersId operation middleware
+func (siw *ServerInterfaceWrapper) PutPurchaseOrdersId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutPurchaseOrdersId(c, id)
+}
+
+// PostPurchaseOrdersIdClose operation middleware
+func (siw *ServerInterfaceWrapper) PostPurchaseOrdersIdClose(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPurchaseOrdersIdClose(c, id)
+}
+
+// GetPurchaseOrdersIdGoodsReceipts operation middleware
+func (siw *ServerInterfaceWrapper) GetPurchaseOrdersIdGoodsReceipts(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPurchaseOrdersIdGoodsReceipts(c, id)
+}
+
+// PostPurchaseOrdersIdGoodsReceipts operation middleware
+func (siw *ServerInterfaceWrapper) PostPurchaseOrdersIdGoodsReceipts(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPurchaseOrdersIdGoodsReceipts(c, id)
+}
+
+// PostPurchaseOrdersIdPlace operation middleware
+func (siw *ServerInterfaceWrapper) PostPurchaseOrdersIdPlace(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPurchaseOrdersIdPlace(c, id)
+}
+
+// GetReportsCmp08 operation middleware
+func (siw *ServerInterfaceWrapper) GetReportsCmp08(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsCmp08Params
+
+	// ------------- Required query parameter "financialYear" -------------
+
+	if paramValue := c.Query("financialYear"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument financialYear is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "financialYear", c.Request.URL.Query(), &params.FinancialYear)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter financialYear: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "quarter" -------------
+
+	if paramValue := c.Query("quarter"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument quarter is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "quarter", c.Request.URL.Query(), &params.Quarter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter quarter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetReportsCmp08(c, params)
+}
+
+// GetReportsInputTax operation middleware
+func (siw *ServerInterfaceWrapper) GetReportsInputTax(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsInputTaxParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetReportsInputTax(c, params)
+}
+
+// GetReportsLowStock operation middleware
+func (siw *ServerInterfaceWrapper) GetReportsLowStock(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsLowStockParams
+
+	// ------------- Optional query parameter "days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "days", c.Request.URL.Query(), &params.Days)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter days: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetReportsLowStock(c, params)
+}
+
+// PostReportsLowStockPurchaseOrders operation middleware
+func (siw *ServerInterfaceWrapper) PostReportsLowStockPurchaseOrders(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostReportsLowStockPurchaseOrdersParams
+
+	// ------------- Optional query parameter "days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "days", c.Request.URL.Query(), &params.Days)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter days: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostReportsLowStockPurchaseOrders(c, params)
+}
+
+// GetReportsNearExpiry operation middleware
+func (siw *ServerInterfaceWrapper) GetReportsNearExpiry(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsNearExpiryParams
+
+	// ------------- Optional query parameter "days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "days", c.Request.URL.Query(), &params.Days)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter days: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetReportsNearExpiry(c, params)
+}
+
+// GetReportsStockValuation operation middleware
+func (siw *ServerInterfaceWrapper) GetReportsStockValuation(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsStockValuationParams
+
+	// ------------- Optional query parameter "asOf" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "asOf", c.Request.URL.Query(), &params.AsOf)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter asOf: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "categoryId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "categoryId", c.Request.URL.Query(), &params.CategoryId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter categoryId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetReportsStockValuation(c, params)
+}
+
+// GetReportsTax operation middleware
+func (siw *ServerInterfaceWrapper) GetReportsTax(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsTaxParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetReportsTax(c, params)
+}
+
+// GetSales operation middleware
+func (siw *ServerInterfaceWrapper) GetSales(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSales(c)
+}
+
+// PostSales operation middleware
+func (siw *ServerInterfaceWrapper) PostSales(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSales(c)
+}
+
+// PostSalesPreview operation middleware
+func (siw *ServerInterfaceWrapper) PostSalesPreview(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSalesPreview(c)
+}
+
+// DeleteSalesId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSalesId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteSalesId(c, id)
+}
+
+// PutSalesId operation middleware
+func (siw *ServerInterfaceWrapper) PutSalesId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutSalesId(c, id)
+}
+
+// GetSalesIdEwaybill operation middleware
+func (siw *ServerInterfaceWrapper) GetSalesIdEwaybill(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSalesIdEwaybill(c, id)
+}
+
+// PostSalesIdEwaybill operation middleware
+func (siw *ServerInterfaceWrapper) PostSalesIdEwaybill(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostSalesIdEwaybill(c, id)
+}
+
+// PutSalesIdEwaybill operation middleware
+func (siw *ServerInterfaceWrapper) PutSalesIdEwaybill(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutSalesIdEwaybill(c, id)
+}
+
+// GetSalesIdReceipt operation middleware
+func (siw *ServerInterfaceWrapper) GetSalesIdReceipt(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSalesIdReceipt(c, id)
+}
+
+// GetSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetSettings(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSettings(c)
+}
+
+// PutSettings operation middleware
+func (siw *ServerInterfaceWrapper) PutSettings(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutSettings(c)
+}
+
+// GetStocktakes operation middleware
+func (siw *ServerInterfaceWrapper) GetStocktakes(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStocktakesParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStocktakes(c, params)
+}
+
+// PostStocktakes operation middleware
+func (siw *ServerInterfaceWrapper) PostStocktakes(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStocktakes(c)
+}
+
+// GetStocktakesId operation middleware
+func (siw *ServerInterfaceWrapper) GetStocktakesId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStocktakesId(c, id)
+}
+
+// PostStocktakesIdApprove operation middleware
+func (siw *ServerInterfaceWrapper) PostStocktakesIdApprove(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStocktakesIdApprove(c, id)
+}
+
+// PostStocktakesIdCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostStocktakesIdCancel(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStocktakesIdCancel(c, id)
+}
+
+// GetStocktakesIdCounts operation middleware
+func (siw *ServerInterfaceWrapper) GetStocktakesIdCounts(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStocktakesIdCounts(c, id)
+}
+
+// PostStocktakesIdCounts operation middleware
+func (siw *ServerInterfaceWrapper) PostStocktakesIdCounts(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStocktakesIdCounts(c, id)
+}
+
+// GetStores operation middleware
+func (siw *ServerInterfaceWrapper) GetStores(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStores(c)
+}
+
+// PostStores operation middleware
+func (siw *ServerInterfaceWrapper) PostStores(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostStores(c)
 }
 
-// GetProductsParams defines parameters for GetProducts.
-type GetProductsParams struct {
-	// Name Search products by name (partial match allowed)
-	Name *string `form:"name,omitempty" json:"name,omitempty"`
-}
+// GetStoresId operation middleware
+func (siw *ServerInterfaceWrapper) GetStoresId(c *gin.Context) {
 
-// PostSalesJSONBody defines parameters for PostSales.
-type PostSalesJSONBody struct {
-	Items *[]struct {
-		ProductId *int `json:"productId,omitempty"`
-		Quantity  *int `json:"quantity,omitempty"`
-	} `json:"items,omitempty"`
-}
+	var err error
 
-// PutSalesIdJSONBody defines parameters for PutSalesId.
-type PutSalesIdJSONBody struct {
-	Items *[]struct {
-		ProductId *int `json:"productId,omitempty"`
-		Quantity  *int `json:"quantity,omitempty"`
-	} `json:"items,omitempty"`
-}
+	// ------------- Path parameter "id" -------------
+	var id int
 
-// PostAuthLoginJSONRequestBody defines body for PostAuthLogin for application/json ContentType.
-type PostAuthLoginJSONRequestBody PostAuthLoginJSONBody
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-// PostAuthRegisterJSONRequestBody defines body for PostAuthRegister for application/json ContentType.
-type PostAuthRegisterJSONRequestBody PostAuthRegisterJSONBody
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-// PostProductsJSONRequestBody defines body for PostProducts for application/json ContentType.
-type PostProductsJSONRequestBody = Product
+	siw.Handler.GetStoresId(c, id)
+}
 
-// PutProductsIdJSONRequestBody defines body for PutProductsId for application/json ContentType.
-type PutProductsIdJSONRequestBody = Product
+// PutStoresId operation middleware
+func (siw *ServerInterfaceWrapper) PutStoresId(c *gin.Context) {
 
-// PostSalesJSONRequestBody defines body for PostSales for application/json ContentType.
-type PostSalesJSONRequestBody PostSalesJSONBody
+	var err error
 
-// PutSalesIdJSONRequestBody defines body for PutSalesId for application/json ContentType.
-type PutSalesIdJSONRequestBody PutSalesIdJSONBody
+	// ------------- Path parameter "id" -------------
+	var id int
 
-// PutSettingsJSONRequestBody defines body for PutSettings for application/json ContentType.
-type PutSettingsJSONRequestBody = Settings
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Authenticate and return JWT token
-	// (POST /auth/login)
-	PostAuthLogin(c *gin.Context)
-	// Register the business owner account
-	// (POST /auth/register)
-	PostAuthRegister(c *gin.Context)
-	// List all products
-	// (GET /products)
-	GetProducts(c *gin.Context, params GetProductsParams)
-	// Add a new product
-	// (POST /products)
-	PostProducts(c *gin.Context)
-	// Delete a product
-	// (DELETE /products/{id})
-	DeleteProductsId(c *gin.Context, id int)
-	// Update a product
-	// (PUT /products/{id})
-	PutProductsId(c *gin.Context, id int)
-	// List all sales
-	// (GET /sales)
-	GetSales(c *gin.Context)
-	// Create a new sale
-	// (POST /sales)
-	PostSales(c *gin.Context)
-	// Delete a sale
-	// (DELETE /sales/{id})
-	DeleteSalesId(c *gin.Context, id int)
-	// Update a sale
-	// (PUT /sales/{id})
-	PutSalesId(c *gin.Context, id int)
-	// Generate and download PDF receipt
-	// (GET /sales/{id}/receipt)
-	GetSalesIdReceipt(c *gin.Context, id int)
-	// Get business information
-	// (GET /settings)
-	GetSettings(c *gin.Context)
-	// Update business information
-	// (PUT /settings)
-	PutSettings(c *gin.Context)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
+	siw.Handler.PutStoresId(c, id)
 }
 
-type MiddlewareFunc func(c *gin.Context)
-
-// PostAuthLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthLogin(c *gin.Context) {
+// GetSuppliers operation middleware
+func (siw *ServerInterfaceWrapper) GetSuppliers(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -187,11 +4434,11 @@ func (siw *ServerInterfaceWrapper) PostAuthLogin(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostAuthLogin(c)
+	siw.Handler.GetSuppliers(c)
 }
 
-// PostAuthRegister operation middleware
-func (siw *ServerInterfaceWrapper) PostAuthRegister(c *gin.Context) {
+// PostSuppliers operation middleware
+func (siw *ServerInterfaceWrapper) PostSuppliers(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -200,22 +4447,20 @@ func (siw *ServerInterfaceWrapper) PostAuthRegister(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostAuthRegister(c)
+	siw.Handler.PostSuppliers(c)
 }
 
-// GetProducts operation middleware
-func (siw *ServerInterfaceWrapper) GetProducts(c *gin.Context) {
+// GetSuppliersId operation middleware
+func (siw *ServerInterfaceWrapper) GetSuppliersId(c *gin.Context) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetProductsParams
-
-	// ------------- Optional query parameter "name" -------------
+	// ------------- Path parameter "id" -------------
+	var id int
 
-	err = runtime.BindQueryParameter("form", true, false, "name", c.Request.URL.Query(), &params.Name)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -226,11 +4471,22 @@ func (siw *ServerInterfaceWrapper) GetProducts(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetProducts(c, params)
+	siw.Handler.GetSuppliersId(c, id)
 }
 
-// PostProducts operation middleware
-func (siw *ServerInterfaceWrapper) PostProducts(c *gin.Context) {
+// PutSuppliersId operation middleware
+func (siw *ServerInterfaceWrapper) PutSuppliersId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -239,11 +4495,11 @@ func (siw *ServerInterfaceWrapper) PostProducts(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostProducts(c)
+	siw.Handler.PutSuppliersId(c, id)
 }
 
-// DeleteProductsId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteProductsId(c *gin.Context) {
+// GetSuppliersIdPurchaseOrders operation middleware
+func (siw *ServerInterfaceWrapper) GetSuppliersIdPurchaseOrders(c *gin.Context) {
 
 	var err error
 
@@ -256,6 +4512,17 @@ func (siw *ServerInterfaceWrapper) DeleteProductsId(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSuppliersIdPurchaseOrdersParams
+
+	// ------------- Optional query parameter "outstanding" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "outstanding", c.Request.URL.Query(), &params.Outstanding)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter outstanding: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -263,11 +4530,50 @@ func (siw *ServerInterfaceWrapper) DeleteProductsId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteProductsId(c, id)
+	siw.Handler.GetSuppliersIdPurchaseOrders(c, id, params)
 }
 
-// PutProductsId operation middleware
-func (siw *ServerInterfaceWrapper) PutProductsId(c *gin.Context) {
+// GetTaxRateChanges operation middleware
+func (siw *ServerInterfaceWrapper) GetTaxRateChanges(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTaxRateChanges(c)
+}
+
+// PostTaxRateChanges operation middleware
+func (siw *ServerInterfaceWrapper) PostTaxRateChanges(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTaxRateChanges(c)
+}
+
+// PostTaxRateChangesPreview operation middleware
+func (siw *ServerInterfaceWrapper) PostTaxRateChangesPreview(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTaxRateChangesPreview(c)
+}
+
+// DeleteTaxRateChangesId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTaxRateChangesId(c *gin.Context) {
 
 	var err error
 
@@ -287,13 +4593,40 @@ func (siw *ServerInterfaceWrapper) PutProductsId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PutProductsId(c, id)
+	siw.Handler.DeleteTaxRateChangesId(c, id)
 }
 
-// GetSales operation middleware
-func (siw *ServerInterfaceWrapper) GetSales(c *gin.Context) {
+// GetTransfers operation middleware
+func (siw *ServerInterfaceWrapper) GetTransfers(c *gin.Context) {
 
-	c.Set(BearerAuthScopes, []string{})
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTransfersParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "direction" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "direction", c.Request.URL.Query(), &params.Direction)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter direction: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "storeId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "storeId", c.Request.URL.Query(), &params.StoreId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter storeId: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -302,13 +4635,11 @@ func (siw *ServerInterfaceWrapper) GetSales(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSales(c)
+	siw.Handler.GetTransfers(c, params)
 }
 
-// PostSales operation middleware
-func (siw *ServerInterfaceWrapper) PostSales(c *gin.Context) {
-
-	c.Set(BearerAuthScopes, []string{})
+// PostTransfers operation middleware
+func (siw *ServerInterfaceWrapper) PostTransfers(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -317,11 +4648,11 @@ func (siw *ServerInterfaceWrapper) PostSales(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostSales(c)
+	siw.Handler.PostTransfers(c)
 }
 
-// DeleteSalesId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteSalesId(c *gin.Context) {
+// GetTransfersId operation middleware
+func (siw *ServerInterfaceWrapper) GetTransfersId(c *gin.Context) {
 
 	var err error
 
@@ -334,8 +4665,6 @@ func (siw *ServerInterfaceWrapper) DeleteSalesId(c *gin.Context) {
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -343,11 +4672,11 @@ func (siw *ServerInterfaceWrapper) DeleteSalesId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteSalesId(c, id)
+	siw.Handler.GetTransfersId(c, id)
 }
 
-// PutSalesId operation middleware
-func (siw *ServerInterfaceWrapper) PutSalesId(c *gin.Context) {
+// PostTransfersIdCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostTransfersIdCancel(c *gin.Context) {
 
 	var err error
 
@@ -360,8 +4689,6 @@ func (siw *ServerInterfaceWrapper) PutSalesId(c *gin.Context) {
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -369,11 +4696,11 @@ func (siw *ServerInterfaceWrapper) PutSalesId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PutSalesId(c, id)
+	siw.Handler.PostTransfersIdCancel(c, id)
 }
 
-// GetSalesIdReceipt operation middleware
-func (siw *ServerInterfaceWrapper) GetSalesIdReceipt(c *gin.Context) {
+// GetTransfersIdChallan operation middleware
+func (siw *ServerInterfaceWrapper) GetTransfersIdChallan(c *gin.Context) {
 
 	var err error
 
@@ -386,8 +4713,6 @@ func (siw *ServerInterfaceWrapper) GetSalesIdReceipt(c *gin.Context) {
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -395,13 +4720,22 @@ func (siw *ServerInterfaceWrapper) GetSalesIdReceipt(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSalesIdReceipt(c, id)
+	siw.Handler.GetTransfersIdChallan(c, id)
 }
 
-// GetSettings operation middleware
-func (siw *ServerInterfaceWrapper) GetSettings(c *gin.Context) {
+// PostTransfersIdDispatch operation middleware
+func (siw *ServerInterfaceWrapper) PostTransfersIdDispatch(c *gin.Context) {
 
-	c.Set(BearerAuthScopes, []string{})
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -410,13 +4744,22 @@ func (siw *ServerInterfaceWrapper) GetSettings(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSettings(c)
+	siw.Handler.PostTransfersIdDispatch(c, id)
 }
 
-// PutSettings operation middleware
-func (siw *ServerInterfaceWrapper) PutSettings(c *gin.Context) {
+// PostTransfersIdReceive operation middleware
+func (siw *ServerInterfaceWrapper) PostTransfersIdReceive(c *gin.Context) {
 
-	c.Set(BearerAuthScopes, []string{})
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -425,7 +4768,7 @@ func (siw *ServerInterfaceWrapper) PutSettings(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PutSettings(c)
+	siw.Handler.PostTransfersIdReceive(c, id)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -457,44 +4800,430 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 
 	router.POST(options.BaseURL+"/auth/login", wrapper.PostAuthLogin)
 	router.POST(options.BaseURL+"/auth/register", wrapper.PostAuthRegister)
+	router.GET(options.BaseURL+"/barcodes/:code", wrapper.GetBarcodesCode)
+	router.GET(options.BaseURL+"/categories", wrapper.GetCategories)
+	router.POST(options.BaseURL+"/categories", wrapper.PostCategories)
+	router.DELETE(options.BaseURL+"/categories/:id", wrapper.DeleteCategoriesId)
+	router.GET(options.BaseURL+"/categories/:id", wrapper.GetCategoriesId)
+	router.PUT(options.BaseURL+"/categories/:id", wrapper.PutCategoriesId)
+	router.GET(options.BaseURL+"/customer-groups", wrapper.GetCustomerGroups)
+	router.POST(options.BaseURL+"/customer-groups", wrapper.PostCustomerGroups)
+	router.DELETE(options.BaseURL+"/customer-groups/:id", wrapper.DeleteCustomerGroupsId)
+	router.PUT(options.BaseURL+"/customer-groups/:id", wrapper.PutCustomerGroupsId)
+	router.GET(options.BaseURL+"/customers", wrapper.GetCustomers)
+	router.POST(options.BaseURL+"/customers", wrapper.PostCustomers)
+	router.GET(options.BaseURL+"/customers/:id", wrapper.GetCustomersId)
+	router.PUT(options.BaseURL+"/customers/:id", wrapper.PutCustomersId)
+	router.GET(options.BaseURL+"/goods-receipts/:id", wrapper.GetGoodsReceiptsId)
+	router.GET(options.BaseURL+"/images/:key", wrapper.GetImagesKey)
+	router.GET(options.BaseURL+"/price-lists", wrapper.GetPriceLists)
+	router.POST(options.BaseURL+"/price-lists", wrapper.PostPriceLists)
+	router.DELETE(options.BaseURL+"/price-lists/:id", wrapper.DeletePriceListsId)
+	router.GET(options.BaseURL+"/price-lists/:id", wrapper.GetPriceListsId)
+	router.PUT(options.BaseURL+"/price-lists/:id", wrapper.PutPriceListsId)
+	router.GET(options.BaseURL+"/price-lists/:id/prices", wrapper.GetPriceListsIdPrices)
+	router.DELETE(options.BaseURL+"/price-lists/:id/prices/:productId", wrapper.DeletePriceListsIdPricesProductId)
+	router.PUT(options.BaseURL+"/price-lists/:id/prices/:productId", wrapper.PutPriceListsIdPricesProductId)
 	router.GET(options.BaseURL+"/products", wrapper.GetProducts)
+	router.PATCH(options.BaseURL+"/products", wrapper.PatchProducts)
 	router.POST(options.BaseURL+"/products", wrapper.PostProducts)
+	router.GET(options.BaseURL+"/products/bulk-updates", wrapper.GetProductsBulkUpdates)
+	router.GET(options.BaseURL+"/products/export", wrapper.GetProductsExport)
+	router.POST(options.BaseURL+"/products/import", wrapper.PostProductsImport)
+	router.GET(options.BaseURL+"/products/lookup", wrapper.GetProductsLookup)
+	router.GET(options.BaseURL+"/products/search", wrapper.GetProductsSearch)
 	router.DELETE(options.BaseURL+"/products/:id", wrapper.DeleteProductsId)
 	router.PUT(options.BaseURL+"/products/:id", wrapper.PutProductsId)
+	router.POST(options.BaseURL+"/products/:id/archive", wrapper.PostProductsIdArchive)
+	router.POST(options.BaseURL+"/products/:id/barcodes", wrapper.PostProductsIdBarcodes)
+	router.GET(options.BaseURL+"/products/:id/batches", wrapper.GetProductsIdBatches)
+	router.GET(options.BaseURL+"/products/:id/images", wrapper.GetProductsIdImages)
+	router.POST(options.BaseURL+"/products/:id/images", wrapper.PostProductsIdImages)
+	router.DELETE(options.BaseURL+"/products/:id/images/:imageId", wrapper.DeleteProductsIdImagesImageId)
+	router.GET(options.BaseURL+"/products/:id/price-history", wrapper.GetProductsIdPriceHistory)
+	router.GET(options.BaseURL+"/products/:id/price-schedules", wrapper.GetProductsIdPriceSchedules)
+	router.POST(options.BaseURL+"/products/:id/price-schedules", wrapper.PostProductsIdPriceSchedules)
+	router.DELETE(options.BaseURL+"/products/:id/price-schedules/:scheduleId", wrapper.DeleteProductsIdPriceSchedulesScheduleId)
+	router.GET(options.BaseURL+"/products/:id/stock", wrapper.GetProductsIdStock)
+	router.GET(options.BaseURL+"/products/:id/stock-movements", wrapper.GetProductsIdStockMovements)
+	router.POST(options.BaseURL+"/products/:id/stock-movements", wrapper.PostProductsIdStockMovements)
+	router.GET(options.BaseURL+"/products/:id/tax-rates", wrapper.GetProductsIdTaxRates)
+	router.POST(options.BaseURL+"/products/:id/tax-rates", wrapper.PostProductsIdTaxRates)
+	router.POST(options.BaseURL+"/products/:id/unarchive", wrapper.PostProductsIdUnarchive)
+	router.GET(options.BaseURL+"/products/:id/variants", wrapper.GetProductsIdVariants)
+	router.POST(options.BaseURL+"/products/:id/variants", wrapper.PostProductsIdVariants)
+	router.GET(options.BaseURL+"/promotions", wrapper.GetPromotions)
+	router.POST(options.BaseURL+"/promotions", wrapper.PostPromotions)
+	router.DELETE(options.BaseURL+"/promotions/:id", wrapper.DeletePromotionsId)
+	router.GET(options.BaseURL+"/promotions/:id", wrapper.GetPromotionsId)
+	router.PUT(options.BaseURL+"/promotions/:id", wrapper.PutPromotionsId)
+	router.GET(options.BaseURL+"/purchase-orders", wrapper.GetPurchaseOrders)
+	router.POST(options.BaseURL+"/purchase-orders", wrapper.PostPurchaseOrders)
+	router.GET(options.BaseURL+"/purchase-orders/:id", wrapper.GetPurchaseOrdersId)
+	router.PUT(options.BaseURL+"/purchase-orders/:id", wrapper.PutPurchaseOrdersId)
+	router.POST(options.BaseURL+"/purchase-orders/:id/close", wrapper.PostPurchaseOrdersIdClose)
+	router.GET(options.BaseURL+"/purchase-orders/:id/goods-receipts", wrapper.GetPurchaseOrdersIdGoodsReceipts)
+	router.POST(options.BaseURL+"/purchase-orders/:id/goods-receipts", wrapper.PostPurchaseOrdersIdGoodsReceipts)
+	router.POST(options.BaseURL+"/purchase-orders/:id/place", wrapper.PostPurchaseOrdersIdPlace)
+	router.GET(options.BaseURL+"/reports/cmp08", wrapper.GetReportsCmp08)
+	router.GET(options.BaseURL+"/reports/input-tax", wrapper.GetReportsInputTax)
+	router.GET(options.BaseURL+"/reports/low-stock", wrapper.GetReportsLowStock)
+	router.POST(options.BaseURL+"/reports/low-stock/purchase-orders", wrapper.PostReportsLowStockPurchaseOrders)
+	router.GET(options.BaseURL+"/reports/near-expiry", wrapper.GetReportsNearExpiry)
+	router.GET(options.BaseURL+"/reports/stock-valuation", wrapper.GetReportsStockValuation)
+	router.GET(options.BaseURL+"/reports/tax", wrapper.GetReportsTax)
 	router.GET(options.BaseURL+"/sales", wrapper.GetSales)
 	router.POST(options.BaseURL+"/sales", wrapper.PostSales)
+	router.POST(options.BaseURL+"/sales/preview", wrapper.PostSalesPreview)
 	router.DELETE(options.BaseURL+"/sales/:id", wrapper.DeleteSalesId)
 	router.PUT(options.BaseURL+"/sales/:id", wrapper.PutSalesId)
+	router.GET(options.BaseURL+"/sales/:id/ewaybill", wrapper.GetSalesIdEwaybill)
+	router.POST(options.BaseURL+"/sales/:id/ewaybill", wrapper.PostSalesIdEwaybill)
+	router.PUT(options.BaseURL+"/sales/:id/ewaybill", wrapper.PutSalesIdEwaybill)
 	router.GET(options.BaseURL+"/sales/:id/receipt", wrapper.GetSalesIdReceipt)
 	router.GET(options.BaseURL+"/settings", wrapper.GetSettings)
 	router.PUT(options.BaseURL+"/settings", wrapper.PutSettings)
+	router.GET(options.BaseURL+"/stocktakes", wrapper.GetStocktakes)
+	router.POST(options.BaseURL+"/stocktakes", wrapper.PostStocktakes)
+	router.GET(options.BaseURL+"/stocktakes/:id", wrapper.GetStocktakesId)
+	router.POST(options.BaseURL+"/stocktakes/:id/approve", wrapper.PostStocktakesIdApprove)
+	router.POST(options.BaseURL+"/stocktakes/:id/cancel", wrapper.PostStocktakesIdCancel)
+	router.GET(options.BaseURL+"/stocktakes/:id/counts", wrapper.GetStocktakesIdCounts)
+	router.POST(options.BaseURL+"/stocktakes/:id/counts", wrapper.PostStocktakesIdCounts)
+	router.GET(options.BaseURL+"/stores", wrapper.GetStores)
+	router.POST(options.BaseURL+"/stores", wrapper.PostStores)
+	router.GET(options.BaseURL+"/stores/:id", wrapper.GetStoresId)
+	router.PUT(options.BaseURL+"/stores/:id", wrapper.PutStoresId)
+	router.GET(options.BaseURL+"/suppliers", wrapper.GetSuppliers)
+	router.POST(options.BaseURL+"/suppliers", wrapper.PostSuppliers)
+	router.GET(options.BaseURL+"/suppliers/:id", wrapper.GetSuppliersId)
+	router.PUT(options.BaseURL+"/suppliers/:id", wrapper.PutSuppliersId)
+	router.GET(options.BaseURL+"/suppliers/:id/purchase-orders", wrapper.GetSuppliersIdPurchaseOrders)
+	router.GET(options.BaseURL+"/tax-rate-changes", wrapper.GetTaxRateChanges)
+	router.POST(options.BaseURL+"/tax-rate-changes", wrapper.PostTaxRateChanges)
+	router.POST(options.BaseURL+"/tax-rate-changes/preview", wrapper.PostTaxRateChangesPreview)
+	router.DELETE(options.BaseURL+"/tax-rate-changes/:id", wrapper.DeleteTaxRateChangesId)
+	router.GET(options.BaseURL+"/transfers", wrapper.GetTransfers)
+	router.POST(options.BaseURL+"/transfers", wrapper.PostTransfers)
+	router.GET(options.BaseURL+"/transfers/:id", wrapper.GetTransfersId)
+	router.POST(options.BaseURL+"/transfers/:id/cancel", wrapper.PostTransfersIdCancel)
+	router.GET(options.BaseURL+"/transfers/:id/challan", wrapper.GetTransfersIdChallan)
+	router.POST(options.BaseURL+"/transfers/:id/dispatch", wrapper.PostTransfersIdDispatch)
+	router.POST(options.BaseURL+"/transfers/:id/receive", wrapper.PostTransfersIdReceive)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+RYTW8bNxP+KwTft0AKqJbS9BDo5iRI4CBohchBDoEPo+VIYsolN+QwjmDovxckl/ra",
-	"XWvlr6DoyfJyOJx5nvkib3hhyspo1OT4+Ia7YoklxJ8Ta4QvKPysrKnQksS4UCwcfQTC8FugK6ysSBrN",
-	"x/w1arKg2LvpJbNAyJ798isf8LmxJRAf87kyQHzAaVUhH3Ptyxlavh7sq7nJ646s1IuwLsXOZ6kJF2mf",
-	"hhJbN1RWFnHl+Nmu050pBR9OdGa9+WJmX7GgcMQUFDZx7HJKEpZJIP9o4n9eGq+pn4OPwFdQeWkIVE8L",
-	"LAKhON83WADhbyRL3G7ZEriwoMUJRyip8QTxKgX3RQcF3zxokrRqX3UnEvDAEZYUnuCs8zPqL03w4wTd",
-	"Xkua9E22ttyoP4C1sOrIHiSSetGSCSCERedaK8DMO6nRuT+7SoTAOXhFl/Ajc3PcWyxBqvaCszS67Zym",
-	"Q4EQLLyVtJqGaptcmSFYtOeeltv/3mZ73n++5INUm4OmtLq1b0lU8XVQLPXcNKPsfHLB5sYyYK4EpViG",
-	"hk3+mjK3coQlu5a0ZAQ/WAGq8ArCVgZasMmbt8xigbIitkCNNi6dhdMlhbLGg5aPtcQ0aTufXPAB/47W",
-	"JQuen43OngeYTIUaKsnH/MXZ6OwFH/AKaBkRGIKn5VCZhYw9oDIuplfgOx4ZcpVPjKMA0ocoNuAWv3l0",
-	"9MqImKuF0YQpLaGqlCzizuFXl/pK6m7NOKrAuWtjRSuz3qHt6DMt5K6TTdKi4GOyHuMHVxnt0lm/j0b3",
-	"sJTM36h7W3IQBp6WqCkchYI5XxTo3NwrlfLO+bIEuzoQjDFgkbzV7P3nS5YMCFUiJOSXKMuvwv7En8WF",
-	"dIT2OIUfs+S/k8Xn97C0ROdggXfk8ZNDyzLOtzOZMWa0xG3am2uNlkFRxBbWymXdH6O1C2xh8R3SJMuE",
-	"JLZQIqENehpNDsEWS5ZVstmKBSbYswosSVCsBCqWDJQy1yhCBwwVgH/zaFc8z3jpz2AH0kPkru6ZaJtp",
-	"6/8W53zM/zfcjsbDei4e5qG42bgaNH2QjpiZb/w+oCYuh2pcbWHMRGyQvQqdpTOLdgi4awb18rV3OuwD",
-	"UO9n9fR3WGSEYMA0XmcA2v3fDcbhjRTrdI7C1LL3QXkTv+fdF6IZmTGyQsvZBpYU/NC9ljDbjH8tcfZH",
-	"t+/J0kPfk50Mbnd9wCvfxrynp/DwJ8fTqBtTX4mWePoUvx7DNISTA4W3FrZpFHiKchIvhifUkmT67ggZ",
-	"Od8dHr9cBf5a6oyrvcqoJC9vrzBbJB6iQR+72N7jUnaXm8XDd/njVDepDd9ziayn8HD7OpHl11FBXVAD",
-	"0y1Eb2K/Zx2N+35mEY3Q1BW0Zcrpi82m2nbg0l1nHx2B/2hWjZ4mq/YaRd9o2fSRXlk0rK/GR9vJhaiv",
-	"yE+UTrdBXIn5PsKbh4+Z1BCH7sPhugHwzrPAafi+S28I6WIpzLVWBvZeGbow33kF6kQ6yzxmxOUzWkB5",
-	"lW9Ybit0EjS0vaRJnVgJmncQyYpvLVu7MDz8HLePwB0GuazgXgnaH6ik3X5vv51+MAUoJvA7KlOVqIkl",
-	"WT7g3qr6dW08HKogtzSOxi9HL0c82FSfddP9ziKNZqhFZaSO17Q6vaNj60HXgFuChgUGW7ZbNjNsc1vM",
-	"kvqdJmbQzkkpg5p7XrWgxwqj53LhbcYy69jwfbX+JwAA//+HBe1hMBoAAA==",
+	"H4sIAAAAAAAC/9y9e3fktvEo+FVweu8eJ7stjWbs3JuM/tI87Ci/eSgj2fnd9cz6QCRazYhNtAFQmrZ3",
+	"vvueqgJAkARJUE9P/kisaYIAWFWoKtTz90UmN1tZicroxfPfFzpbiw3HP4+227IQ+YmSG2kKWcFvWyW3",
+	"QplC4Ii80JmsKwN/r6TacLN4vliVkpvFcmF2W7F4vqjqzblQiy/LRcU3AkbaB9qoorqAB1u3wnEePC8q",
+	"Iy7gzS9+Lnn+b5EZeOVFXeWleOm23t+aW0wJnr+vyt3iuVG1WEYXz+vMxJdeLn6teWUKs8PPFTpTxZZg",
+	"sfinfcLkipm1YB6OrKiYrAQ7xz0u4Z+t599oVlcFgEh8zspaF1fibVEVm3rjNulhmcv6vBSL5WLjBhxE",
+	"AKuNzC7fV3/nVT5nm7Jia3gltt4A2Jo18Que/774H0qsFs8X/8eThoyeWBp68iOM+bJcXHFV8Mq84eei",
+	"TMDJF1j+17pQIl88/zlAUICNTxGaePn25OCvH8RWqgg9nBdlqeMozkRlFC/P+Oc0OsYv1QXA9wM3og/y",
+	"l80AZvhnprgR7E//558ZpxPFjERUmFpV8kqoxTJh1VVR8SorePm/BVfxD1kpuWl9Qs6N2DPFRiwihP9r",
+	"zZURA1Npw41Ihojhn0/4jgPppI2X6dv0QOqB+Sde1gLIWtbmmquc6RrhqxkgW+SsrnKhGqK3GEHqFAkw",
+	"j/Gdl9yIC6l2fQLLLrSJk8MrseJ1adjLH07PGlpYScUqcc0seetDVlRroQojcgaIxH1vuYKTer0WFauk",
+	"YVqYJGJZ6+qlzEf28vfTdyyTubjNNnqoKvKR0x0Ql+PNm6J6I6oLs148fxrjzLjqcYSnndB+MouMQ2bk",
+	"dq8UV6J0vwEVrPmVYJWsxCK2iS036/7M7/hG6ObDlZSG5fK6WjKxf7HPflAyE2rHPtYHB98K9ooXzT/e",
+	"FuXlMOdsPktPEsrpQxFKh9MiXqKctdZGboTqUz3PcyW0jor1CyXrbQx9bjqGI5bsei21YFtVZIKVhTaW",
+	"T4KYLIXWdILdO2uu2RBOL7Qpqv56T/+yl6254pkRigFsi1xUplgVGYcRzILjFhRdigtevksk6zVsP64H",
+	"FZl4U+g4zTfgacHjvN5pFiK3y8WTtCAcGWcZZ9dyLy8uCoOgw4HIOg5ZLlRxFVLhD6dnx++ICOWmMEbk",
+	"CzxoRiiY6f/9+WDvb59+f/blfyymhH4D0DF6/AHop0+Ud82HboAW7dQtJHFAUhxHqSfwlczqjajMGT7o",
+	"bgLk3S9y9QsKwB0hABbXvBTsmmu24bkYFYdLJs1aqOtCC1BZfimqK1lkYrFciArUzp8X7V/bKy4+RcD2",
+	"+l9896Ioy4ioVIIbkR+ZdC1AXJ+/srSc/MI7GT9lfFdKnlvuhUDg5UmwQSKXNoRf1OXlXr2FF9k/Tt+/",
+	"YzzLxBZY8PkOQSr2rvkOFQ+2lcrwchHBIuBj6KJxxcsi/3GbrhrF1BMH83fE0XqQvzkYe6f4afQY3+w7",
+	"wiNAK34a+bgP4tda6IiOb+RRI466orUsrkByW4EFzAuFrXbKOOmMe0a6IbGPM/KkqDLLJkOQPN372yeC",
+	"y1/iYDHypORZnO8bxSv9qtCGV1nkdB9tt0p+LjbAeHM7Ci6Vl5tDdsBKYUhCEtmxjJdZXcLYwgT6AW0b",
+	"PmrDP9NV8ruDg4PozTKgStqazKJUE/1O+8I72f+QM3gG22S5ZWdW9B4yRwGo7ShelEvGC8V4lTO9LraD",
+	"K721uHBcSsHJXi5ghsVywQu1WC5wgk9DM8B+hIqxdpJmUrGzD0fvTo/fOZYevLYYn/XdkMXjSqyLrBQx",
+	"IH1oQQL4jZ/Q6UM83AI7fsUKzS6KK1EtloNLNYIDyX7xfKHERV1yFbD45he4cP2SFxtRaWSPi09Tx7bB",
+	"RpeeG9oPz0/sgP8gZa4/iEwUWxO/YJ1J4KyxO+akwcIKnRe7JH0oVYUobrcpmEl5La1zWkCA041WAYaL",
+	"ivEKpTQpYUumJcOtKPgwUmw1A033QuSsqLQRPAeitQYOOk3wKowd3t65lKXgFe7PiA2pVO6PMXtPiL9j",
+	"IzYww6aojundRrXiSvEdPKykGdCEa5WtuRbvVe7O5jQuFKx8NapXJF3OboFPXZ+b27xN2E79YD+e1LJk",
+	"Ht15L8aFSIFwHM+98I1Ge5ZVA/HRBeCcZXwj2HVh1rHlDP/sQdpe5bja1nSt4Fe8KMGAxLhmmRJ5Eb2/",
+	"ToLwxtDvsDOi9ykmhUTeNzVyk61jQH0BDwKoXeD5lR3ph6/vGcWzS5H7W38MsMARjzaDBvhplhhYIua/",
+	"LT5vCyX0+8iF+w3XhuWcNGP8HrYB7VgwLcu8870crRs4qmWKHiDeOZz5NsApbgWcsqjELfjIRm37UH1L",
+	"ihtTwvCitPaSrYJvzsGY74GdZCK8mYNkGu5t1m3EJsWzcleOkFviXN8K57dj/nMcKjD2pdSmTyPwK9sK",
+	"hR4mdi5WUuGFHtiqZdv5IVKKBPxYVxTL4LWO3ab3CaOgv6WPJ0I0E94elB1n/POQw2eeM0TXmw0ns36S",
+	"qtNe/YO8XnzpqzdTgg/wQrIukH/AEvHGJlQh86SjbPhneBX9IXftgPkyCXr4+KiyPnIS54miKG+eM3sx",
+	"a3ZFwj1iRUDZz+xzVkkjNGo9DNg9WGERc6oluUJ9bea29axtz6WCGGbfyOtTcCbHFRvgEmjvTNtQzoty",
+	"d8pLEYHk0ZVQ/EIwd8RRMUDGBWoDXD8HDoEXBOnhBbJCtvLPBE9+beDSmhfVBQjUXPGVwVvTFu6vOXNs",
+	"ipinTtvXRICBEjjZG/BetQ/l4Iz2lfCDEt4CCCfAABHRQcCSVcLAZeBKFrlGiChhalUlgqAToJDyRn1x",
+	"IbQRKTs2kvABf2SdnX+j0QiuGT+XV3RjsdBj6C9cwqEtBdem9cyR5SEc8jWQAwpHUcn6Yg23bFnRmonf",
+	"37rYdR0IYiWUEnlz2be3Lks4S2Ylgn9eGDTql7Br3IX1wSzGLomDtqjbRHIkCAvHUk5CEa/7zGXbe54k",
+	"iFvTxoRwXXGti4tKRGD/Rl7vIW0yXIt4OWee+Br2dF4Dv29Q4GnOwj1pry3u2tvqGPCGlByu36+Srvw5",
+	"38Ws4nyHjio6IkBjV6KUGXxwodm1VHADlbVhNkolcsuaZSCaD4B3gqvXcNHc3RoEeDUSMyiLjh9e2mOE",
+	"5UCaEq6GUvMo/3etzcaGq7Ux8fozz0y5w7gxQIgwS+BgGcZpKcZRayDFnWIB4O5XaKZkXeVNRNGWF5ov",
+	"ll0QeZ2jI4Txd0YuDz8FTH3IKnHBTQEsUzLDL9GbIFerJH3UbjzC6+gBCH4jwcyvRbMmO9+1ly3lNTK7",
+	"pDW1iKz3zoFq5nVmEIUv17y6EBGTT11e/rgFyouxeHAeshof4+dmOEvjmC2qJStWjFe76Bmj4bPcpfaV",
+	"FxGx+aMWioGulLvAxP/ew9/WgueN8FHkZGNmzQ3tstl5bEWxWokMMPf9rHvXqhBlHjpwHLr8hSBQgz8N",
+	"moP6QKvE9Yz7kCzzGaMnNDotaxXz4+FFD6539n1LEqRPFRtgb3oJl0L4WBwIrCivS5H7MEK9JII+tU86",
+	"w/CZXrKGHnHAydHZy7+zJ4Et0YHbGlrtoovlojX7YhlQdtwHFD8nEC3RPyUtcPx+C9OefuW8WBHqzklX",
+	"4xT9oEUpMqNBeEOQxhKBHSBBs02hNWh43ldKr6BNTRwyWVm2DK+zjFdgxoRhzpMW85skR5ZYhIzY3/0+",
+	"rXJCvNKa+2BPi+UkyFKjTDzu/C3vLoKqpxFqilkqH2zurBBq0r/0cEHK9AWDMPUH6gaxMJPAnufZFFWu",
+	"jyIn518uaMh6gS2huVA8fsGL6pDJraj2RJW7U1aKlQENcbGMb/7mMaLWNSg+880WALd4VVzzssBTvRiK",
+	"0ory71G75UxK1YYro9NFcS+WnaSbn2aQYpC+e9SyKarhC/EbvMb+2on3d8JGNiFhmJJQGLL9LknEX1AY",
+	"SaG9wnRHNnmPlw6jBrOz1fu8lXq+mhYCNwSOWzcOX4RI5CqBCkx/r9/zUgPLzUQLooVmXGVrcDknOdPP",
+	"uaIYnL72f/Ru7+m3S/bjycu9I9D34Ye/MvdCB5WHgLdfa8F4pqTWoYvQ88++E7bDGvE2dEZOxoiibm/V",
+	"mm1qbVBdZNx68kCAou9vx3IMQ8DIaBCpG5EXGVlCUcii5S4Ch3buTgQeJ07mwTyWoJEJ2ZwaAD3qpHJ1",
+	"COK6BPmN7i96DD9ppPCG4ade0ruZRRHYubjzaGyzfdbDmY+451WgznkGyrgS3cDuIh7VO5xp8NIGeoTx",
+	"40m3p5ZlN9Gj5FQQ+AzDLkLT+I1c91Pa4WBOw9+52siq+E3k7HSnjdgA7N/JjaiykptaUbjyYsblodjw",
+	"i/mWgmN4a/Fl8FObsJdBSzV+Et5E9HB8auTFNkDe4x90ao1kVzCfdbVbLWcYHw2bHEm/sAwQxYWdEW+z",
+	"F6ISijv6TVBMB8XDqT3U+HhJkqt9y2HX66IUqJlTcBTdQNMMFKFOFvvC085afvrgop7uCv/RqqERCdiR",
+	"J+dgXzZoEECumnFNotgpQfaHwYApmPR7nhkZyZcCVwyeYu0yFN1bVhHAJZ99Z0kFloKT9Ow7ts303SkE",
+	"Vr8cYDf4cwssxMw5ckld5D1C0DfiNl23SwdUaBS2CZK4tmLnopTXQHXZur2/tbzWnht6i7Iie+UNwCPH",
+	"vVUdNc9bon3Uf0BPdjKRL24USxGXMqcuhHCejNGX9RCgL4XYwmkHMhzRbiJZK0mJrxaNRQs83zj1t7n3",
+	"41428kpgaHIp8osBB8/MSL4phw9mQG0C2rEOiEJWGvWCSSfPrOCRbd7cOCNxW9Y6aWQILTgAwDh0eDCG",
+	"Ln2Td9DuXTsqvlBsed3XvmKZ1Fv2hH0Q+Yy1aFI9tBroljaHzn0xvAi5pLtDxv2PaISR9pVCk7fUrBX5",
+	"BY0Xh8nq5k/h9qaVhy/D9xlyVIxFI0a9QmeS/CsD3iHUT41ELtxEmAWGerwWFQbz8igkMI+SaCtcMDHK",
+	"rz+LDYxLD2+Lay749D6LAdBVaSzDPh52ciXyUeMMzQu61qpQ2jD3UrL95Z69vY4W6/KSfCWRE2cfoN8J",
+	"sscOGXoBOnehSjpWlPccWkkXMCORl4dcDNacvFLNtR9ZNWbSYhm4/6Zie2a7pjzQvy9KEzMcjUHM37ZR",
+	"QEIKgb/DKtB1gaaBtcFbosotd+sDMbigzaMU61/ob3rS8TVtG70nRxgJ0fQdJHubg4MDDFrtPtTViGky",
+	"MKusPOoT17G0cgOj7DDfHDPmGGnBdghmmfOiEjnJUwAo7Z6U2HMJng4lfFJT16wVbKl7tw5dKrPc/I0H",
+	"1wYnTN3irR418sFBXvvSmnukak6XpU2M77mWdZnbXzDTh+Vqx1RdzffuOIL7lHLo4oF+gwaKSly/bHGS",
+	"qOP15ay4xUpczwgqrMT16azpZZlPbRmGzJ1zxpZlmc/b8oQWcnOx/PrzVirzUpb1JpK14QmzsCUX3L+3",
+	"3KxbBTBAL7ZVLz5gtIi3VMOp1WLLyQp0vmN6yzMRepyLfGG1s7bZaumdIy0DQTwSoBE2dK8MjOuNeXbR",
+	"stRa1adjlYlaTtoXy8AS1oF9eJdaOu9BLEihZSDsizlZmSC1vzH2oBnyyb+34mIx7PabExayFsXF2gzY",
+	"PAeobSrYofgtZiIofhNOgOJXAH9Hxna+M22LTVGZ//ldVJ8w63pzXvGi/FGV8cjFgd+vi9ysgycToVkW",
+	"N3A0XislY9rTwImhk9RRGORqJSiEOBNliQZD+FnA1OgzOseIukrg8xiaNkJrSym9Z0pe9/fxQV7bTGqn",
+	"ygC4yWxqN3cuYEdKXrOn8TIU44B56y3vLn2YyG/RtT3TzxBQppnAjHtYk2ub5tW4sLZaqCYEJjQ1kNg8",
+	"/a8f8Vd4PeNKFS0u4lenecZPHWYpDIQP2lM0IsXtCBuGy42V1efCPenK6z4lN2pcX21DuriBr6Eh14gW",
+	"tLHoSp4J8UvkFY0TNRwQoZkSPF/S3UzWlXF+N0tkYGU7L3l1iYPjlqpJrcmOiMDbPpmC9wgxnwrw2H4Q",
+	"ui4jtLAuLtal45BjoKNp/u6HN2wyEejJm4wESq/q336LXGfe2UD1DYa55nDkYIEc08TpIoMTMl6CHRvT",
+	"jHZbqaMu2rLYFANyQq5WWgw8+7UWahc8CthW8zVzyLyFrwidm3h6VRM9hdAgR5JzKZNZmUOhFvTyJRPQ",
+	"UBVMnrnfJ76IJjjKnKkvjDewbHXocpfJilyBOnmdl80rSdrC5E12ykE7FFn0/sFihvimo0G9qHfsGbsQ",
+	"hj1lKyWs02Oi2JNU3sRnsXKw7DMrAjJpvK6uIzAQoQ1zk5CRbskEB5+RZNdrmyImK6GdL53AsbLxfAOV",
+	"zbTh2aUrsNihlp6pEGtEWIlK23QXbhtFiFUk9D57XwlisbkUGrm6C/fCqEdbTY6Gg4GXoif9L24wXxkM",
+	"1N6P8pJbhkzZq4I9ZJ/GjuZRFj+g5/UudKd569rTZbSIphDpo020Htc2iHPnl0JbF7z9GTREIolfa14W",
+	"qx3wJ8D8IVuV3LRegaGAyc5ITWb3rVA2oEuvufLFqApFbx8izf8CDBdNKZqFHwc0RxpaAB+2LevOsGBp",
+	"ypKBp0sbDi74Fgge6TxQzxoAwCEv8Zrr9xJV1q5c+PWkl3l+YBg+HaWcly322smSKEvWsF+ySVFY1FqW",
+	"+T57g/iwUPJe2EI1+VstpyzFjfmwCbny+V0ts6vzRmXteKJmWpgGwn8hn5vQCIRh9wGnkk6vKPCoevFX",
+	"NRaogu7r7gvQlcU1LIz8yAdbVTA9y+X+iB2+LdmnzXTgeXq/+pcQl8kqAQzO+S42m6jysyLm73kjM14y",
+	"4DPwRZBaCkpr2fbiWxZpudmh48vIt2BaGw9xXVS5vGZb8JFuirxCzS+MzXj6t+cHB+2Ch3/6+eAp1QX7",
+	"/579fLD37ac/P//5YO8v9FO0TtimqF5wfSnMQOa4L3oLm79ey9KFdNpd++/Ry6YEIFKrApYzO69/NOI0",
+	"ZCU9NhFf/c6iSkOD8wzC81hNIRYMDRihlTb6/9et0R9VPFsJjv37ayn1HYaP395FYlOlfYmJlC2Iz1vM",
+	"uIjVknnlsqYowpArwdzwOy0XMyuR8aRbr+Lmpa5sbMdtMKgNN/W8PZ/SK52qJSOsxm4zjMYdj9ueGSMz",
+	"nbg8CYaw3MbjVY4KPms5UkaqT0EzKorH4nzbKeJUBU4fdiKecPCt6rzMvYtNYi2ouTAsaE4NFFY1EqxA",
+	"LuThkP0mlGzC8ikKDtzXyBJvFrh14wCQ43hwGSVtGbaR2jDM96x2LBdZseEQ1EhSEoaSWeZOwywJTONV",
+	"ImaVRJoKA3wgErybSklHF0qInCofRcLbH7oAUqzJRbD9SRZy6mVAR3wqvgIjNq+YxkN0LpjIMccAQ1tt",
+	"pM1OOMl9SAdJkzSH53ILyFJ4MSh4We78EaTHzQE+tGfPTXEtFFxrKnB0iNxBF0xvTpgrG7zkLo5YcmWx",
+	"XMCa5HmjJX8JAp2CP2m56IUSop3jbUBEfian6MdXvLfBQcOCpZ9LYV8d4iGuac6Ago8/ow2g8rd/uvKf",
+	"7wLt/mYpFp0q4mMQaFUcx0rQtrR0pLDh66b4NS0GFAI0kPtyVpmsoAoHzBhT0y4Ur/IZQB7M3Ljfeqzn",
+	"9e5rKcYK9B+vszGvor2vIY8vUQzyvCyIhmYjazV2VLKh+nZJJM7NWuyIjdjnqXG1veZVCRE9et5BB4PJ",
+	"HO/7sKZ9RqW7yGq3tPbU8bMeV6d3KSf7tBk5pTX3pZws8ttcVmI3XE+p8djlWAjpC3rgTKNkhAAiJb5J",
+	"NEoRskbasGRvpJx1fHz5lyniIWNZ8rQ0erqI62ilvIRbga/L4arTOfuGzaNOyw18v8LSd6eyzAcyBF27",
+	"CxhGqgP6NShfwAkBkGNLiyNuiLXgfprWFHAEqCvLRph1vADitJwL+tJ1T1pXrt6BWB0OfL3bMrTdG0dL",
+	"2jiML73QATtVhUJJ7ZGkQYBbOujLIYpQdKGZN4LEeNnbWR0BU69hKeXXblF3cfLOc/sDligXntMVhRIS",
+	"YRHtWd+S+T5JnvRTVp57k5oR9DgnxSeWuZaQqpoiU8aSYoaoayxjZl4WS3K2yiyCHv1eL4W6Jajg94bp",
+	"IYSDVpCrwzB/3pcX01uFauuVUO10epxF9xxCRUQ8HNtmW0KHS+AuwDpSNHUpFmOGpHvmETc+ClE4pxS2",
+	"SMTqYLeb9j1zoMOa7YRos1NtBehDV/BPAhG4oba+EHU94UqwF89eJNb8a2/sP8WGdtM+pKN+gOSrl025",
+	"4y7lURu+WtmM5373IofFb7Qtc2UaPzS2IPtGN7+5t4OWe9OtyYZN2d1ouMGsO5vm5XM+fHDWtVTwu+Lb",
+	"LbmaMLg823B1iX/BGbqwlsRgarxh19SgkYhZV8V2K0yPL02FLg0yGZu0nCJxhIFwSD2vRyJS7DubS4nJ",
+	"0NEABHltjyza7HwimdW0sT4jadqUpI6G8T85LAPp/3mgEosGNq4Ha8Nm9pz2H9y4By7827Z0XTK9LQvD",
+	"BPiOS+gPYa6FqCKqKczgv+dpNN/ct7V6enAweqxbmz+lZrCxvHQlKIP+otAGXV9j/fMcS81kWYoMa8XC",
+	"np2JtdC6pphM7EOM6iHewqNosR96ZovyJWkQYsOLeDS8M9ydrZXQ64EbnDfO2bgjKpdMrm9ehd3tECa2",
+	"fYdHyV8ODg4O/jzbZj7Qr5NEkMuktTQa068qXx321XB9Ww7OapwKhu/Z0kVUnoGVUl6iZdV9if+kbw/+",
+	"HN9+aNoabuV5wzZxj96Hc7nwN/C3dAGfTGBvD48zR2BOvtZGmz2WXJu3tujCHHOanFNPfCqLBfbnK4BG",
+	"zBtXoS98uAjCnamXKd09Yrs5DGsyNV09zFoQO7PtkG92x4CFHKZid6oy3r+wU0wFjZso/t1USRBMvbKl",
+	"xrvMIbXBIrOT+bBzVeBTrFQeJIISdqOlS4IiDGBgI9NbKfiVy8rQRiqRWnyA6+kY9hYFfKBXJtqa3uJk",
+	"pZLKdGiH4pVexW9KZ/ZZYL1Ed0Ne6K3NpJCqcXjK6vb1VxKPd6e7WoE+2GtR2RIJN4LF5LH+4OnAeWTt",
+	"xZz6PSzIAxCkTS6Wi0YfXTSwjvtk20sNXGzn9k8DuGRyg55otGTwprAVPg86jNmcLd2qrTdg3nnAnmbV",
+	"SZCFqkcCLeHGI+vKiEg5o1Y1L1Q9M1ldCWVcnn1hmkGtdNe+Cnr3DchGFcFBRjrMKU9QAbe8b+vrVQa9",
+	"SQ4Z9X0gjDSXprkc0Qe1NyTvT0MzbZTgG84YKcXW4Tg051SVp0TFgOY9V009OUsunrX8GpAUxbRDfV+6",
+	"3XT9OUHtWEvetFmqCgxWGRuEHDQRh4lqhTHsnRZjLv9nn72T1mbjUoOI/19IoXsJQvMNNhaDE9aaUyeg",
+	"UIkdiMSbqnnwx7RROlpJmPqqV/1+bOzs+gNtIN+6lUdRodguzIBi8RPdYU2ElAO5blweE+pJ2uY2NTWV",
+	"fGv3Kndz5JxaAvkkCXTc0C6SuIo3mQ6VDAE+3dKCMD1EryWtB/ZQbiLbSvZr98k9lj98s6ufjaL9KZmY",
+	"BmkFTFrzqhi9BLFoE9daJZ96VY0Az1jLrj5vMmEOg4SKjBteyou6F6fYP8F3EH6PMyQWb7ey/yQocpMQ",
+	"2n4vIfAeTan1cuIV2U5rsDBp9s9v2bODZ/+TrWpMt6rjYWJTF660TgrO5eQJbZnUXdrpA91+7fC7FaGU",
+	"GqLkFS+XTQycFZ9tFSQlql+ZiXJwLhMiDMtHZWKl5G+iunGBRrv2XeWE2OlSoT43s8GTYpPVQDIqEwMC",
+	"4p3oXDzdeF9v0mnZTd44SZPOpZsTa7aJjrfLHBhsy+G/78jSVp8zTlDnCCkumbZH8NcasKTKXQtH43HL",
+	"dt3RXb90kRfda57KoobOF/SA6YxXlciXaKQVOau3xI+9UoWN+LrM2W50ubhWhREN9BvueStuTVMksutc",
+	"XMWrW+OXqYbQ6Io/XjR7fk5TS/uMu+Ts90DQN7IwFIwOM8vbe2/bHb0yqZTIDHoUuKIsgW6wyoiqEBLe",
+	"tE7vSW/Ygw5PbyDxcNZJT+8Q8sHZ5u1dlhCkBYRQTNviQZ429OQhtMNGITHU0RXRP5x4Q9HoIU/Unpkc",
+	"Mn6u6X4HObWdAB47dZpa7OTYWAZQaBn0EUOeUzkZk7Ye2BPz06LKQGQoExcQ7XrQAaNEV9+S6cKlHvV2",
+	"sQRmZSSeJqcQIJIpFwKV5lt2tU3xaDhTW4wDNOVI2VZis0sXpelkYajN3KPV80dvHWgZgdNQPBF/lolh",
+	"inppJTyGzjkCvEOanlBBfnJQttc5bySZ8Xnz7+CBljR0g+JK+CNumyy4Wk+Hlh6woI7URnd0J7Q5EonD",
+	"EIhF5ArjgcUmLN5lp1ssFzQfgtS+OJTOY6QS82IrBoMXRt3O3nES8/4ftlzS32gGkSeoB1ZM1qYUxl8u",
+	"gMtTJskdVLSJ35lgnSqQJiUksGNPgLXw24HrTkLZm/vxZCdpSY/u807Ww21q4zwyHI7MGKDDp3/ZgxBu",
+	"nhmh8LsLG0GZUWA8cQHQrIRt/ZqVvNiwotrWBsNOMuX6Ot6U8Epxwct3iV0MB2nn66WB5vsHCcHn2nRu",
+	"L89eNLKL8sXWWP4QNxeayF88exkwRYq2hN9i7C+IBbpNkvhsx8wN27re6IYyPRw+g6pjD7bZ9bFmro0F",
+	"2B9coUa0AwJYdrMy2G7bCiXdZRGvddtGQowgLXk07Yk7ul6nqYfbcLeznGsyWvluZUsqtuhsp2iMhvLc",
+	"++zI9c23LattEozVAZydNgwX69cP4vhhc+2ZN2i/psO+y65smZHwdVlZ2/uWRH9x3zR8T03YxkMUb1+u",
+	"74ZHd7jNmiWJNNDehit8ZeftRImrQlxH6omO5IWNllifWz99fkX0uS/cYQHzLwPwHHAKnj87P6a0hXRL",
+	"jZ/Ovhlzca1mnQpdbzZc7ebv4IO8jq1u5IxyiMPgct/Xg5pXLSO+U3xlRrvzli44EuIQOchzM6RDLXBe",
+	"fZ/erg3lziV/5yicP8jruOp108zd6dHFzNmLebMHRyrOgG+aMHmvmeq3x6gLT3v8Rtx5oURS5WC351f+",
+	"BZvirMSWV9lufhCEj3CwaaNShwGPSS6u9OjLZuLbwLaZJRG89+P1dri4Xc032yb3nYzpOPCr7W5cuYa6",
+	"je2ZCoXW1JqTs4AMUqDY7jF2M0y4ORLx4IaPBIUkNOtcCSWiweWNex+tbtZ5gga8bzTLRVlgbdRszcuS",
+	"V0uk6KI6l3WVMxerqhc3dj07egjq6cHS0Zg/g8mgYfQq3EBc8CoaMawpsbvBgZLQUonk4nhXY0xiKEKK",
+	"9y3SMyKNQ0W7YXYNhMbK4/V5Xj+HvDYdNCL67VdYm1ChrTHXSFew57CPf3YOYLJvFlQ/xA0PTDTSrgg7",
+	"pynitpqQR8yvaUKld+Efe4b6s3sXRLsMZlE9x+jJDG0baHMiM3T761gR4nRpI2RdsLJvfto5OfbAdCmS",
+	"0kdms0pfRaXnJG3417Cn5kNLXEUFFX69C6atDNsUWpOH4WYSqydbbzBNcP2bPJ7J5RJv36EypIVoXskf",
+	"rwbhlC/TJ3H45r2UFyvlZXBmKD2A/IlkyLLh0WYtNj76maoGEUfcNJZcmgLmx7gnLa4EGHncaU4xI95v",
+	"kghtjZvDoAZS6Ep9INYwk0KubnG+7qHi4pgoSisnEstYweS7BlOlWBmkP1GRa7HR85Lo6JHrk0yyFcdV",
+	"cJ/3zGM6SHWgCQGRiN4PFgM95A6EbFPRfH+TshHaCmulHNqKjb6hrU3wsGNBw6ix9dcsQWp3OBSzPXDb",
+	"+DL9zXFFZULSpPH2m5XX6M09hrihcIaIfsiVYDkVRKX4jlCXCBUreqcwxATdKDy0+Av6kOJ3iaWdOqhS",
+	"2i1uWlS/2Pk71UyHIyCWi897MM3eFVegK2iYzyvJdl7PqVx6xKKDalrG/fSyWe7LEsNw2h3etpnutXf7",
+	"0eYXbQTXtRKHYeBxUbFtppfsAuXIpkRYUkQ94Vwv2SU9LG0Xqm+DOi7wDv2s2bMAarSNS4AB/A+OzQb/",
+	"L6p8/9TPXu+4MeR1J1WWhCRsNsNAqOdWB4CsKfpL1sSwbajOhiJgrrGbIghd0AUu6HX2p6DAyp/32VHf",
+	"NdKElPnIKytqK8ovdV++KlZysVy4dY5omYHPRln4g6iE4vEuOHKqA35RsRJkKZXePHTVuWyWI89tsIGL",
+	"3LTzsfPaMPG50NgMzrW/R3Beiq25cR/8EctKh3O4D4sxifa0g41umy4O0LlyOlyGQNN68+fF6WK5eLtY",
+	"Lt4sPgUfPTFT+mfaRkh26djHug4pQc7iBgnK1ALpCE6/WdfYjKdYLBcapb2uqwhNgd4tsloVZkdFUkjT",
+	"EVwJdVSbdfOv7x3X/8e/zmA6HL14bp826sTamO3iyxe0Pq8i6b1HJ8fO4LiBGtEuzIqdvD9leqeN2JB/",
+	"GkNceJnVFEiJrOPk1fdOiWIX/hTsMxv8S2QMIKRgOy0U2/BLG2i7oXq+7UyGQ5vgCrN3ogo6QXIuC9J2",
+	"wCoMUhLs2gpYdkq7Pzo5BgwKpW2kz/7B/tPFFyp3zbfF4vni2/2D/W8pamWNEH/Ca7N+UsoL8uds7aVA",
+	"bu0nHueUDGsAKW9wGNGN0OaFzHdBh1n4E9kQBRI9+bdNGaAzGNEAuNZQminefFULNeDE7KscbVq24fhK",
+	"6K2sNK317ODgFjs18lJUyTvpkF0NnNcUZEbRdZYJrUE/oyPoPX+tgUGeMfvHv84YbWC5MPwCRTOMXXyC",
+	"9wl/LpBwGoUf3MivE4tPb7HT4b63KXjEoxsEbI5g0sG4Fc0JsZxCMZ757LM+Ll2X6Se/w3++oKdVRJD5",
+	"gzA2i0XbLtVbrvhGGKFgyt8XcJjxjLtm2M8XNuWiDeBlAKweTOw01HXTz2OV8PDNRqfTVxeBgkH/2lYX",
+	"Mf7/afKEUn9qeL2FVH8LOC8qrnZRlza9qq8u/u/Pm7L9endwD9EWstRYGoj4O9pZNx3lipdF7pNZuhSA",
+	"xbw4O29N1iDdhyQR4oNooBGcv2xG3ZK9JelML12j895tsA+0Zmu+lc85dXbvQAZqE2KLhuaTfcW+QjGS",
+	"Sw2cgk+GW8Qwb+vA5macLQ0ad8+n0tcdCEdzbaaHqPXH6rKCWHLb9U8qlte0IRvi3RSfoyFd2ZTnQZje",
+	"EH7alPzk9yL/QlsphRF9tL3C35sZjvMkRlbkKWysiQfqM5rvRgL7aLMWkmMDK2nYCp00OPRvI0OpM8qa",
+	"dwL/AA0+sbgNbwJNAHI8I7I2IzMMHpppdvJgkD940GMxC4kt+P8gTAK9LxfbOsaO6gcC7WMzuYfFpuvP",
+	"nsjklva/rKh0kYtWC1SbWQOV0ZUQyz4/vDnl/IibTGWWtqzuHtbQHZf9dugPNPJB5H+4ZJISYF+gksBY",
+	"95KA2dcAsvbQEEj2yaTA7wPkHs5DGwQPLPn7i4/Be1IJeEtO6z614wGo3elpijZHdYDWmrZ4NFphsXyT",
+	"VFRJegChEaJPVRNa6H5UVaEN9UmFoT08QW1w8PId1UpZXbjcigtLDHFtob0WeqlB7aikfzRy1IZk2YNB",
+	"/g9xfg8e7fxOybf553c2SQ6Istb4hKOdJMkeVoilyC+UTHIVnJWhu+v0YZqUW/ctsh5LWo0S+pSIclYV",
+	"W6JbBfmYUWkEZTAdMlLo0gubSeL8Gq9ECRiI33PGITgtGr5SqfBYAmH0iExJgfQj0uPfIycEXdZ71sM1",
+	"fUywXZv1Pn2NRyXcfwwbPzR5DFsDAnL4RtgfOmFVuOjPHdiFqQgsmKYJMWg41k9+vxS7ECOd2G+hrmz/",
+	"o1VRNnm3WLGBbM8UXkcxiPTDjx/eYOlVn2i5lUVlMJpsf7Hs4/wYd/JfYpeE7UuxS0H3bHfA/zXXGdDD",
+	"LX4HwmkQpzRkCI3fC4i64wTR3EITi9vUm/OKF+WwnR+Vsz1QzkZVpBPXxedhdCS/XIqS1DQRGr3gN2qo",
+	"boGjyIi2x1SkzuffvQAIPvhhlaTOwkOQvflVPqolNbiw93WqsL0cv7c3uOpQbuKNvcHiY97WA6BO3dSD",
+	"odO39LO1COCKDe+0rQcuqSQHPs2pmtfQhX0bNkPmJryoL+3tDDADGKq1GDpJ02zkKxTRiUdlJjYj8rjd",
+	"pSzGqAa034cB76NzvwdH6c0NIbchhg8CZmifSYlRXILZTAgX55DKI+mHREl/nJ/Q6D/iQZ2nRuAfybqE",
+	"ti2SQU+6DQLfuJbydi5CpE14mzjkI9h78rsPHZ8p9OjrToLA87tH7DI6SzvY/R4EqmZKYEbQLIQxWwHS",
+	"Iciapgk3smKF6Z1JWIRx/wZlTiaz7G6e37bkmb0nmUKobn0iCKfGxrFaQBc97sgJt7fyrzUdJkuuLoQ2",
+	"zKYhNE0vWh0w2ou0ul0owbM1VIenxor4OwSnhpOatYVjC1yYHGD6F7WOXPo6afAeZZ7lTY8k+ILVo6dK",
+	"CzNp+EHKpXwBT1Naljkza0w4xApTLlA+5Xw2QSSDvPVUhGfBnx0eEnUik21KcQ3LRDumR6xdqwdX2bqx",
+	"X9jbKPvTlitT8JIawLoOMH9eLKPxjPifiTjITkID9IAY7v8AiKl21E1DYz9ZUeWcCi3HNhAECowfsm7b",
+	"Vi0JfwAFTPxyezpkGkEjGphU4kooCxBbLjW2F6pXJo7sjPEozxUvteh3dXooJcMrCqnunIEYK+/NiURQ",
+	"BWYbIEKbldklv1Kg2Sygv+NXS/jPqiiNUK6YHSW+ugQd10HkY+WC/bG3w9LnCwmsKEGtY7ClMeOlrAQI",
+	"J3faQAB9rHwyDJYX0K1zj+9c7DM65m4l2MPOepI/Vkpc1CVXjYADYdTIwu/xIyiPiZqf9Qht/2N1xHK1",
+	"Y6quXFssagBgK+91Kv9RjCfwKoBIJa4/VpRv4sPbcKcABV7tDDRv3mfvfUXLEFmMK/GxsjcFOISyEpQl",
+	"x7GgQ9AfA8tjwoWhhGjs8tLeLxAtH6uGra0LbWxtvxASTAnM7sN62/sfq77IBfoYZlqxc5ar3Ye6usHx",
+	"ug/JiBuH6pbkMHh44RjdQKT4Zm0dGrCUoM5FWyqORyTAHS0OytB32PacSn7A63QwgBIC/7mVaCooS8mb",
+	"UhD2tChM2LFi2J46sKdpwfSaY4K7bUcu69J33HRkDkc1Gmj70pUVtQstfW6QDneEOZIgZcL+IbLKxCAP",
+	"GzG2esK9R/KaYWgd6CSR6Dn28fgDdrvT//oR+TINY7xUguc7G2SjgzouzZV0wO/sno8Y++mfT4Dp7BHx",
+	"Jik+zVF4KPt/7wROS9fgRDbcvaFH5KDAk4nyBy/r5+E0WJbKSD/LEmSF0IbyZBMgLT67Ko9xP5lRgm9c",
+	"lSvbhWvZCE2r/uTLVhKDpcDjV/SZru0gmQfCA0oN5YNGEvvsrDEbsUyW9aYCTbGCjNdiA3uFNXh26ZX3",
+	"k/enZ6z5IBoUkzoBvbymr06SPKPpO5m+Cuvo478+l/rzYrn4d7sPz7B2/NJ+pZGMsIElGmzOr11Ku2g2",
+	"BxLySsJoBudRs21Za4Lle+rDjSUXSpkLLyCjujTNt1je8AgQJOkTJuuvabMrHUgXf9RrQnsX9jCi2LLC",
+	"CunV/85R8LnOyFjdXxtemYFNWf0LW520NpZWejR6pyGq6Subf+grSxfoglEmIZbS8fYuWfreO6SGiJw1",
+	"JNtLuO7la4ebuaryfbkV1edNScDWe3K1KjKRy6zeiMrs6y2epbUQZlPu43/nZ9IZ8dk8AU4wL4nurM1h",
+	"QQWvGDeGZ+uNqMzN4+vphLYMan6dBAFBTCZMk+3vm4oyKHntLHcQK4DI0wHTsmncep+9xgsbjC/gAlYW",
+	"dCc5FyuphL/IwEPoGWbgrodJ3w3PKzTebaSCz4bBz11RJZp3xYtSL8n413SLsVXnpY8Z8tMKpaRyWeS2",
+	"7a9t/clNrdl3z57BddLd3NyemwiSsM9x029EYbFar8TC1+AN7bhi9VYLZdgGdSrcM7EXULdaulVTy8F9",
+	"ihP+0M3LyQNf2cZiYMO3W3jnUohtp9yPE8d0kYzezwIt93iTLipvckkbSJrdUPLtLJWZtvqW4rpGL3+b",
+	"ujTFlivzBE70Xs4NH8uDBgRHTt7pT+xPmdxs+JJpsSkyWdIdyfBzpgXAy4j8z/DLf785/W8kk8Vymocs",
+	"FxZ5/SX/cfr+neOTaNn3Zn40exhp6cEeNRu88PtHhOrHxXP2cQHS+eNiyT4uUB2jH99+OPm4+AIWDLyB",
+	"wTGgMOlg+aW7X7myyUum/V+2tN6S6ct66e4KehlcCOuqMMtWt3M8Mv32534XnqTpVPKgApr9Sjox9K1k",
+	"qvMnEsBBn1BcVBIAyzKuYYNb7jojbesqM1Schk5BU3UkFWQx7Hm50cYd0sC1VJf4HHAF39TX8LzmPlot",
+	"DYnp0wPUdUg+d7ZefDR+bGtrceVqt0eWLxo7mJcGoIHa0nholt7U4Lga4ReczdaxnXkF9Ltnzx76+04l",
+	"sHook0TFqlA6HDrJxK659leXjmy2kPHapvXWAWfpcI0pMQ0tOettyl35DY1MYuhN48m7jEu8I9vIgNmD",
+	"K7VzOmPLtpFqA/kuagXbBvMXls3ESxl8X1ilIjQ+M+7ap4bdPCeQSk6Jwcv5W8vxoJgI2yqxKj4Dz30l",
+	"rnjFL7gqSCmRG14VWuRMb6kPnPfioo5CXBIbm6MK5cjW8tMlM0JtyGCBFkOz20qUctcSqvlo5F3Ionl1",
+	"6dQmigypclRnHGdGzjZxMycXVRp1/jpKl6N1nobUjrLYFAN3/WcHIJg/U/W8pwcHQS29p8thH29nAbla",
+	"aTGwwkG0PN9DBY8h/An8FquxYwYXtS2G764cwpUf3XaARn2NHZWibVxMOBKpkZRWeX3UOEo6/dNBlD1P",
+	"8mgEpTe22z5PLSfWmmt2LkSF/u3GKCdVUylXKndPsIV34S7FPEnYctd1lZdkrC80+aqbtp9Skd9oBQYq",
+	"a3VgBeaNG8Hz4dDNEfPvWNDg/ePyka34B8NUkZrcMiXB0qnstvZ+n0STbu7H4DFLSMOWhqOufQulTvfi",
+	"ax/a4G2SoP5GwkhNQsnkrGbUIhXgci7ssYEKiluujWss7LJ87CUf3nO5xnpNUUibyXt0bvf/FcYVDypd",
+	"XZTMIb+2k8hykSY+BkAdsrZUMnJ3z/HCbg1WXFmwB0LL04dAi/0mV3fxToSPG+n4AfXQjGvAtuqpoGrk",
+	"RqiKl+z10bu9p9/6k7hqhWM5CxnVLE9EtO/bMHXlASzT4HuK5hsKNDp3GjrqxFaxJo5F/q5xE/3rzdZE",
+	"3RePE0I00DQiRn++c0bAkm/MHBrXZzOvJx1XEpi8Z4USPdfncXUlKiPVLkpGlC6YRkWU0Pc1x5tbe4at",
+	"0zeFR/peVzAelUn4a0fl0+ttKXku8tvjlXDQQutUWEZfM8ZJ2KbWKMg5+8fJ6x+W7OTdD0v2w/H3oNL8",
+	"S5yfoD2Fkjrh60G71VWxWrmizyByLNCZ4qjmmDWvwh4OZu2+HVwCPocRpvIMl6LJsIxSYciprYvfINRz",
+	"U6AvQgtrF3h79N+/HL89+uH1L6fH/8/raS3ivmnwjkzmiI1Ul1lo2KQXPz1ArdP0kzKUEkv5rCOxUwbu",
+	"TfXWhi3gpy1jMf1cU3CSPQhcuxjcmYL76bdDqbkYeO9ouUWNPfUdKBsl98be82er8i4FG/97PO/CTvR9",
+	"TG8+YPR94Ve8a0MAIYA8jY5XTBkFxvOp3ZX6Njii5B0bwZkm/jA09u/2ja876co25k4p0WYjgYP4Jkpr",
+	"ocrlO5QMjDoUL1nJTRN9dWu5OFLv/OYoBzjkdZmq8yC4Tv07Xzfa3XekIN6NzX1aXEMB2nB1B/jVvSVu",
+	"pQH53JYwGauzhmtDxZXRR8YG2lN4bTu6HWvo02hR5frI7LN/2Usa/Ts6uzZ853q5NBRcaFaJz8YFTe1P",
+	"qDgPRXL3lDTVENkjlEpoLx7LYPIom7Qp+sDtrVCFvDMLNk6GbZlKvtXemtghpa47wT71SVPWfoCT2TgH",
+	"zlZCm+KKl2i4uyFbfPK7+3Om5tIm21M/yQMqMTpc9K71mA5DZL47ErVOG7sJdl9NsEJ33kAt2Zqe7Frt",
+	"NADcDHpZWy/avm5gS2QVBJyBw4RhmKYV2qlkgv6PNJl5ikO/Njsv7vqNuBJlVByGYdo3Fn0/2LTIVtD3",
+	"kNgbN9zgFHu+ddMMzLz173y12kzrO5K0GQS4g5bL3LoDFaY1b9c0N5CMECJ2mWSlfyi83b1K0Nq5bX70",
+	"0JpBh1omqYO6oE3qBz5ff2VNGkpwLas70BPAciIqTO8gAqPsR5tQRj+BUilNYyhp0SdQD+M+unDZVGS1",
+	"PYKwUElV89LOxvN/19ogfFIZkOGf91RqhtJxfsY/f8DRXy3TsV+Qwm7O3H3ViePw9kRyFzBpkxejnMX0",
+	"p4iLiTP+OZmP3D8S7p6DeLA/LNNoLTuB3XxYW/dobDv8bKQjZnIz1w63hdD+gaurSIzAGLZ/9C/8B/nc",
+	"/Ud1vO4H40YBjPJxabdwkirG78p7/4F4amTKnhvf57HNSEFB5LsJ0pjtT2701+6rS2G2PzXZmEGrReK4",
+	"t9fwPObmGKfGTuX94+bueXC/nes9cOO7poujsmxhL/A6LW3mLgVKSx3Eirh+spPqn23z2nNoFVhYQ5Sr",
+	"huHcgUp4FOwQQtRmx6W5ijyeB9L2W2SN7Mqtg6NdgoF7K5MbcGTiq6OsayN9d98RduVGPRBHoeXSCs25",
+	"vUXc/1wFNSXWxcVaUP1aqfA2QM6PWIXb4HsDyLkfJxlICK17Cdl08HlwZ3O48AAikgs6ZLLKC3s0uS/c",
+	"gc8APddFlcvrgbK3biMD+GnTdnowtn3hkcOxLRwTArLtyKSoODvWR17bgwEMCcNHIcML0HAFLCUpStrO",
+	"GDSjMb2pecTEHZ6jaZ7zVVa4TTko8xAbrW87cQxGg9UfALyPzvseGqXJYfCJvO/GBBIGtyewSmt+2kPZ",
+	"Oa4L2KHvaWRS/hPlpqdnSYdLnNK7D3WZCZdOUj/sC6R1dMvaxHSL9gtDDSJGFYwuCu7loLUh8cCKRn/x",
+	"MbD7gglUrEgaXurJXpIQ7gbXh6BIpq355c3FG6ngtiCyQosmIq0pUFBXvai0k5JnIjDo2g26zEq75lhb",
+	"kM5ZnGzY0qaGr1FYzkQ2whIzXa5sYQxZG214hZdEi7xCjBRIbc83IWNbg0eO65CgfTD0/CF4wAOSxSvF",
+	"Vya5deydHffZRDWkkbdfCOMGUIfGita9Gjl5YbAYInx6mzSXTGEVbDgEhdFY7lrPZTRPslLqKbN1h6Jf",
+	"4iv/6VwHAZPfG/qLBvuetclm1XYICfzYY01LF+fiago0nBC3sxPGTz2bLNpNxOaIo1Y/sa/Xyt1uKzat",
+	"F/Z7h/V0w9tJJ2/27jcdc6bCVMkVjdN8QZWHyVtuCTKgqKIy0nmktV9Ku9q82tZBlr5flK+LVW1rAy3u",
+	"IMhSXTSByo5BU6inKx2F08Q2YM3E+G37jMC94WDyU+Cwhag9psWVULy0E2yNpoxZWzFbKsvlC93SIDCJ",
+	"HLjnPsP8sD2jeHYp8o+Vz+etBGgelObFqnpz7raNVbC2hdqxvHElQklRHDqYNPM4Z+buFYZ+872HuzPM",
+	"aPx3laAv0OkDMlgOkopVIJzp/dylHT66hPhAAyxvwAhpna7MDkoBVEnmKQd4H/qPVw5IV6O7yFZUj6wk",
+	"ujtoTEuk2xPohyl3UVtC4Em22R78dUzqU80p/RLHTTR3+B7TYDNeiirniu0EV005xopXWcFL/NVGSz87",
+	"ePYX5KTwx96z/zmQiuzf/d+Cq6mCO1S75tnB0/+1TMiT/mfNlREDuzxkT+FsHm0VpBJI9o+6EgNb/JXm",
+	"Gd+cq9zz3UTdnnvtN/z25OCvw3XEXr492Tv4K3MU16Y+CyzoRlCrCuWwSwza8h3VSrOOWDeP4UZ0Itos",
+	"QXXoEJWHPcM/J9DiMYyFCJ0JcjymFPZQj0K7wrxyuRAmdAdlcgc2YwuOJuzDyPm7uE9KclgYKboHI5A8",
+	"XLG9duU5ry/KqgMWbMhBtfqBogo/UaZEXkxTUymv9yaD5e2rb+T1QLB8xygBaUVyRR41cuOLUmZgXiiw",
+	"ABoUosICEv5goO57cSG0EUGoagYD9CH79oCqnbnyMQN4z/mubWB/NN7hIDWM8TcO7nGMn4SND1TTP6tQ",
+	"TAmrlkHw/5JkGUHaQxm18ACg9IIDazpNxHwiw2pPh0qmnCRTNNOjB7jxIEU8GEE8vXOC6ABl0KK37bpU",
+	"3CnxuGF4ZXd+5yvBKtnY94y0OEeW3HFe4wI96/xWqOZ9J5yiOJBVZyeWghN0qEpwtUeXwwR+805w9ZoG",
+	"p1APXwuO7naoG0VVIyo/A47QwmBt5tvRzkNX+2vAMMxO3jWAjTMUX+XFZvEg3eALoqkWSbd4OIbw9Uv7",
+	"PGeyEk33iEn2Qbk+ULCa0+4mEY1H4yf/wgSyYaAI8lm4ZoWBv2Xu8mgF5SmhrpDz3SEzMue7JLxz/X41",
+	"rD6k6C/31BnhOF88braZR9BIcV1EiEd9q3TwTcrh/9NpAkCyaEi7QuS7kqitxLQ27jkgfpJW09ToGRo0",
+	"ya/HVZxpD1+vvjyqKp8NKcnwwP4Ls/5r6gYHdYOAOlQ7baFNDgixMUI4xQEPkizI0yoeuPZ/sQbsvvef",
+	"ttt2X02fMWzwflMAp+dKuPbuvoZS2GTfABvlme0X5kNPg0DTeNGABoj3kLnHS/FYCXs8nsEPv98oKsO7",
+	"Zn0enFQtBNzUZXsfyXsv8QOthb8T5ujIzR+xJ7ah3XDdUNsplroCV7b2v2suGRCb+MwzU+5A+COIfeMZ",
+	"ADo2pVsGXRdFduk6qguACvwDv22EUE/sVv8g9Hpw7/R65sBHChUCEaqzTYUy3yvZdm7FBRp1Mx70aSFn",
+	"G2CUT9NfWhA0vveY8c/IOq5kMVqmAcYk1GaAYYHfxM3aAuxPssgt+ILeOKig2mumdXTGZclA2M+9g/Fm",
+	"x7JT+84JZf9H+3nT6DuyjeXCkXNbcZL1eRloTXSvQphPd8F61CYho7LMhxkB7YisVvjdP/++OBdcCXVU",
+	"m/Xi+c+fvnwKScsH4SadzSfimu/Oi7KcVMaO89du6NfmWXv9L757ARuPAPr13jXfMfisxktlG2xiqT9o",
+	"KpTIEqjhFhPNjE1SFvXXH6j3MfjGIBLHInUfDFt3L6Qdoh5JsUykky3fIWk0uAIW7ju1DcptpBbXHgDL",
+	"q+fC8KLU2JAtpAI711i6RqI0OqrCea29qe3Vp67W3gJJDClasLpDq91jMkmy42LraybYd07gPKjwSKRX",
+	"i3WH6vviZh9w/i6ReJLTdRN2BcTPy2nhZEOoEmSTi8F5fNG0zVdz22L2Iz1efe+C64aLx+csl9cVnr5w",
+	"/ABQyRI/bnNxY+5T33FrRFtA66IS2rsNdERcnrsxRUVwbScZ+elH+U34nfdw02x94gOqkiOgdc+SU8V+",
+	"OD07fgcsAOMkWKSNgdUz0xGCZOja5IwTYjPqPlK9/PQPm+bll02uSIYgmM7u0iG4xquIdYKilBC/Oe9v",
+	"27QP0bBVYcgHIFdMYFNaX8SgsqG2voGlVPZHaDtZBhVMXNu0Ci/FWNFmn7nOKE1069I2VbK9J+2v2HHG",
+	"9zInd7yv2eD623ysZG32GXqBZGW/BMDhOqNnsq7QAsUN41ibeCgmtkV491bvjGjgEYqcNQsPEBuVvhV5",
+	"cxUSn7fUV7qb4hThHW9tSCqcSmwjbO1UjkpGlFRb5NTvo3AdhBzuuuUqYJ9h260lWwExO6tkbNvDlcua",
+	"AzSZ+NZQyFeY9JZIBIj8EIpLQoO/6MABzERjWaRfrZ9Q8Kax3bCq6RcbT4TT7U01tZKyOQh9wrdbJce6",
+	"Z8Hhp57ivtJdU/DPOj/dwg00SpB7HkgfQQDS57sBvoEPFpHBX1kO/TSUbjw9KyV/E9XHyoFzn51yxwjp",
+	"XDQFJDc8F0wXAH3PtMOD+7HShu9cxMi5lJd6ybTscnfwk15zlWs4aGYt3IabTbBtWWt2vebG9sbLa+UO",
+	"Fw7eZz5kCkMbsOlJJc3Hys7V9B/j2hdGmWa8x/mRRdbXVsYStk975+WD635pJ9ueAzrHDanrqZqWjrNP",
+	"lLCMnuohM3nI6yvJoE6RUEP83tJEm+PDpl3ioecKQG3dopVzWAXls40H3oXUSqWV/1NFga9i/Qg4b4pW",
+	"t4QA9jxH1Hqn4hz0wlo6VcK/pNFfdyFk+Bb8kKSGHvTFN5fbPkWQIN02MLaOb79yVgaKY1HNvMUc5XlL",
+	"iIWhk1hPERNXY/ZOkNX+CoJyEkWZzeCzLamVxnsETt70hW5oksMFpLLJhkEJT4ANfGOTxyUrQbkzQP5u",
+	"s0WF29j/WB01YQBBQLZSIiPVxFaeozcpqRC2gzEOjQBvFxK1vTsvpI1o/k0oGUhuroQtMnq+Y8evMPJ5",
+	"97GyXQhThPV9n5B7lNW49ccKCRhjvy/bJyc9DqDBu6wNtldrKYl3Wnrg/vi+tSNHTrMti2vP5TjXV5Mm",
+	"JvVQAWawVKLNRwm9dM3AB/s8WovbN5r5a7NqXYfObP/pSU9hA4R7OWfqMcwcSgzCdjKyJrRggIBKtYVS",
+	"gUDEg1U+renJdQJXVJdO2gSBOKoayk0xQ6iv1QQxgqAxbqMSbAVKDJ+CIWfAvUPyUY/WQ2Fu0rEw42jd",
+	"iAaasJcRMsATZnNuxsWDH/QgEsKuNisM2e9wKBQ5+ITZ1d7a338PFOy/+IHlQ2vdDn3ZZ8mlZFPlAgbq",
+	"JiS+e4xNs3838muUAAkoGOYBDkcTomAC2iPi4CEg+9jn6WGReTuf800JoZEHs0/enMqkAb0kFSm942b6",
+	"5PTEBa3DjF/zAi1jmLa+xNIgANMtV6bgZbkL63HFnOlBqZk/QnP9O66NelNyarrOtud3VVhm1Np0nZH2",
+	"bLfaMfKybWZsm93FA/YxSu8B7DQSCAfsteKNgTE+cE7LoghY7q2zkAPEo/QXChcf6DJE8EtoXPpOFGgu",
+	"4BX7++k7ZK+sQotQK4yDVxHH/WT/IrsLtD6WpTMiabbhJltTrohbdNld8VyadQ/9sZMSy2aaIo77zSq6",
+	"AY3c95F1H5zWxoKwxLHnmM+awzgbiLyh3LRrRo3c7p2w7NZDM2Swt/Ndn+DSyCYtCalNN4+ZjdQ92x0v",
+	"3N+m3wgivJtWrgPute4ZbnVW2AmDPdwj/WADgPsr9pgo84PuI6bPze5D+pbxafNCiczGKM6b+ZV/c3By",
+	"ND3cd9mANI5gN53UjdAhJjHSkJkAk+Mm514ugqzNOTrG3By22Trj2teOo8Jdl1RBFmUHli5a0eofK+qf",
+	"Tn2K80JvQcKIfJ8dVayoOrO7wqC2JiHFmUGQjFTUNbmZYNnEPQUuh1YgpGYSc/Q+VrkoC4yIzNa8LHlF",
+	"9WKocTIuXhjb591p24dBiq1cgY8tqPLZcLvSW/mb+iHwT7vOPnvRhEl+rJLiJK0PsG2NHvLthaf0XgSm",
+	"J8wH1qda68YPQHLiLZGPVDHPGyBQ5MxcY3vtmd62Tm3PIAK3YrxP3kOZ4e658617x0PTTn7CSOqP+KQp",
+	"yr/5FZqiUohi8MroBkyYojwufABjh7tg4KRmnDCdjJOkQKUAOV9pnFLauZ2MUooha0iVckN9z6pGRmCY",
+	"e6NaxQulO70KpZnTfHuSyVaLITEWKZE+hX2SB4kn86Ud/Z+THdUVwMh3XYCqlfb2RN0lTRTaY1Kqdvux",
+	"hkq69d9clhZsrbdvqpwUUFhXQ0qnCTfLZJhzQ4VNjHOgYvnYKFuJOpPa3r8w6aOpNrURZi1z0n22tdEB",
+	"8AM9CMtEWWTsszOYgsaAzSiuBXEl7K3DRUkXitWVLY9mlSehl6SpMiNdZTX895Jxjd+AmYS+lJ+djD6x",
+	"qD5WlbQV0tmPFYZy98uw2BIsS5v/AvBgmxpsWFho/so17e5l0EzqV8f5K4eu/0ieHB6Huz2AMJoHVwnL",
+	"Z0GvEe55WGEHWbAEQ7mlH69buwj17oG1W2+t4o7MEgizzWjCs5N+Wi3PHz6sH5qmBq2QRt8cwca/wlmR",
+	"Ekjak/rHKi90psSWV9nOJ2Ug9bq67Bjaf82HrlAfq0ZSzWnK4N76WDVJC7ZOXKPNYrAnJgw0izOObXdt",
+	"A2yLIYis9Pcm5Cm1qpb0C9fGoYJXgNtMaH1IFsgGb8F3RAD3jWaZrFaFzVu0opgCRLHZLeD3Y6XXUhmk",
+	"lmtVGCOAa68CFpdw2G1x/K+qs77d/azWCg/MZSY7Kxz5i1kljbtMN2e5fVu7w/DIW/C2hq6GE+xd5xXU",
+	"HjyFB28OsCGYT6ireEnFNzLjJcuhzLHcolZAYxfLRa3KxfPF2pjt8ydPShi3lto8/+vBXw8WXz75xXrQ",
+	"r0HuGksATFT5VhYUL2xpHUYs+n5N1/l6wyt+4cq021fsMx15rUmcUk0jE/saPou88yKSwExc4aJWLp3Z",
+	"zeFTrHvTvEbzaHEl9tDZ3VhVnWMm2ApYTvszvHRVvMhGKdUOmdmLZy9wsqK6kkUWTuNeiG0HDMIABypN",
+	"aatvNq+6aowREPbygBvdxmuLpcgvhGqma+Jvh1Hp67EaJUTwEfRzEcWN964ve35Xz57bXYgCMmn8rrFN",
+	"uSppmi7m/tSfK8EvSX10ZdVoMfcvdqFkvQ0XUkUWX+VDXYq9c65FDgIDJ+q0P25RtWuPGsHmcNgvYblt",
+	"l4Xp4WenPG8C0nNjFl8+ffn/BwDPfBMiTrMBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -10,9 +10,11 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/gin-contrib/cors"
 	ginzap "github.com/gin-contrib/zap"
 	"github.com/gin-gonic/gin"
+	"github.com/nitinjangam/pos-receipt-system/internal/audit"
 	middleware "github.com/oapi-codegen/gin-middleware"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 	"go.uber.org/zap"
@@ -49,8 +51,8 @@ func New(config *Config) (*Handler, error) {
 	// CORS settings
 	r.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"http://localhost:4200"},
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization"},
+		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", audit.UserHeader},
 		ExposeHeaders:    []string{"Content-Length"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
@@ -60,12 +62,20 @@ func New(config *Config) (*Handler, error) {
 
 	r.Use(otelgin.Middleware(ServiceName))
 
+	// Uploaded images are validated as opaque files; the image service
+	// checks their content.
+	for _, contentType := range []string{"image/jpeg", "image/png", "image/gif", "image/webp"} {
+		openapi3filter.RegisterBodyDecoder(contentType, openapi3filter.FileBodyDecoder)
+	}
+
 	options := &middleware.Options{}
 
 	r.Use(middleware.OapiRequestValidatorWithOptions(swagger, options))
 
 	r.Use(gin.Recovery())
 
+	r.Use(userMiddleware)
+
 	l := config.Logger.Desugar()
 
 	r.Use(ginzap.RecoveryWithZap(l, true))
@@ -87,6 +97,15 @@ func New(config *Config) (*Handler, error) {
 	}, nil
 }
 
+// userMiddleware passes the user named in the request on to the services
+// through the request context.
+func userMiddleware(c *gin.Context) {
+	if user := c.GetHeader(audit.UserHeader); user != "" {
+		c.Request = c.Request.WithContext(audit.WithUser(c.Request.Context(), user))
+	}
+	c.Next()
+}
+
 func getRequestBody(c *gin.Context) string {
 	payload, err := io.ReadAll(c.Request.Body)
 	if err != nil {
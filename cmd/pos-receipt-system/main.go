@@ -9,6 +9,7 @@ import (
 	"github.com/nitinjangam/pos-receipt-system/internal/handler"
 	"github.com/nitinjangam/pos-receipt-system/internal/repository"
 	"github.com/nitinjangam/pos-receipt-system/internal/service"
+	"github.com/nitinjangam/pos-receipt-system/internal/storage"
 	"go.opentelemetry.io/otel"
 )
 
@@ -35,21 +36,91 @@ func main() {
 	authService := service.NewAuthService(authRepository, config.Logger)
 	authHandler := handler.NewAuthHandler(authService, config.Logger)
 
+	imageStore, err := storage.NewLocal(config.ImageDir, config.ImageBaseURL)
+	if err != nil {
+		panic("failed to open image store: " + err.Error())
+	}
+
 	productRepository := repository.NewProductRepository(db)
-	productService := service.NewProductService(productRepository, config.Logger)
+	imageRepository := repository.NewImageRepository(db)
+	imageService := service.NewImageService(imageRepository, productRepository, imageStore, config.MaxImageSize, config.Logger)
+	imageHandler := handler.NewImageHandler(imageService, config.Logger)
+
+	taxRateRepository := repository.NewTaxRateRepository(db)
+	categoryRepository := repository.NewCategoryRepository(db)
+	supplierRepository := repository.NewSupplierRepository(db)
+	productService := service.NewProductService(productRepository, categoryRepository, supplierRepository, imageService, config.Logger)
 	productHandler := handler.NewProductHandler(productService, config.Logger)
 
-	salesRepository := repository.NewSalesRepository(ctx)
-	salesService := service.NewSalesService(tracer, config.Logger, salesRepository)
-	salesHandler := handler.NewSalesHandler(ctx, config.Logger, salesService)
+	priceRepository := repository.NewPriceRepository(db)
+	priceService := service.NewPriceService(priceRepository, productRepository, config.Logger)
+	priceHandler := handler.NewPriceHandler(priceService, config.Logger)
 
-	settingsRepository := repository.NewSettingsRepository(ctx)
+	categoryService := service.NewCategoryService(categoryRepository, config.Logger)
+	categoryHandler := handler.NewCategoryHandler(categoryService, config.Logger)
+
+	taxRateService := service.NewTaxRateService(taxRateRepository, productRepository, categoryRepository, config.Logger)
+	taxRateHandler := handler.NewTaxRateHandler(taxRateService, config.Logger)
+
+	priceListRepository := repository.NewPriceListRepository(db)
+	priceListService := service.NewPriceListService(priceListRepository, productRepository, config.Logger)
+	priceListHandler := handler.NewPriceListHandler(priceListService, config.Logger)
+
+	customerRepository := repository.NewCustomerRepository(db)
+	customerService := service.NewCustomerService(customerRepository, priceListRepository, config.Logger)
+	customerHandler := handler.NewCustomerHandler(customerService, config.Logger)
+
+	settingsRepository := repository.NewSettingsRepository(db)
 	settingsService := service.NewSettingsService(tracer, config.Logger, settingsRepository)
 	settingsHandler := handler.NewSettingsHandler(tracer, config.Logger, settingsService)
 
+	promotionRepository := repository.NewPromotionRepository(db)
+	promotionService := service.NewPromotionService(promotionRepository, productRepository, categoryRepository, config.Logger)
+	promotionHandler := handler.NewPromotionHandler(promotionService, config.Logger)
+
+	salesRepository := repository.NewSalesRepository(db)
+	inventoryRepository := repository.NewInventoryRepository(db)
+	salesService := service.NewSalesService(tracer, config.Logger, salesRepository, productRepository, customerRepository, inventoryRepository,
+		priceListRepository, promotionRepository, categoryRepository, settingsService)
+	salesHandler := handler.NewSalesHandler(ctx, config.Logger, salesService)
+
+	inventoryService := service.NewInventoryService(inventoryRepository, salesRepository, productRepository, settingsService, config.Logger)
+	inventoryHandler := handler.NewInventoryHandler(inventoryService, config.Logger)
+
+	reportRepository := repository.NewReportRepository(db)
+	reportService := service.NewReportService(reportRepository, categoryRepository, settingsService, config.Logger)
+	reportHandler := handler.NewReportHandler(reportService, config.Logger)
+
+	ewayBillRepository := repository.NewEWayBillRepository(db)
+	ewayBillService := service.NewEWayBillService(tracer, config.Logger, ewayBillRepository, salesRepository, settingsService)
+	ewayBillHandler := handler.NewEWayBillHandler(ewayBillService, config.Logger)
+
+	supplierService := service.NewSupplierService(supplierRepository, config.Logger)
+	supplierHandler := handler.NewSupplierHandler(supplierService, config.Logger)
+
+	purchaseRepository := repository.NewPurchaseRepository(db)
+	purchaseService := service.NewPurchaseService(purchaseRepository, supplierRepository, productRepository, inventoryRepository, settingsService,
+		reportService, config.Logger)
+	purchaseHandler := handler.NewPurchaseHandler(purchaseService, config.Logger)
+
+	stocktakeRepository := repository.NewStocktakeRepository(db)
+	stocktakeService := service.NewStocktakeService(stocktakeRepository, productRepository, categoryRepository, settingsService, config.Logger)
+	stocktakeHandler := handler.NewStocktakeHandler(stocktakeService, config.Logger)
+
+	storeRepository := repository.NewStoreRepository(db)
+	storeService := service.NewStoreService(storeRepository, config.Logger)
+	storeHandler := handler.NewStoreHandler(storeService, config.Logger)
+
+	transferRepository := repository.NewTransferRepository(db)
+	transferService := service.NewTransferService(transferRepository, storeRepository, productRepository, inventoryRepository, settingsService, config.Logger)
+	transferHandler := handler.NewTransferHandler(transferService, config.Logger)
+
 	// ToDo: create health check service
 
-	handler := handler.NewHandler(authHandler, productHandler, salesHandler, settingsHandler)
+	handler := handler.NewHandler(authHandler, productHandler, salesHandler, settingsHandler, taxRateHandler,
+		customerHandler, reportHandler, ewayBillHandler, inventoryHandler, categoryHandler, priceHandler,
+		supplierHandler, purchaseHandler, imageHandler, priceListHandler, promotionHandler,
+		stocktakeHandler, storeHandler, transferHandler)
 
 	// Run the API
 	if err := api.Run(ctx, config, handler); err != nil {
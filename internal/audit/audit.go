@@ -0,0 +1,24 @@
+// Package audit carries the user making a request through the context, so
+// that changes can be recorded against them.
+package audit
+
+import "context"
+
+// UserHeader is the request header naming the user.
+const UserHeader = "X-User"
+
+type userKey struct{}
+
+// WithUser returns a copy of ctx carrying the user name.
+func WithUser(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// User returns the user carried by ctx, or nil when there is none.
+func User(ctx context.Context) *string {
+	user, ok := ctx.Value(userKey{}).(string)
+	if !ok || user == "" {
+		return nil
+	}
+	return &user
+}
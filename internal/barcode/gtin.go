@@ -0,0 +1,77 @@
+// Package barcode validates, generates and renders the EAN/UPC barcodes
+// printed on products.
+package barcode
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrBarcodeFormat     = errors.New("barcode must be an 8 digit EAN-8, 12 digit UPC-A or 13 digit EAN-13")
+	ErrBarcodeCheckDigit = errors.New("barcode check digit does not match")
+)
+
+// InternalPrefix is the GS1 prefix reserved for restricted in-store use, so
+// generated codes never collide with manufacturer barcodes.
+const InternalPrefix = "200"
+
+// Normalize removes surrounding whitespace from a scanned barcode.
+func Normalize(code string) string {
+	return strings.TrimSpace(code)
+}
+
+// Validate checks the length, digits and check digit of an EAN-8, UPC-A or
+// EAN-13 barcode.
+func Validate(code string) error {
+	switch len(code) {
+	case 8, 12, 13:
+	default:
+		return ErrBarcodeFormat
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return ErrBarcodeFormat
+		}
+	}
+	if CheckDigit(code[:len(code)-1]) != code[len(code)-1] {
+		return ErrBarcodeCheckDigit
+	}
+	return nil
+}
+
+// CheckDigit computes the GS1 check digit for the digits of a barcode without
+// its check digit. Weights alternate 3 and 1 starting from the rightmost
+// digit, which makes the same rule work for every GTIN length.
+func CheckDigit(digits string) byte {
+	sum := 0
+	for i := 0; i < len(digits); i++ {
+		d := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// Equivalents returns the code together with its other representation when
+// it is a UPC-A, which scanners may also report as an EAN-13 with a leading
+// zero.
+func Equivalents(code string) []string {
+	switch {
+	case len(code) == 12:
+		return []string{code, "0" + code}
+	case len(code) == 13 && code[0] == '0':
+		return []string{code, code[1:]}
+	default:
+		return []string{code}
+	}
+}
+
+// Internal returns the in-store EAN-13 for the given sequence number.
+func Internal(sequence int) string {
+	digits := fmt.Sprintf("%s%09d", InternalPrefix, sequence)
+	return digits + string(CheckDigit(digits))
+}
@@ -0,0 +1,84 @@
+package barcode
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+
+	bc "github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/ean"
+)
+
+const (
+	moduleWidth = 3   // width of a single bar module in pixels
+	quietZone   = 11  // blank modules on each side of the symbol
+	barHeight   = 100 // bar height in pixels
+	textHeight  = 24  // space below the bars for the human-readable digits
+)
+
+// encode builds the bar pattern; UPC-A is encoded as the equivalent EAN-13.
+func encode(code string) (bc.Barcode, error) {
+	if err := Validate(code); err != nil {
+		return nil, err
+	}
+	if len(code) == 12 {
+		code = "0" + code
+	}
+	return ean.Encode(code)
+}
+
+// PNG renders the barcode as a PNG image.
+func PNG(code string) ([]byte, error) {
+	symbol, err := encode(code)
+	if err != nil {
+		return nil, err
+	}
+	width := symbol.Bounds().Dx() * moduleWidth
+	scaled, err := bc.Scale(symbol, width, barHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, scaled); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// SVG renders the barcode as an SVG image with the digits printed below it.
+func SVG(code string) ([]byte, error) {
+	symbol, err := encode(code)
+	if err != nil {
+		return nil, err
+	}
+
+	modules := symbol.Bounds().Dx()
+	width := (modules + 2*quietZone) * moduleWidth
+	height := barHeight + textHeight
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, width, height, width, height)
+	fmt.Fprintf(&buf, `<rect width="%d" height="%d" fill="#fff"/>`, width, height)
+	for x := 0; x < modules; {
+		if !isBar(symbol, x) {
+			x++
+			continue
+		}
+		start := x
+		for x < modules && isBar(symbol, x) {
+			x++
+		}
+		fmt.Fprintf(&buf, `<rect x="%d" y="0" width="%d" height="%d" fill="#000"/>`,
+			(quietZone+start)*moduleWidth, (x-start)*moduleWidth, barHeight)
+	}
+	fmt.Fprintf(&buf, `<text x="%d" y="%d" font-family="monospace" font-size="18" text-anchor="middle">%s</text>`,
+		width/2, barHeight+textHeight-4, code)
+	buf.WriteString(`</svg>`)
+	return buf.Bytes(), nil
+}
+
+func isBar(symbol bc.Barcode, x int) bool {
+	r, _, _, _ := symbol.At(x, 0).RGBA()
+	return r == 0
+}
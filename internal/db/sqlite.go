@@ -2,11 +2,23 @@ package db
 
 import (
 	"database/sql"
+	"database/sql/driver"
+	"fmt"
 	"log"
+	"strings"
 
-	_ "modernc.org/sqlite"
+	"github.com/nitinjangam/pos-receipt-system/internal/search"
+	"modernc.org/sqlite"
 )
 
+func init() {
+	// search_key lets the search index triggers store phonetic keys.
+	sqlite.MustRegisterDeterministicScalarFunction("search_key", 1, func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		text, _ := args[0].(string)
+		return search.Key(text), nil
+	})
+}
+
 func InitSQLite(filepath string) *sql.DB {
 	db, err := sql.Open("sqlite", filepath)
 	if err != nil {
@@ -20,7 +32,12 @@ func InitSQLite(filepath string) *sql.DB {
 	_, _ = db.Exec("PRAGMA journal_mode=WAL;")
 
 	// Run migrations
+	migrateLegacySales(db)
 	runMigrations(db)
+	runColumnMigrations(db)
+	runIndexMigrations(db)
+	runSearchMigrations(db)
+	runCostMigrations(db)
 
 	return db
 }
@@ -41,24 +58,545 @@ func runMigrations(db *sql.DB) {
 		description TEXT,
 		price REAL NOT NULL,
 		cgst_rate REAL NOT NULL DEFAULT 0,   -- CGST % for this product
-		sgst_rate REAL NOT NULL DEFAULT 0  -- SGST % for this product
+		sgst_rate REAL NOT NULL DEFAULT 0,   -- SGST % for this product
+		hsn_code TEXT,
+		sku TEXT,                            -- unique when set, see runIndexMigrations
+		stock_on_hand REAL NOT NULL DEFAULT 0, -- cached balance of stock_movements
+		stock_value REAL NOT NULL DEFAULT 0, -- cached cost value of the stock on hand
+		category_id INTEGER REFERENCES categories(id),
+		parent_id INTEGER REFERENCES products(id), -- set on variants
+		variant_label TEXT,                  -- option values of a variant, e.g. M / Red
+		option_values TEXT,                  -- JSON object of option name to value on a variant
+		variant_options TEXT,                -- JSON array of options on a parent product
+		unit TEXT NOT NULL DEFAULT 'pcs',    -- unit of measure stock and sales are counted in
+		purchase_unit TEXT,                  -- unit the product is bought in, e.g. case
+		purchase_unit_factor REAL,           -- units in one purchase unit
+		updated_at DATETIME,                 -- last change to the product or its stock on hand
+		active INTEGER NOT NULL DEFAULT 1,   -- 0 once archived
+		batch_tracked INTEGER NOT NULL DEFAULT 0, -- 1 when purchases must name a batch
+		cost_price REAL,                     -- cost per unit on the latest goods receipt
+		reorder_level REAL,                  -- stock at or below which the product is reordered
+		reorder_quantity REAL,               -- least quantity to order when reordering
+		supplier_id INTEGER REFERENCES suppliers(id) -- preferred supplier
+	);
+
+	CREATE TABLE IF NOT EXISTS categories (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		name TEXT NOT NULL,
+		parent_id INTEGER,                   -- NULL for top-level categories
+		hsn_code TEXT,                       -- defaults inherited by new products
+		cgst_rate REAL,
+		sgst_rate REAL,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY(parent_id) REFERENCES categories(id)
+	);
+
+	-- Sibling categories must have distinct names.
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_parent_name ON categories(COALESCE(parent_id, 0), name);
+
+	CREATE TABLE IF NOT EXISTS product_barcodes (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		product_id INTEGER NOT NULL,
+		barcode TEXT NOT NULL UNIQUE,        -- EAN-13, UPC-A or EAN-8 with check digit
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY(product_id) REFERENCES products(id)
+	);
+
+	CREATE TABLE IF NOT EXISTS price_lists (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		name TEXT NOT NULL UNIQUE COLLATE NOCASE,
+		description TEXT,
+		is_default INTEGER NOT NULL DEFAULT 0, -- at most one list is the default
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS price_list_prices (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		price_list_id INTEGER NOT NULL,
+		product_id INTEGER NOT NULL,
+		min_quantity REAL NOT NULL,          -- quantity break, in the product's unit
+		price REAL NOT NULL,                 -- unit price before tax
+		FOREIGN KEY(price_list_id) REFERENCES price_lists(id),
+		FOREIGN KEY(product_id) REFERENCES products(id)
+	);
+
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_price_list_prices_tier ON price_list_prices(price_list_id, product_id, min_quantity);
+	CREATE INDEX IF NOT EXISTS idx_price_list_prices_product ON price_list_prices(product_id);
+
+	CREATE TABLE IF NOT EXISTS customer_groups (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		name TEXT NOT NULL UNIQUE COLLATE NOCASE,
+		price_list_id INTEGER,
+		FOREIGN KEY(price_list_id) REFERENCES price_lists(id)
+	);
+
+	CREATE TABLE IF NOT EXISTS customers (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		legal_name TEXT NOT NULL,
+		address TEXT,
+		state_code TEXT,                     -- two-digit GST state code
+		gstin TEXT UNIQUE,
+		phone TEXT,
+		group_id INTEGER,
+		price_list_id INTEGER,               -- overrides the price list of the group
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY(group_id) REFERENCES customer_groups(id),
+		FOREIGN KEY(price_list_id) REFERENCES price_lists(id)
 	);
 
 	CREATE TABLE IF NOT EXISTS sales (
 		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		customer_id INTEGER,
+		supply_type TEXT NOT NULL DEFAULT 'B2C', -- B2B when the buyer has a GSTIN
+		document_type TEXT NOT NULL DEFAULT 'tax_invoice', -- bill_of_supply under the composition scheme
+		buyer_name TEXT,                     -- snapshot of the customer at sale time
+		buyer_address TEXT,
+		buyer_state_code TEXT,
+		buyer_gstin TEXT,
+		interstate INTEGER NOT NULL DEFAULT 0, -- 1 when IGST was charged
+		subtotal REAL NOT NULL,              -- sum of line subtotals
+		discount_total REAL NOT NULL DEFAULT 0, -- sum of line discounts
+		cgst_total REAL NOT NULL,
+		sgst_total REAL NOT NULL,
+		igst_total REAL NOT NULL DEFAULT 0,
+		tax_total REAL NOT NULL,
+		grand_total REAL NOT NULL,
+		sold_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		voided_at DATETIME,
+		price_list_id INTEGER,               -- price list the lines were priced from
+		FOREIGN KEY(customer_id) REFERENCES customers(id),
+		FOREIGN KEY(price_list_id) REFERENCES price_lists(id)
+	);
+
+	CREATE TABLE IF NOT EXISTS sale_items (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		sale_id INTEGER NOT NULL,
 		product_id INTEGER NOT NULL,
-		quantity INTEGER NOT NULL,
+		hsn_code TEXT,                       -- snapshot of the product HSN code at sale time
+		quantity REAL NOT NULL,
+		unit TEXT NOT NULL DEFAULT 'pcs',    -- snapshot of the product unit at sale time
 		unit_price REAL NOT NULL,            -- snapshot of product price at sale time
-		cgst_rate REAL NOT NULL,             -- snapshot of CGST % at sale time
-		sgst_rate REAL NOT NULL,             -- snapshot of SGST % at sale time
+		cgst_rate REAL NOT NULL,             -- snapshot of CGST % effective at sale time
+		sgst_rate REAL NOT NULL,             -- snapshot of SGST % effective at sale time
+		igst_rate REAL NOT NULL DEFAULT 0,   -- charged instead of CGST and SGST on an inter-state sale
 		cgst_amount REAL NOT NULL,           -- calculated CGST amount
 		sgst_amount REAL NOT NULL,           -- calculated SGST amount
-		line_total REAL NOT NULL,            -- (unit_price * quantity + taxes)
-		subtotal REAL NOT NULL,              -- (unit_price * quantity)
-		sold_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		igst_amount REAL NOT NULL DEFAULT 0, -- calculated IGST amount
+		line_total REAL NOT NULL,            -- (subtotal + taxes)
+		subtotal REAL NOT NULL,              -- (unit_price * quantity - discount)
+		discount REAL NOT NULL DEFAULT 0,    -- taken off by promotions
+		cost_of_goods_sold REAL,             -- cost of the goods that left stock; NULL for sales made before costing
+		sale_bundle_id INTEGER,              -- bundle line the item is a component of
+		FOREIGN KEY(sale_id) REFERENCES sales(id),
+		FOREIGN KEY(product_id) REFERENCES products(id),
+		FOREIGN KEY(sale_bundle_id) REFERENCES sale_bundles(id)
+	);
+
+	CREATE TABLE IF NOT EXISTS bundle_components (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		bundle_id INTEGER NOT NULL,          -- product sold as the bundle
+		product_id INTEGER NOT NULL,         -- component product
+		quantity REAL NOT NULL,              -- in the component's unit, per bundle
+		FOREIGN KEY(bundle_id) REFERENCES products(id),
+		FOREIGN KEY(product_id) REFERENCES products(id)
+	);
+
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_bundle_components_bundle ON bundle_components(bundle_id, product_id);
+	CREATE INDEX IF NOT EXISTS idx_bundle_components_product ON bundle_components(product_id);
+
+	CREATE TABLE IF NOT EXISTS sale_bundles (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		sale_id INTEGER NOT NULL,
+		product_id INTEGER NOT NULL,         -- the bundle product
+		quantity REAL NOT NULL,
+		unit TEXT NOT NULL DEFAULT 'pcs',    -- snapshot of the bundle unit at sale time
+		unit_price REAL NOT NULL,            -- snapshot of the bundle price at sale time
+		FOREIGN KEY(sale_id) REFERENCES sales(id),
 		FOREIGN KEY(product_id) REFERENCES products(id)
 	);
 
+	CREATE TABLE IF NOT EXISTS promotions (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		name TEXT NOT NULL,
+		description TEXT,
+		active INTEGER NOT NULL DEFAULT 1,
+		priority INTEGER NOT NULL DEFAULT 0, -- applied highest first
+		stackable INTEGER NOT NULL DEFAULT 1,
+		starts_at DATETIME,
+		ends_at DATETIME,
+		conditions TEXT NOT NULL DEFAULT '{}', -- JSON PromotionConditions
+		action_type TEXT NOT NULL,           -- percentage, flat or free_item
+		action_value REAL,
+		buy_quantity INTEGER,
+		free_quantity INTEGER,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS sale_promotions (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		sale_id INTEGER NOT NULL,
+		promotion_id INTEGER NOT NULL,
+		name TEXT NOT NULL,                  -- snapshot of the promotion name at sale time
+		discount REAL NOT NULL,
+		FOREIGN KEY(sale_id) REFERENCES sales(id),
+		FOREIGN KEY(promotion_id) REFERENCES promotions(id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_sale_promotions_sale ON sale_promotions(sale_id);
+	CREATE INDEX IF NOT EXISTS idx_sale_promotions_promotion ON sale_promotions(promotion_id);
+
+	CREATE TABLE IF NOT EXISTS tax_rate_changes (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		hsn_code TEXT NOT NULL,              -- '' when the change applies to a category alone
+		category_id INTEGER REFERENCES categories(id),
+		cgst_rate REAL NOT NULL,
+		sgst_rate REAL NOT NULL,
+		effective_from DATETIME NOT NULL,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS product_tax_rates (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		product_id INTEGER NOT NULL,
+		cgst_rate REAL NOT NULL,
+		sgst_rate REAL NOT NULL,
+		effective_from DATETIME NOT NULL,    -- rate applies to sales at or after this instant
+		rate_change_id INTEGER,              -- bulk change that created this entry, if any
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY(product_id) REFERENCES products(id),
+		FOREIGN KEY(rate_change_id) REFERENCES tax_rate_changes(id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_product_tax_rates_product ON product_tax_rates(product_id, effective_from);
+
+	CREATE TABLE IF NOT EXISTS price_schedules (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		product_id INTEGER NOT NULL,
+		price REAL NOT NULL,
+		starts_at DATETIME NOT NULL,         -- the product sells at this price from this instant
+		ends_at DATETIME,                    -- until this instant; open-ended when NULL
+		note TEXT,
+		created_by TEXT,
+		created_at DATETIME NOT NULL,
+		FOREIGN KEY(product_id) REFERENCES products(id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_price_schedules_product ON price_schedules(product_id, starts_at);
+
+	CREATE TABLE IF NOT EXISTS product_bulk_updates (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		product_ids TEXT,                    -- JSON array of the products asked for, if any
+		filter TEXT,                         -- JSON object of the filter, if any
+		changes TEXT NOT NULL,               -- JSON object of the changes applied
+		note TEXT,
+		items TEXT NOT NULL,                 -- JSON array of the products changed with old and new values
+		changed_by TEXT,
+		changed_at DATETIME NOT NULL
+	);
+
+	CREATE TABLE IF NOT EXISTS product_price_changes (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		product_id INTEGER NOT NULL,
+		field TEXT NOT NULL,                 -- price, cgstRate or sgstRate
+		old_value REAL,
+		new_value REAL,
+		effective_from DATETIME NOT NULL,
+		source TEXT NOT NULL,                -- edit, taxRate, priceSchedule or bulkUpdate
+		tax_rate_id INTEGER,                 -- scheduled rate the change belongs to, if any
+		price_schedule_id INTEGER,           -- scheduled price the change belongs to, if any
+		bulk_update_id INTEGER,              -- bulk update the change was made in, if any
+		changed_by TEXT,
+		changed_at DATETIME NOT NULL,
+		FOREIGN KEY(product_id) REFERENCES products(id),
+		FOREIGN KEY(tax_rate_id) REFERENCES product_tax_rates(id),
+		FOREIGN KEY(price_schedule_id) REFERENCES price_schedules(id),
+		FOREIGN KEY(bulk_update_id) REFERENCES product_bulk_updates(id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_product_price_changes_product ON product_price_changes(product_id, effective_from);
+
+	CREATE TABLE IF NOT EXISTS stock_movements (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		product_id INTEGER NOT NULL,
+		quantity REAL NOT NULL,              -- signed change; negative when goods leave
+		reason TEXT NOT NULL,                -- sale, return, void, purchase, adjustment or transfer
+		sale_id INTEGER,
+		sale_item_id INTEGER,                -- sale line a sale movement belongs to
+		transfer_id INTEGER,                 -- transfer a transfer movement dispatched or received
+		batch_id INTEGER,                    -- batch the goods went into or came out of, if any
+		note TEXT,
+		balance REAL NOT NULL,               -- stock on hand after this movement
+		unit_cost REAL,                      -- cost per unit the goods moved at; NULL before costing
+		stock_value REAL,                    -- cost value of the stock on hand after this movement
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY(product_id) REFERENCES products(id),
+		FOREIGN KEY(sale_id) REFERENCES sales(id),
+		FOREIGN KEY(sale_item_id) REFERENCES sale_items(id),
+		FOREIGN KEY(transfer_id) REFERENCES transfers(id),
+		FOREIGN KEY(batch_id) REFERENCES product_batches(id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_stock_movements_product ON stock_movements(product_id, id);
+
+	-- Goods brought in at one cost, used up first in, first out.
+	CREATE TABLE IF NOT EXISTS stock_cost_layers (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		product_id INTEGER NOT NULL,
+		stock_movement_id INTEGER,           -- movement that brought the goods in; NULL for opening stock
+		quantity REAL NOT NULL,              -- brought in
+		remaining REAL NOT NULL,             -- not yet used up
+		unit_cost REAL NOT NULL,
+		created_at DATETIME NOT NULL,
+		FOREIGN KEY(product_id) REFERENCES products(id),
+		FOREIGN KEY(stock_movement_id) REFERENCES stock_movements(id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_stock_cost_layers_product ON stock_cost_layers(product_id, id);
+
+	CREATE TABLE IF NOT EXISTS product_batches (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		product_id INTEGER NOT NULL,
+		batch_no TEXT NOT NULL,
+		expires_on TEXT NOT NULL,            -- YYYY-MM-DD, the last day the batch may be sold
+		mrp REAL,
+		quantity REAL NOT NULL DEFAULT 0,    -- on hand, kept in step with the stock movements
+		received_at DATETIME NOT NULL,
+		UNIQUE(product_id, batch_no),
+		FOREIGN KEY(product_id) REFERENCES products(id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_product_batches_product ON product_batches(product_id, expires_on);
+	CREATE INDEX IF NOT EXISTS idx_product_batches_expiry ON product_batches(expires_on);
+
+	CREATE TABLE IF NOT EXISTS product_images (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		product_id INTEGER NOT NULL,
+		image_key TEXT NOT NULL,             -- key of the image in the image store
+		thumbnail_key TEXT NOT NULL,
+		url TEXT NOT NULL,                   -- where clients load the image from
+		thumbnail_url TEXT NOT NULL,
+		content_type TEXT NOT NULL,
+		size INTEGER NOT NULL,               -- bytes
+		width INTEGER NOT NULL,
+		height INTEGER NOT NULL,
+		created_at DATETIME NOT NULL,        -- RFC 3339, so that it reads back from JSON as well
+		FOREIGN KEY(product_id) REFERENCES products(id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_product_images_product ON product_images(product_id, id);
+
+	CREATE TABLE IF NOT EXISTS suppliers (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		legal_name TEXT NOT NULL,
+		address TEXT,
+		state_code TEXT,                     -- two-digit GST state code
+		gstin TEXT UNIQUE,
+		phone TEXT,
+		email TEXT,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS purchase_orders (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		supplier_id INTEGER NOT NULL,
+		status TEXT NOT NULL DEFAULT 'open', -- draft, open, partially_received, received or closed
+		expected_on TEXT,                    -- YYYY-MM-DD
+		note TEXT,
+		ordered_at DATETIME NOT NULL,
+		created_by TEXT,
+		closed_at DATETIME,
+		FOREIGN KEY(supplier_id) REFERENCES suppliers(id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_purchase_orders_supplier ON purchase_orders(supplier_id, status);
+
+	CREATE TABLE IF NOT EXISTS purchase_order_items (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		purchase_order_id INTEGER NOT NULL,
+		product_id INTEGER NOT NULL,
+		quantity REAL NOT NULL,
+		received_quantity REAL NOT NULL DEFAULT 0, -- kept in step with the goods receipt lines
+		unit_cost REAL NOT NULL,             -- agreed cost per unit before tax
+		cgst_rate REAL NOT NULL DEFAULT 0,
+		sgst_rate REAL NOT NULL DEFAULT 0,
+		FOREIGN KEY(purchase_order_id) REFERENCES purchase_orders(id),
+		FOREIGN KEY(product_id) REFERENCES products(id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_purchase_order_items_order ON purchase_order_items(purchase_order_id);
+
+	CREATE TABLE IF NOT EXISTS goods_receipts (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		purchase_order_id INTEGER NOT NULL,
+		supplier_id INTEGER NOT NULL,
+		supplier_invoice_no TEXT,
+		supplier_invoice_date TEXT,          -- YYYY-MM-DD
+		note TEXT,
+		interstate INTEGER NOT NULL DEFAULT 0, -- 1 when IGST was charged
+		subtotal REAL NOT NULL,
+		cgst_total REAL NOT NULL,
+		sgst_total REAL NOT NULL,
+		igst_total REAL NOT NULL,
+		tax_total REAL NOT NULL,
+		total REAL NOT NULL,
+		received_at DATETIME NOT NULL,
+		created_by TEXT,
+		FOREIGN KEY(purchase_order_id) REFERENCES purchase_orders(id),
+		FOREIGN KEY(supplier_id) REFERENCES suppliers(id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_goods_receipts_order ON goods_receipts(purchase_order_id);
+	CREATE INDEX IF NOT EXISTS idx_goods_receipts_received ON goods_receipts(received_at);
+
+	CREATE TABLE IF NOT EXISTS goods_receipt_items (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		goods_receipt_id INTEGER NOT NULL,
+		purchase_order_item_id INTEGER NOT NULL,
+		product_id INTEGER NOT NULL,
+		quantity REAL NOT NULL,
+		unit_cost REAL NOT NULL,             -- cost per unit before tax as invoiced
+		cgst_rate REAL NOT NULL DEFAULT 0,
+		sgst_rate REAL NOT NULL DEFAULT 0,
+		igst_rate REAL NOT NULL DEFAULT 0,
+		cgst_amount REAL NOT NULL DEFAULT 0,
+		sgst_amount REAL NOT NULL DEFAULT 0,
+		igst_amount REAL NOT NULL DEFAULT 0,
+		subtotal REAL NOT NULL,
+		line_total REAL NOT NULL,
+		batch_id INTEGER,
+		stock_movement_id INTEGER NOT NULL,  -- purchase movement that brought the goods into stock
+		FOREIGN KEY(goods_receipt_id) REFERENCES goods_receipts(id),
+		FOREIGN KEY(purchase_order_item_id) REFERENCES purchase_order_items(id),
+		FOREIGN KEY(product_id) REFERENCES products(id),
+		FOREIGN KEY(batch_id) REFERENCES product_batches(id),
+		FOREIGN KEY(stock_movement_id) REFERENCES stock_movements(id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_goods_receipt_items_receipt ON goods_receipt_items(goods_receipt_id);
+
+	CREATE TABLE IF NOT EXISTS stocktakes (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		name TEXT NOT NULL,
+		category_id INTEGER,                 -- NULL when the whole catalogue is counted
+		note TEXT,
+		status TEXT NOT NULL DEFAULT 'counting', -- counting, approved or cancelled
+		reason TEXT,                         -- given on approval
+		start_movement_id INTEGER NOT NULL,  -- last stock movement when the quantities were frozen
+		end_movement_id INTEGER,             -- last stock movement before approval or cancellation
+		started_at DATETIME NOT NULL,
+		started_by TEXT,
+		closed_at DATETIME,
+		closed_by TEXT,
+		FOREIGN KEY(category_id) REFERENCES categories(id)
+	);
+
+	CREATE TABLE IF NOT EXISTS stocktake_items (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		stocktake_id INTEGER NOT NULL,
+		product_id INTEGER NOT NULL,
+		expected_quantity REAL NOT NULL,     -- stock on hand when the stocktake started
+		unit_cost REAL NOT NULL DEFAULT 0,   -- unit cost of the stock when the stocktake started
+		counted_quantity REAL,               -- total of the counts, NULL until counted
+		stock_movement_id INTEGER,           -- adjustment posted for the variance
+		UNIQUE(stocktake_id, product_id),
+		FOREIGN KEY(stocktake_id) REFERENCES stocktakes(id),
+		FOREIGN KEY(product_id) REFERENCES products(id),
+		FOREIGN KEY(stock_movement_id) REFERENCES stock_movements(id)
+	);
+
+	CREATE TABLE IF NOT EXISTS stocktake_counts (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		stocktake_id INTEGER NOT NULL,
+		product_id INTEGER NOT NULL,
+		quantity REAL NOT NULL,              -- signed; negative corrects an earlier count
+		device TEXT,
+		counted_at DATETIME NOT NULL,
+		counted_by TEXT,
+		FOREIGN KEY(stocktake_id) REFERENCES stocktakes(id),
+		FOREIGN KEY(product_id) REFERENCES products(id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_stocktake_counts_stocktake ON stocktake_counts(stocktake_id, id);
+
+	-- The business's other outlets; this store's own details are in settings.
+	CREATE TABLE IF NOT EXISTS stores (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		name TEXT NOT NULL,
+		address TEXT,
+		city TEXT,
+		pincode TEXT,
+		state_code TEXT,                     -- two-digit GST state code
+		gstin TEXT,                          -- not unique: outlets in one state share the business's GSTIN
+		phone TEXT,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS transfers (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		direction TEXT NOT NULL,             -- outbound or inbound
+		store_id INTEGER NOT NULL,           -- store the goods go to or come from
+		status TEXT NOT NULL,                -- draft, in_transit, received or cancelled
+		reference TEXT,                      -- sending store's challan number for inbound transfers
+		note TEXT,
+		receipt_note TEXT,
+		created_at DATETIME NOT NULL,
+		created_by TEXT,
+		dispatched_at DATETIME,              -- entry of an inbound transfer
+		dispatched_by TEXT,
+		received_at DATETIME,
+		received_by TEXT,
+		FOREIGN KEY(store_id) REFERENCES stores(id)
+	);
+
+	CREATE TABLE IF NOT EXISTS transfer_items (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		transfer_id INTEGER NOT NULL,
+		product_id INTEGER NOT NULL,
+		quantity REAL NOT NULL,              -- dispatched
+		unit_cost REAL,                      -- set on dispatch for outbound transfers
+		received_quantity REAL,              -- NULL until received
+		stock_movement_id INTEGER,           -- movement out on dispatch, or in on receipt
+		UNIQUE(transfer_id, product_id),
+		FOREIGN KEY(transfer_id) REFERENCES transfers(id),
+		FOREIGN KEY(product_id) REFERENCES products(id),
+		FOREIGN KEY(stock_movement_id) REFERENCES stock_movements(id)
+	);
+
+	CREATE TABLE IF NOT EXISTS transfer_item_batches (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		transfer_item_id INTEGER NOT NULL,
+		batch_id INTEGER,                    -- set once the goods left the batch, or entered it on receipt
+		batch_no TEXT NOT NULL,
+		expires_on TEXT NOT NULL,            -- YYYY-MM-DD
+		mrp REAL,
+		quantity REAL NOT NULL,              -- dispatched in the batch
+		FOREIGN KEY(transfer_item_id) REFERENCES transfer_items(id),
+		FOREIGN KEY(batch_id) REFERENCES product_batches(id)
+	);
+
+	-- The ledger is append-only; corrections are posted as new movements.
+	CREATE TRIGGER IF NOT EXISTS stock_movements_no_update BEFORE UPDATE ON stock_movements
+	BEGIN
+		SELECT RAISE(ABORT, 'stock movements are immutable');
+	END;
+
+	CREATE TRIGGER IF NOT EXISTS stock_movements_no_delete BEFORE DELETE ON stock_movements
+	BEGIN
+		SELECT RAISE(ABORT, 'stock movements are immutable');
+	END;
+
+	CREATE TABLE IF NOT EXISTS eway_bills (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		sale_id INTEGER NOT NULL UNIQUE,
+		payload TEXT NOT NULL,               -- validated bulk-upload JSON
+		ewb_no TEXT,                         -- number issued by the portal
+		ewb_date DATETIME,
+		valid_upto DATETIME,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY(sale_id) REFERENCES sales(id)
+	);
+
     CREATE TABLE IF NOT EXISTS settings (
         key TEXT PRIMARY KEY,
         value TEXT
@@ -69,3 +607,194 @@ func runMigrations(db *sql.DB) {
 		log.Fatalf("failed to migrate DB: %v", err)
 	}
 }
+
+// migrateLegacySales moves the old line-per-row sales table out of the way so
+// that sales can be stored as a header with sale_items lines.
+func migrateLegacySales(db *sql.DB) {
+	if !hasColumn(db, "sales", "product_id") {
+		return
+	}
+	if _, err := db.Exec("ALTER TABLE sales RENAME TO sales_legacy"); err != nil {
+		log.Fatalf("failed to migrate legacy sales table: %v", err)
+	}
+}
+
+// runColumnMigrations adds columns introduced after a table was first created.
+// Quantity columns created as INTEGER before fractional quantities were
+// supported are left alone; SQLite stores fractional values in them as REAL.
+func runColumnMigrations(db *sql.DB) {
+	columns := []struct {
+		table      string
+		column     string
+		definition string
+	}{
+		{"products", "hsn_code", "TEXT"},
+		{"products", "sku", "TEXT"},
+		{"products", "stock_on_hand", "REAL NOT NULL DEFAULT 0"},
+		{"products", "category_id", "INTEGER REFERENCES categories(id)"},
+		{"products", "parent_id", "INTEGER REFERENCES products(id)"},
+		{"products", "variant_label", "TEXT"},
+		{"products", "option_values", "TEXT"},
+		{"products", "variant_options", "TEXT"},
+		{"products", "unit", "TEXT NOT NULL DEFAULT 'pcs'"},
+		{"products", "purchase_unit", "TEXT"},
+		{"products", "purchase_unit_factor", "REAL"},
+		{"products", "updated_at", "DATETIME"},
+		{"products", "active", "INTEGER NOT NULL DEFAULT 1"},
+		{"products", "batch_tracked", "INTEGER NOT NULL DEFAULT 0"},
+		{"products", "cost_price", "REAL"},
+		{"products", "reorder_level", "REAL"},
+		{"products", "reorder_quantity", "REAL"},
+		{"products", "supplier_id", "INTEGER REFERENCES suppliers(id)"},
+		{"products", "stock_value", "REAL NOT NULL DEFAULT 0"},
+		{"customers", "group_id", "INTEGER REFERENCES customer_groups(id)"},
+		{"customers", "price_list_id", "INTEGER REFERENCES price_lists(id)"},
+		{"sales", "customer_id", "INTEGER REFERENCES customers(id)"},
+		{"sales", "supply_type", "TEXT NOT NULL DEFAULT 'B2C'"},
+		{"sales", "buyer_name", "TEXT"},
+		{"sales", "buyer_address", "TEXT"},
+		{"sales", "buyer_state_code", "TEXT"},
+		{"sales", "buyer_gstin", "TEXT"},
+		{"sales", "document_type", "TEXT NOT NULL DEFAULT 'tax_invoice'"},
+		{"sales", "voided_at", "DATETIME"},
+		{"sales", "price_list_id", "INTEGER REFERENCES price_lists(id)"},
+		{"sales", "discount_total", "REAL NOT NULL DEFAULT 0"},
+		{"sales", "interstate", "INTEGER NOT NULL DEFAULT 0"},
+		{"sales", "igst_total", "REAL NOT NULL DEFAULT 0"},
+		{"sale_items", "hsn_code", "TEXT"},
+		{"sale_items", "unit", "TEXT NOT NULL DEFAULT 'pcs'"},
+		{"sale_items", "sale_bundle_id", "INTEGER REFERENCES sale_bundles(id)"},
+		{"sale_items", "discount", "REAL NOT NULL DEFAULT 0"},
+		{"sale_items", "cost_of_goods_sold", "REAL"},
+		{"sale_items", "igst_rate", "REAL NOT NULL DEFAULT 0"},
+		{"sale_items", "igst_amount", "REAL NOT NULL DEFAULT 0"},
+		{"tax_rate_changes", "category_id", "INTEGER REFERENCES categories(id)"},
+		{"product_price_changes", "bulk_update_id", "INTEGER REFERENCES product_bulk_updates(id)"},
+		{"stock_movements", "sale_item_id", "INTEGER REFERENCES sale_items(id)"},
+		{"stock_movements", "batch_id", "INTEGER REFERENCES product_batches(id)"},
+		{"stock_movements", "unit_cost", "REAL"},
+		{"stock_movements", "stock_value", "REAL"},
+		{"stock_movements", "transfer_id", "INTEGER REFERENCES transfers(id)"},
+	}
+
+	for _, c := range columns {
+		if hasColumn(db, c.table, c.column) {
+			continue
+		}
+		query := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", c.table, c.column, c.definition)
+		if _, err := db.Exec(query); err != nil {
+			log.Fatalf("failed to add column %s.%s: %v", c.table, c.column, err)
+		}
+	}
+}
+
+// runIndexMigrations creates indexes on columns that may have been added by
+// runColumnMigrations.
+func runIndexMigrations(db *sql.DB) {
+	indexes := []string{
+		"CREATE UNIQUE INDEX IF NOT EXISTS idx_products_sku ON products(sku)",
+		"CREATE INDEX IF NOT EXISTS idx_products_category ON products(category_id)",
+		"CREATE INDEX IF NOT EXISTS idx_products_parent ON products(parent_id)",
+		"CREATE INDEX IF NOT EXISTS idx_products_updated ON products(updated_at)",
+	}
+
+	for _, index := range indexes {
+		if _, err := db.Exec(index); err != nil {
+			log.Fatalf("failed to create index: %v", err)
+		}
+	}
+}
+
+// indexProduct re-creates the search index entry of the product whose ID is
+// bound as :id.
+const indexProduct = `
+		DELETE FROM products_fts WHERE rowid = :id;
+		INSERT INTO products_fts (rowid, name, description, sku, barcodes, phonetic)
+			SELECT p.id, p.name, p.description, p.sku,
+				(SELECT GROUP_CONCAT(b.barcode, ' ') FROM product_barcodes b WHERE b.product_id = p.id),
+				search_key(p.name || ' ' || COALESCE(p.description, ''))
+			FROM products p WHERE p.id = :id AND p.active = 1;`
+
+// runSearchMigrations creates the FTS5 product search index and the triggers
+// that keep it in step with products and barcodes, then indexes any products
+// that are missing from it. Archived products are left out of the index, and
+// stock updates do not touch it.
+func runSearchMigrations(db *sql.DB) {
+	statements := []string{
+		`CREATE VIRTUAL TABLE IF NOT EXISTS products_fts USING fts5(
+			name, description, sku, barcodes, phonetic,
+			tokenize = 'unicode61 remove_diacritics 2',
+			prefix = '2 3'
+		)`,
+		"CREATE VIRTUAL TABLE IF NOT EXISTS products_fts_vocab USING fts5vocab(products_fts, 'row')",
+		`CREATE TRIGGER IF NOT EXISTS products_fts_insert AFTER INSERT ON products BEGIN` +
+			strings.ReplaceAll(indexProduct, ":id", "NEW.id") + ` END`,
+		// Older databases have this trigger without the active column.
+		"DROP TRIGGER IF EXISTS products_fts_update",
+		`CREATE TRIGGER products_fts_update AFTER UPDATE OF name, description, sku, active ON products BEGIN` +
+			strings.ReplaceAll(indexProduct, ":id", "NEW.id") + ` END`,
+		`CREATE TRIGGER IF NOT EXISTS products_fts_delete AFTER DELETE ON products BEGIN
+			DELETE FROM products_fts WHERE rowid = OLD.id;
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS products_fts_barcode_insert AFTER INSERT ON product_barcodes BEGIN` +
+			strings.ReplaceAll(indexProduct, ":id", "NEW.product_id") + ` END`,
+		`CREATE TRIGGER IF NOT EXISTS products_fts_barcode_delete AFTER DELETE ON product_barcodes BEGIN` +
+			strings.ReplaceAll(indexProduct, ":id", "OLD.product_id") + ` END`,
+		`INSERT INTO products_fts (rowid, name, description, sku, barcodes, phonetic)
+			SELECT p.id, p.name, p.description, p.sku,
+				(SELECT GROUP_CONCAT(b.barcode, ' ') FROM product_barcodes b WHERE b.product_id = p.id),
+				search_key(p.name || ' ' || COALESCE(p.description, ''))
+			FROM products p WHERE p.active = 1 AND p.id NOT IN (SELECT rowid FROM products_fts)`,
+	}
+
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			log.Fatalf("failed to create search index: %v", err)
+		}
+	}
+}
+
+// runCostMigrations values the stock of products that have stock on hand but
+// were never costed, which is the stock held before costing was introduced,
+// at their latest cost price, and opens a cost layer for it.
+func runCostMigrations(db *sql.DB) {
+	statements := []string{
+		`UPDATE products SET stock_value = ROUND(stock_on_hand * COALESCE(cost_price, 0), 2)
+			WHERE stock_on_hand > 0 AND id NOT IN (SELECT product_id FROM stock_cost_layers)`,
+		`INSERT INTO stock_cost_layers (product_id, quantity, remaining, unit_cost, created_at)
+			SELECT id, stock_on_hand, stock_on_hand, COALESCE(cost_price, 0), CURRENT_TIMESTAMP FROM products
+			WHERE stock_on_hand > 0 AND id NOT IN (SELECT product_id FROM stock_cost_layers)`,
+	}
+
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			log.Fatalf("failed to value opening stock: %v", err)
+		}
+	}
+}
+
+func hasColumn(db *sql.DB, table, column string) bool {
+	rows, err := db.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		log.Fatalf("failed to inspect table %s: %v", table, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			cid       int
+			name      string
+			colType   string
+			notNull   int
+			dfltValue sql.NullString
+			pk        int
+		)
+		if err := rows.Scan(&cid, &name, &colType, &notNull, &dfltValue, &pk); err != nil {
+			log.Fatalf("failed to inspect table %s: %v", table, err)
+		}
+		if name == column {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,230 @@
+// Package ewaybill builds and validates the JSON accepted by the bulk
+// generation tool of the e-way bill portal.
+package ewaybill
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/nitinjangam/pos-receipt-system/internal/gst"
+)
+
+// Version is the bulk-upload schema version the payload follows.
+const Version = "1.0.0621"
+
+// DateLayout is the date format expected by the portal.
+const DateLayout = "02/01/2006"
+
+// Supply and document codes used for outward sales.
+const (
+	SupplyTypeOutward   = "O"
+	SubSupplyTypeSupply = 1
+	DocTypeInvoice      = "INV"
+	DocTypeBillOfSupply = "BIL"
+	TransTypeRegular    = 1
+	UnregisteredGSTIN   = "URP"
+)
+
+// Transport modes.
+const (
+	TransModeRoad = "1"
+	TransModeRail = "2"
+	TransModeAir  = "3"
+	TransModeShip = "4"
+)
+
+// Vehicle types.
+const (
+	VehicleTypeRegular         = "R"
+	VehicleTypeOverDimensional = "O"
+)
+
+var (
+	pincodePattern = regexp.MustCompile(`^[1-9][0-9]{5}$`)
+	hsnPattern     = regexp.MustCompile(`^([0-9]{4}|[0-9]{6}|[0-9]{8})$`)
+	vehiclePattern = regexp.MustCompile(`^([A-Z]{2}[0-9]{1,2}[A-Z]{0,3}[0-9]{4}|TR[A-Z0-9]{6,13}|[0-9]{2}BH[0-9]{4}[A-Z]{1,2})$`)
+)
+
+// Payload is the top-level document uploaded to the portal.
+type Payload struct {
+	Version   string `json:"version"`
+	BillLists []Bill `json:"billLists"`
+}
+
+// Bill is a single e-way bill.
+type Bill struct {
+	UserGstin           string  `json:"userGstin"`
+	SupplyType          string  `json:"supplyType"`
+	SubSupplyType       int     `json:"subSupplyType"`
+	SubSupplyDesc       string  `json:"subSupplyDesc"`
+	DocType             string  `json:"docType"`
+	DocNo               string  `json:"docNo"`
+	DocDate             string  `json:"docDate"`
+	TransType           int     `json:"transType"`
+	FromGstin           string  `json:"fromGstin"`
+	FromTrdName         string  `json:"fromTrdName"`
+	FromAddr1           string  `json:"fromAddr1"`
+	FromAddr2           string  `json:"fromAddr2"`
+	FromPlace           string  `json:"fromPlace"`
+	FromPincode         int     `json:"fromPincode"`
+	FromStateCode       int     `json:"fromStateCode"`
+	ActualFromStateCode int     `json:"actualFromStateCode"`
+	ToGstin             string  `json:"toGstin"`
+	ToTrdName           string  `json:"toTrdName"`
+	ToAddr1             string  `json:"toAddr1"`
+	ToAddr2             string  `json:"toAddr2"`
+	ToPlace             string  `json:"toPlace"`
+	ToPincode           int     `json:"toPincode"`
+	ToStateCode         int     `json:"toStateCode"`
+	ActualToStateCode   int     `json:"actualToStateCode"`
+	TotalValue          float64 `json:"totalValue"`
+	CgstValue           float64 `json:"cgstValue"`
+	SgstValue           float64 `json:"sgstValue"`
+	IgstValue           float64 `json:"igstValue"`
+	CessValue           float64 `json:"cessValue"`
+	TotNonAdvolVal      float64 `json:"TotNonAdvolVal"`
+	OthValue            float64 `json:"OthValue"`
+	TotInvValue         float64 `json:"totInvValue"`
+	TransMode           string  `json:"transMode"`
+	TransDistance       string  `json:"transDistance"`
+	TransporterName     string  `json:"transporterName"`
+	TransporterID       string  `json:"transporterId"`
+	TransDocNo          string  `json:"transDocNo"`
+	TransDocDate        string  `json:"transDocDate"`
+	VehicleNo           string  `json:"vehicleNo"`
+	VehicleType         string  `json:"vehicleType"`
+	MainHsnCode         int     `json:"mainHsnCode"`
+	ItemList            []Item  `json:"itemList"`
+}
+
+// Item is a line of goods on an e-way bill.
+type Item struct {
+	ItemNo        int     `json:"itemNo"`
+	ProductName   string  `json:"productName"`
+	ProductDesc   string  `json:"productDesc"`
+	HsnCode       int     `json:"hsnCode"`
+	Quantity      float64 `json:"quantity"`
+	QtyUnit       string  `json:"qtyUnit"`
+	TaxableAmount float64 `json:"taxableAmount"`
+	SgstRate      float64 `json:"sgstRate"`
+	CgstRate      float64 `json:"cgstRate"`
+	IgstRate      float64 `json:"igstRate"`
+	CessRate      float64 `json:"cessRate"`
+	CessNonAdvol  float64 `json:"cessNonAdvol"`
+}
+
+// NormalizeVehicleNo upper-cases the registration number and removes spaces
+// and hyphens.
+func NormalizeVehicleNo(vehicleNo string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(strings.ToUpper(vehicleNo))
+}
+
+// ValidHSN reports whether the code is a 4, 6 or 8 digit HSN code.
+func ValidHSN(code string) bool {
+	return hsnPattern.MatchString(code)
+}
+
+// validHSNNumber reports whether the numeric HSN code is valid; the portal
+// takes HSN codes as numbers, so chapters 01 to 09 lose their leading zero.
+func validHSNNumber(code int) bool {
+	digits := strconv.Itoa(code)
+	return ValidHSN(digits) || ValidHSN("0"+digits)
+}
+
+// Validate checks the bill against the rules the portal enforces on upload
+// and returns every violation found.
+func (b Bill) Validate() error {
+	var errs []error
+	add := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if err := gst.ValidateGSTIN(b.FromGstin); err != nil {
+		add("fromGstin: %v", err)
+	}
+	if b.ToGstin != UnregisteredGSTIN {
+		if err := gst.ValidateGSTIN(b.ToGstin); err != nil {
+			add("toGstin: %v", err)
+		}
+	}
+	if b.DocNo == "" || len(b.DocNo) > 16 {
+		add("docNo: must be 1 to 16 characters")
+	}
+	if b.FromTrdName == "" {
+		add("fromTrdName: business name is required")
+	}
+	if b.ToTrdName == "" {
+		add("toTrdName: recipient name is required")
+	}
+	if !pincodePattern.MatchString(fmt.Sprint(b.FromPincode)) {
+		add("fromPincode: must be a 6 digit pincode")
+	}
+	if !pincodePattern.MatchString(fmt.Sprint(b.ToPincode)) {
+		add("toPincode: must be a 6 digit pincode")
+	}
+	if b.FromPlace == "" {
+		add("fromPlace: is required")
+	}
+	if b.ToPlace == "" {
+		add("toPlace: is required")
+	}
+	if _, ok := gst.StateName(fmt.Sprintf("%02d", b.FromStateCode)); !ok {
+		add("fromStateCode: unknown state code %d", b.FromStateCode)
+	}
+	if _, ok := gst.StateName(fmt.Sprintf("%02d", b.ToStateCode)); !ok {
+		add("toStateCode: unknown state code %d", b.ToStateCode)
+	}
+	if b.FromStateCode != b.ToStateCode && (b.CgstValue != 0 || b.SgstValue != 0) {
+		add("inter-state supply from state %d to %d must be charged IGST, not CGST/SGST", b.FromStateCode, b.ToStateCode)
+	}
+	if b.FromStateCode == b.ToStateCode && b.IgstValue != 0 {
+		add("intra-state supply must be charged CGST/SGST, not IGST")
+	}
+
+	switch b.TransMode {
+	case TransModeRoad:
+		if b.VehicleNo == "" && b.TransporterID == "" {
+			add("vehicleNo: required for road transport unless a transporter ID is given")
+		}
+	case TransModeRail, TransModeAir, TransModeShip:
+		if b.TransDocNo == "" || b.TransDocDate == "" {
+			add("transDocNo, transDocDate: required for rail, air and ship transport")
+		}
+	default:
+		add("transMode: unknown transport mode %q", b.TransMode)
+	}
+	if b.VehicleNo != "" && !vehiclePattern.MatchString(b.VehicleNo) {
+		add("vehicleNo: %s is not a valid registration number", b.VehicleNo)
+	}
+	if b.TransporterID != "" {
+		if len(b.TransporterID) != 15 || gst.GSTINCheckChar(b.TransporterID[:14]) != b.TransporterID[14] {
+			add("transporterId: %s is not a valid GSTIN or TRANSIN", b.TransporterID)
+		}
+	}
+
+	if len(b.ItemList) == 0 {
+		add("itemList: at least one item is required")
+	}
+	var taxable float64
+	for _, item := range b.ItemList {
+		if !validHSNNumber(item.HsnCode) {
+			add("itemList[%d].hsnCode: %q must be a 4, 6 or 8 digit HSN code", item.ItemNo, item.ProductName)
+		}
+		if item.Quantity <= 0 {
+			add("itemList[%d].quantity: must be positive", item.ItemNo)
+		}
+		taxable += item.TaxableAmount
+	}
+	if diff := taxable - b.TotalValue; diff > 1 || diff < -1 {
+		add("totalValue: %.2f does not match the sum of item taxable amounts %.2f", b.TotalValue, taxable)
+	}
+	total := b.TotalValue + b.CgstValue + b.SgstValue + b.IgstValue + b.CessValue + b.TotNonAdvolVal + b.OthValue
+	if diff := total - b.TotInvValue; diff > 1 || diff < -1 {
+		add("totInvValue: %.2f does not match the taxable value plus taxes %.2f", b.TotInvValue, total)
+	}
+
+	return errors.Join(errs...)
+}
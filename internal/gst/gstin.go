@@ -0,0 +1,54 @@
+package gst
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var (
+	ErrGSTINFormat    = errors.New("GSTIN must be 15 characters: state code, PAN, entity number, 'Z' and a check character")
+	ErrGSTINStateCode = errors.New("GSTIN state code is not a valid GST state code")
+	ErrGSTINChecksum  = errors.New("GSTIN check character does not match")
+)
+
+const gstinCharset = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+var gstinPattern = regexp.MustCompile(`^[0-9]{2}[A-Z]{5}[0-9]{4}[A-Z][1-9A-Z]Z[0-9A-Z]$`)
+
+// NormalizeGSTIN upper-cases the GSTIN and strips surrounding whitespace.
+func NormalizeGSTIN(gstin string) string {
+	return strings.ToUpper(strings.TrimSpace(gstin))
+}
+
+// ValidateGSTIN checks the format, state-code prefix and check character of a
+// normalized GSTIN.
+func ValidateGSTIN(gstin string) error {
+	if !gstinPattern.MatchString(gstin) {
+		return ErrGSTINFormat
+	}
+	if _, ok := StateName(gstin[:2]); !ok {
+		return ErrGSTINStateCode
+	}
+	if GSTINCheckChar(gstin[:14]) != gstin[14] {
+		return ErrGSTINChecksum
+	}
+	return nil
+}
+
+// GSTINCheckChar computes the check character for the first 14 characters of
+// a GSTIN using the base-36 weighted sum defined by GSTN.
+func GSTINCheckChar(body string) byte {
+	const mod = len(gstinCharset)
+	sum := 0
+	for i := 0; i < len(body); i++ {
+		value := strings.IndexByte(gstinCharset, body[i])
+		factor := 1
+		if i%2 == 1 {
+			factor = 2
+		}
+		product := value * factor
+		sum += product/mod + product%mod
+	}
+	return gstinCharset[(mod-sum%mod)%mod]
+}
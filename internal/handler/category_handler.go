@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/service"
+	"go.uber.org/zap"
+)
+
+type CategoryHandlerInterface interface {
+	GetCategories(c *gin.Context)
+	PostCategories(c *gin.Context)
+	GetCategoriesId(c *gin.Context, id int)
+	PutCategoriesId(c *gin.Context, id int)
+	DeleteCategoriesId(c *gin.Context, id int)
+}
+
+type CategoryHandler struct {
+	categoryService service.CategoryServiceInterface
+	logger          *zap.SugaredLogger
+}
+
+func NewCategoryHandler(categoryService service.CategoryServiceInterface, logger *zap.SugaredLogger) CategoryHandlerInterface {
+	return &CategoryHandler{
+		categoryService: categoryService,
+		logger:          logger,
+	}
+}
+
+func (s *CategoryHandler) GetCategories(c *gin.Context) {
+	categories, err := s.categoryService.GetCategories(c.Request.Context())
+	if err != nil {
+		s.logger.Debugw("Failed to get categories", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"categories": categories,
+	})
+}
+
+func (s *CategoryHandler) PostCategories(c *gin.Context) {
+	var category v1.Category
+	if err := c.ShouldBindJSON(&category); err != nil {
+		s.logger.Debugw("Failed to bind category", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	created, err := s.categoryService.PostCategory(c.Request.Context(), category)
+	if err != nil {
+		s.categoryError(c, err)
+		return
+	}
+	c.JSON(201, gin.H{
+		"category": created,
+	})
+}
+
+func (s *CategoryHandler) GetCategoriesId(c *gin.Context, id int) {
+	category, err := s.categoryService.GetCategory(c.Request.Context(), id)
+	if err != nil {
+		s.categoryError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"category": category,
+	})
+}
+
+func (s *CategoryHandler) PutCategoriesId(c *gin.Context, id int) {
+	var category v1.Category
+	if err := c.ShouldBindJSON(&category); err != nil {
+		s.logger.Debugw("Failed to bind category", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+	category.Id = &id
+
+	updated, err := s.categoryService.PutCategory(c.Request.Context(), category)
+	if err != nil {
+		s.categoryError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"category": updated,
+	})
+}
+
+func (s *CategoryHandler) DeleteCategoriesId(c *gin.Context, id int) {
+	if err := s.categoryService.DeleteCategory(c.Request.Context(), id); err != nil {
+		s.categoryError(c, err)
+		return
+	}
+	c.Status(204)
+}
+
+func (s *CategoryHandler) categoryError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrCategoryNotFound):
+		c.JSON(404, gin.H{"message": "Category not found"})
+	case errors.Is(err, service.ErrInvalidCategory):
+		c.JSON(400, gin.H{"message": err.Error()})
+	case errors.Is(err, service.ErrCategoryInUse):
+		c.JSON(409, gin.H{"message": err.Error()})
+	default:
+		s.logger.Debugw("Category request failed", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+	}
+}
@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/service"
+	"go.uber.org/zap"
+)
+
+type CustomerHandlerInterface interface {
+	GetCustomers(c *gin.Context)
+	PostCustomers(c *gin.Context)
+	GetCustomersId(c *gin.Context, id int)
+	PutCustomersId(c *gin.Context, id int)
+	GetCustomerGroups(c *gin.Context)
+	PostCustomerGroups(c *gin.Context)
+	PutCustomerGroupsId(c *gin.Context, id int)
+	DeleteCustomerGroupsId(c *gin.Context, id int)
+}
+
+type CustomerHandler struct {
+	customerService service.CustomerServiceInterface
+	logger          *zap.SugaredLogger
+}
+
+func NewCustomerHandler(customerService service.CustomerServiceInterface, logger *zap.SugaredLogger) CustomerHandlerInterface {
+	return &CustomerHandler{
+		customerService: customerService,
+		logger:          logger,
+	}
+}
+
+func (s *CustomerHandler) GetCustomers(c *gin.Context) {
+	customers, err := s.customerService.GetCustomers(c.Request.Context())
+	if err != nil {
+		s.logger.Debugw("Failed to get customers", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"customers": customers,
+	})
+}
+
+func (s *CustomerHandler) PostCustomers(c *gin.Context) {
+	var customer v1.Customer
+	if err := c.ShouldBindJSON(&customer); err != nil {
+		s.logger.Debugw("Failed to bind customer", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	created, err := s.customerService.PostCustomer(c.Request.Context(), customer)
+	if err != nil {
+		s.customerError(c, err)
+		return
+	}
+	c.JSON(201, gin.H{
+		"customer": created,
+	})
+}
+
+func (s *CustomerHandler) GetCustomersId(c *gin.Context, id int) {
+	customer, err := s.customerService.GetCustomer(c.Request.Context(), id)
+	if err != nil {
+		s.customerError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"customer": customer,
+	})
+}
+
+func (s *CustomerHandler) PutCustomersId(c *gin.Context, id int) {
+	var customer v1.Customer
+	if err := c.ShouldBindJSON(&customer); err != nil {
+		s.logger.Debugw("Failed to bind customer", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+	customer.Id = &id
+
+	updated, err := s.customerService.PutCustomer(c.Request.Context(), customer)
+	if err != nil {
+		s.customerError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"customer": updated,
+	})
+}
+
+func (s *CustomerHandler) GetCustomerGroups(c *gin.Context) {
+	groups, err := s.customerService.GetCustomerGroups(c.Request.Context())
+	if err != nil {
+		s.logger.Debugw("Failed to get customer groups", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"customerGroups": groups,
+	})
+}
+
+func (s *CustomerHandler) PostCustomerGroups(c *gin.Context) {
+	var group v1.CustomerGroup
+	if err := c.ShouldBindJSON(&group); err != nil {
+		s.logger.Debugw("Failed to bind customer group", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	created, err := s.customerService.PostCustomerGroup(c.Request.Context(), group)
+	if err != nil {
+		s.customerError(c, err)
+		return
+	}
+	c.JSON(201, gin.H{
+		"customerGroup": created,
+	})
+}
+
+func (s *CustomerHandler) PutCustomerGroupsId(c *gin.Context, id int) {
+	var group v1.CustomerGroup
+	if err := c.ShouldBindJSON(&group); err != nil {
+		s.logger.Debugw("Failed to bind customer group", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+	group.Id = &id
+
+	updated, err := s.customerService.PutCustomerGroup(c.Request.Context(), group)
+	if err != nil {
+		s.customerError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"customerGroup": updated,
+	})
+}
+
+func (s *CustomerHandler) DeleteCustomerGroupsId(c *gin.Context, id int) {
+	if err := s.customerService.DeleteCustomerGroup(c.Request.Context(), id); err != nil {
+		s.customerError(c, err)
+		return
+	}
+	c.Status(204)
+}
+
+func (s *CustomerHandler) customerError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrCustomerNotFound):
+		c.JSON(404, gin.H{"message": "Customer not found"})
+	case errors.Is(err, service.ErrCustomerGroupNotFound):
+		c.JSON(404, gin.H{"message": "Customer group not found"})
+	case errors.Is(err, service.ErrInvalidCustomer), errors.Is(err, service.ErrInvalidCustomerGroup):
+		c.JSON(400, gin.H{"message": err.Error()})
+	case errors.Is(err, service.ErrCustomerGroupInUse):
+		c.JSON(409, gin.H{"message": err.Error()})
+	default:
+		s.logger.Debugw("Customer request failed", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+	}
+}
@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/service"
+	"go.uber.org/zap"
+)
+
+type EWayBillHandlerInterface interface {
+	GetSalesIdEwaybill(c *gin.Context, id int)
+	PostSalesIdEwaybill(c *gin.Context, id int)
+	PutSalesIdEwaybill(c *gin.Context, id int)
+}
+
+type EWayBillHandler struct {
+	ewayBillService service.EWayBillServiceInterface
+	logger          *zap.SugaredLogger
+}
+
+func NewEWayBillHandler(ewayBillService service.EWayBillServiceInterface, logger *zap.SugaredLogger) EWayBillHandlerInterface {
+	return &EWayBillHandler{
+		ewayBillService: ewayBillService,
+		logger:          logger,
+	}
+}
+
+func (s *EWayBillHandler) GetSalesIdEwaybill(c *gin.Context, id int) {
+	bill, err := s.ewayBillService.GetEWayBill(c.Request.Context(), id)
+	if err != nil {
+		s.ewayBillError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"ewayBill": bill,
+	})
+}
+
+func (s *EWayBillHandler) PostSalesIdEwaybill(c *gin.Context, id int) {
+	var request v1.EWayBillRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		s.logger.Debugw("Failed to bind e-way bill request", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	bill, err := s.ewayBillService.GenerateEWayBill(c.Request.Context(), id, request)
+	if err != nil {
+		s.ewayBillError(c, err)
+		return
+	}
+	c.JSON(201, gin.H{
+		"ewayBill": bill,
+	})
+}
+
+func (s *EWayBillHandler) PutSalesIdEwaybill(c *gin.Context, id int) {
+	var number v1.EWayBillNumber
+	if err := c.ShouldBindJSON(&number); err != nil {
+		s.logger.Debugw("Failed to bind e-way bill number", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	bill, err := s.ewayBillService.RecordEWayBillNumber(c.Request.Context(), id, number)
+	if err != nil {
+		s.ewayBillError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"ewayBill": bill,
+	})
+}
+
+func (s *EWayBillHandler) ewayBillError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrSaleNotFound):
+		c.JSON(404, gin.H{"message": "Sale not found"})
+	case errors.Is(err, service.ErrEWayBillNotFound):
+		c.JSON(404, gin.H{"message": "E-way bill not found"})
+	case errors.Is(err, service.ErrInvalidEWayBill):
+		c.JSON(400, gin.H{"message": err.Error()})
+	case errors.Is(err, service.ErrEWayBillIssued), errors.Is(err, service.ErrSaleVoided):
+		c.JSON(409, gin.H{"message": err.Error()})
+	default:
+		s.logger.Debugw("E-way bill request failed", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+	}
+}
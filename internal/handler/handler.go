@@ -12,31 +12,175 @@ type HandlerInterface interface {
 	PostProducts(c *gin.Context)
 	PutProductsId(c *gin.Context, id int)
 	DeleteProductsId(c *gin.Context, id int)
+	PostProductsIdArchive(c *gin.Context, id int)
+	PostProductsIdUnarchive(c *gin.Context, id int)
+	GetProductsLookup(c *gin.Context, params v1.GetProductsLookupParams)
+	PostProductsIdBarcodes(c *gin.Context, id int)
+	GetBarcodesCode(c *gin.Context, code string, params v1.GetBarcodesCodeParams)
+	GetProductsSearch(c *gin.Context, params v1.GetProductsSearchParams)
+	PostProductsImport(c *gin.Context, params v1.PostProductsImportParams)
+	GetProductsExport(c *gin.Context, params v1.GetProductsExportParams)
+	PatchProducts(c *gin.Context, params v1.PatchProductsParams)
+	GetProductsBulkUpdates(c *gin.Context)
+	GetProductsIdVariants(c *gin.Context, id int)
+	PostProductsIdVariants(c *gin.Context, id int)
+	GetProductsIdImages(c *gin.Context, id int)
+	PostProductsIdImages(c *gin.Context, id int)
+	DeleteProductsIdImagesImageId(c *gin.Context, id int, imageId int)
+	GetImagesKey(c *gin.Context, key string)
+	GetCategories(c *gin.Context)
+	PostCategories(c *gin.Context)
+	GetCategoriesId(c *gin.Context, id int)
+	PutCategoriesId(c *gin.Context, id int)
+	DeleteCategoriesId(c *gin.Context, id int)
+	GetProductsIdStock(c *gin.Context, id int)
+	GetProductsIdStockMovements(c *gin.Context, id int)
+	PostProductsIdStockMovements(c *gin.Context, id int)
+	GetProductsIdBatches(c *gin.Context, id int, params v1.GetProductsIdBatchesParams)
+	GetProductsIdPriceHistory(c *gin.Context, id int)
+	GetProductsIdPriceSchedules(c *gin.Context, id int)
+	PostProductsIdPriceSchedules(c *gin.Context, id int)
+	DeleteProductsIdPriceSchedulesScheduleId(c *gin.Context, id int, scheduleId int)
+	GetProductsIdTaxRates(c *gin.Context, id int)
+	PostProductsIdTaxRates(c *gin.Context, id int)
+	GetTaxRateChanges(c *gin.Context)
+	PostTaxRateChanges(c *gin.Context)
+	PostTaxRateChangesPreview(c *gin.Context)
+	DeleteTaxRateChangesId(c *gin.Context, id int)
 	GetSales(c *gin.Context)
 	PostSales(c *gin.Context)
+	PostSalesPreview(c *gin.Context)
 	DeleteSalesId(c *gin.Context, id int)
 	PutSalesId(c *gin.Context, id int)
 	GetSalesIdReceipt(c *gin.Context, id int)
+	GetSalesIdEwaybill(c *gin.Context, id int)
+	PostSalesIdEwaybill(c *gin.Context, id int)
+	PutSalesIdEwaybill(c *gin.Context, id int)
 	GetSettings(c *gin.Context)
 	PutSettings(c *gin.Context)
+	GetCustomers(c *gin.Context)
+	PostCustomers(c *gin.Context)
+	GetCustomersId(c *gin.Context, id int)
+	PutCustomersId(c *gin.Context, id int)
+	GetCustomerGroups(c *gin.Context)
+	PostCustomerGroups(c *gin.Context)
+	PutCustomerGroupsId(c *gin.Context, id int)
+	DeleteCustomerGroupsId(c *gin.Context, id int)
+	GetPriceLists(c *gin.Context)
+	PostPriceLists(c *gin.Context)
+	GetPriceListsId(c *gin.Context, id int)
+	PutPriceListsId(c *gin.Context, id int)
+	DeletePriceListsId(c *gin.Context, id int)
+	GetPriceListsIdPrices(c *gin.Context, id int)
+	PutPriceListsIdPricesProductId(c *gin.Context, id int, productId int)
+	DeletePriceListsIdPricesProductId(c *gin.Context, id int, productId int)
+	GetPromotions(c *gin.Context)
+	PostPromotions(c *gin.Context)
+	GetPromotionsId(c *gin.Context, id int)
+	PutPromotionsId(c *gin.Context, id int)
+	DeletePromotionsId(c *gin.Context, id int)
+	GetSuppliers(c *gin.Context)
+	PostSuppliers(c *gin.Context)
+	GetSuppliersId(c *gin.Context, id int)
+	PutSuppliersId(c *gin.Context, id int)
+	GetSuppliersIdPurchaseOrders(c *gin.Context, id int, params v1.GetSuppliersIdPurchaseOrdersParams)
+	GetPurchaseOrders(c *gin.Context, params v1.GetPurchaseOrdersParams)
+	PostPurchaseOrders(c *gin.Context)
+	GetPurchaseOrdersId(c *gin.Context, id int)
+	PutPurchaseOrdersId(c *gin.Context, id int)
+	PostPurchaseOrdersIdPlace(c *gin.Context, id int)
+	PostPurchaseOrdersIdClose(c *gin.Context, id int)
+	GetPurchaseOrdersIdGoodsReceipts(c *gin.Context, id int)
+	PostPurchaseOrdersIdGoodsReceipts(c *gin.Context, id int)
+	GetGoodsReceiptsId(c *gin.Context, id int)
+	GetStocktakes(c *gin.Context, params v1.GetStocktakesParams)
+	PostStocktakes(c *gin.Context)
+	GetStocktakesId(c *gin.Context, id int)
+	GetStocktakesIdCounts(c *gin.Context, id int)
+	PostStocktakesIdCounts(c *gin.Context, id int)
+	PostStocktakesIdApprove(c *gin.Context, id int)
+	PostStocktakesIdCancel(c *gin.Context, id int)
+	GetStores(c *gin.Context)
+	PostStores(c *gin.Context)
+	GetStoresId(c *gin.Context, id int)
+	PutStoresId(c *gin.Context, id int)
+	GetTransfers(c *gin.Context, params v1.GetTransfersParams)
+	PostTransfers(c *gin.Context)
+	GetTransfersId(c *gin.Context, id int)
+	PostTransfersIdDispatch(c *gin.Context, id int)
+	PostTransfersIdReceive(c *gin.Context, id int)
+	PostTransfersIdCancel(c *gin.Context, id int)
+	GetTransfersIdChallan(c *gin.Context, id int)
+	GetReportsTax(c *gin.Context, params v1.GetReportsTaxParams)
+	GetReportsCmp08(c *gin.Context, params v1.GetReportsCmp08Params)
+	GetReportsNearExpiry(c *gin.Context, params v1.GetReportsNearExpiryParams)
+	GetReportsInputTax(c *gin.Context, params v1.GetReportsInputTaxParams)
+	GetReportsLowStock(c *gin.Context, params v1.GetReportsLowStockParams)
+	GetReportsStockValuation(c *gin.Context, params v1.GetReportsStockValuationParams)
+	PostReportsLowStockPurchaseOrders(c *gin.Context, params v1.PostReportsLowStockPurchaseOrdersParams)
 }
 
 type Handler struct {
-	AuthHandler     AuthHandlerInterface
-	ProductHandler  ProductHandlerInterface
-	SalesHandler    SalesHandlerInterface
-	SettingsHandler SettingsHandlerInterface
+	AuthHandler      AuthHandlerInterface
+	ProductHandler   ProductHandlerInterface
+	SalesHandler     SalesHandlerInterface
+	SettingsHandler  SettingsHandlerInterface
+	TaxRateHandler   TaxRateHandlerInterface
+	CustomerHandler  CustomerHandlerInterface
+	ReportHandler    ReportHandlerInterface
+	EWayBillHandler  EWayBillHandlerInterface
+	InventoryHandler InventoryHandlerInterface
+	CategoryHandler  CategoryHandlerInterface
+	PriceHandler     PriceHandlerInterface
+	SupplierHandler  SupplierHandlerInterface
+	PurchaseHandler  PurchaseHandlerInterface
+	ImageHandler     ImageHandlerInterface
+	PriceListHandler PriceListHandlerInterface
+	PromotionHandler PromotionHandlerInterface
+	StocktakeHandler StocktakeHandlerInterface
+	StoreHandler     StoreHandlerInterface
+	TransferHandler  TransferHandlerInterface
 }
 
 func NewHandler(AuthHandler AuthHandlerInterface,
 	ProductHandler ProductHandlerInterface,
 	SalesHandler SalesHandlerInterface,
-	SettingsHandler SettingsHandlerInterface) HandlerInterface {
+	SettingsHandler SettingsHandlerInterface,
+	TaxRateHandler TaxRateHandlerInterface,
+	CustomerHandler CustomerHandlerInterface,
+	ReportHandler ReportHandlerInterface,
+	EWayBillHandler EWayBillHandlerInterface,
+	InventoryHandler InventoryHandlerInterface,
+	CategoryHandler CategoryHandlerInterface,
+	PriceHandler PriceHandlerInterface,
+	SupplierHandler SupplierHandlerInterface,
+	PurchaseHandler PurchaseHandlerInterface,
+	ImageHandler ImageHandlerInterface,
+	PriceListHandler PriceListHandlerInterface,
+	PromotionHandler PromotionHandlerInterface,
+	StocktakeHandler StocktakeHandlerInterface,
+	StoreHandler StoreHandlerInterface,
+	TransferHandler TransferHandlerInterface) HandlerInterface {
 	return &Handler{
-		AuthHandler:     AuthHandler,
-		ProductHandler:  ProductHandler,
-		SalesHandler:    SalesHandler,
-		SettingsHandler: SettingsHandler,
+		AuthHandler:      AuthHandler,
+		ProductHandler:   ProductHandler,
+		SalesHandler:     SalesHandler,
+		SettingsHandler:  SettingsHandler,
+		TaxRateHandler:   TaxRateHandler,
+		CustomerHandler:  CustomerHandler,
+		ReportHandler:    ReportHandler,
+		EWayBillHandler:  EWayBillHandler,
+		InventoryHandler: InventoryHandler,
+		CategoryHandler:  CategoryHandler,
+		PriceHandler:     PriceHandler,
+		SupplierHandler:  SupplierHandler,
+		PurchaseHandler:  PurchaseHandler,
+		ImageHandler:     ImageHandler,
+		PriceListHandler: PriceListHandler,
+		PromotionHandler: PromotionHandler,
+		StocktakeHandler: StocktakeHandler,
+		StoreHandler:     StoreHandler,
+		TransferHandler:  TransferHandler,
 	}
 }
 
@@ -70,6 +214,181 @@ func (s *Handler) DeleteProductsId(c *gin.Context, id int) {
 	s.ProductHandler.DeleteProductsId(c, id)
 }
 
+// PostProductsIdArchive archives a product and its variants.
+func (s *Handler) PostProductsIdArchive(c *gin.Context, id int) {
+	s.ProductHandler.PostProductsIdArchive(c, id)
+}
+
+// PostProductsIdUnarchive returns an archived product and its variants to the catalogue.
+func (s *Handler) PostProductsIdUnarchive(c *gin.Context, id int) {
+	s.ProductHandler.PostProductsIdUnarchive(c, id)
+}
+
+// GetProductsLookup finds a product by barcode.
+func (s *Handler) GetProductsLookup(c *gin.Context, params v1.GetProductsLookupParams) {
+	s.ProductHandler.GetProductsLookup(c, params)
+}
+
+// PostProductsIdBarcodes generates an internal barcode for a product.
+func (s *Handler) PostProductsIdBarcodes(c *gin.Context, id int) {
+	s.ProductHandler.PostProductsIdBarcodes(c, id)
+}
+
+// GetBarcodesCode renders a barcode image.
+func (s *Handler) GetBarcodesCode(c *gin.Context, code string, params v1.GetBarcodesCodeParams) {
+	s.ProductHandler.GetBarcodesCode(c, code, params)
+}
+
+// GetProductsSearch searches products by partial, phonetic or misspelt words.
+func (s *Handler) GetProductsSearch(c *gin.Context, params v1.GetProductsSearchParams) {
+	s.ProductHandler.GetProductsSearch(c, params)
+}
+
+// PostProductsImport imports products from a CSV or XLSX file.
+func (s *Handler) PostProductsImport(c *gin.Context, params v1.PostProductsImportParams) {
+	s.ProductHandler.PostProductsImport(c, params)
+}
+
+// GetProductsExport streams the product catalogue as CSV, XLSX or JSON.
+func (s *Handler) GetProductsExport(c *gin.Context, params v1.GetProductsExportParams) {
+	s.ProductHandler.GetProductsExport(c, params)
+}
+
+// PatchProducts changes the price, tax rates or category of many products at once.
+func (s *Handler) PatchProducts(c *gin.Context, params v1.PatchProductsParams) {
+	s.ProductHandler.PatchProducts(c, params)
+}
+
+// GetProductsBulkUpdates lists the bulk product updates made.
+func (s *Handler) GetProductsBulkUpdates(c *gin.Context) {
+	s.ProductHandler.GetProductsBulkUpdates(c)
+}
+
+// GetProductsIdImages retrieves the images of a product.
+func (s *Handler) GetProductsIdImages(c *gin.Context, id int) {
+	s.ImageHandler.GetProductsIdImages(c, id)
+}
+
+// PostProductsIdImages uploads an image of a product.
+func (s *Handler) PostProductsIdImages(c *gin.Context, id int) {
+	s.ImageHandler.PostProductsIdImages(c, id)
+}
+
+// DeleteProductsIdImagesImageId deletes an image of a product.
+func (s *Handler) DeleteProductsIdImagesImageId(c *gin.Context, id int, imageId int) {
+	s.ImageHandler.DeleteProductsIdImagesImageId(c, id, imageId)
+}
+
+// GetImagesKey serves a stored image or thumbnail.
+func (s *Handler) GetImagesKey(c *gin.Context, key string) {
+	s.ImageHandler.GetImagesKey(c, key)
+}
+
+// GetProductsIdVariants retrieves the variants of a product.
+func (s *Handler) GetProductsIdVariants(c *gin.Context, id int) {
+	s.ProductHandler.GetProductsIdVariants(c, id)
+}
+
+// PostProductsIdVariants generates the variants of a product from its options.
+func (s *Handler) PostProductsIdVariants(c *gin.Context, id int) {
+	s.ProductHandler.PostProductsIdVariants(c, id)
+}
+
+// GetCategories retrieves the category tree.
+func (s *Handler) GetCategories(c *gin.Context) {
+	s.CategoryHandler.GetCategories(c)
+}
+
+// PostCategories creates a new category.
+func (s *Handler) PostCategories(c *gin.Context) {
+	s.CategoryHandler.PostCategories(c)
+}
+
+// GetCategoriesId retrieves a category by ID.
+func (s *Handler) GetCategoriesId(c *gin.Context, id int) {
+	s.CategoryHandler.GetCategoriesId(c, id)
+}
+
+// PutCategoriesId updates a category by ID.
+func (s *Handler) PutCategoriesId(c *gin.Context, id int) {
+	s.CategoryHandler.PutCategoriesId(c, id)
+}
+
+// DeleteCategoriesId deletes an unused category by ID.
+func (s *Handler) DeleteCategoriesId(c *gin.Context, id int) {
+	s.CategoryHandler.DeleteCategoriesId(c, id)
+}
+
+// GetProductsIdStock retrieves the stock on hand of a product.
+func (s *Handler) GetProductsIdStock(c *gin.Context, id int) {
+	s.InventoryHandler.GetProductsIdStock(c, id)
+}
+
+// GetProductsIdStockMovements retrieves the stock movements of a product.
+func (s *Handler) GetProductsIdStockMovements(c *gin.Context, id int) {
+	s.InventoryHandler.GetProductsIdStockMovements(c, id)
+}
+
+// PostProductsIdStockMovements posts a stock movement for a product.
+func (s *Handler) PostProductsIdStockMovements(c *gin.Context, id int) {
+	s.InventoryHandler.PostProductsIdStockMovements(c, id)
+}
+
+// GetProductsIdBatches retrieves the batches of a product.
+func (s *Handler) GetProductsIdBatches(c *gin.Context, id int, params v1.GetProductsIdBatchesParams) {
+	s.InventoryHandler.GetProductsIdBatches(c, id, params)
+}
+
+// GetProductsIdPriceHistory retrieves the price and tax rate changes of a product.
+func (s *Handler) GetProductsIdPriceHistory(c *gin.Context, id int) {
+	s.PriceHandler.GetProductsIdPriceHistory(c, id)
+}
+
+// GetProductsIdPriceSchedules retrieves the scheduled prices of a product.
+func (s *Handler) GetProductsIdPriceSchedules(c *gin.Context, id int) {
+	s.PriceHandler.GetProductsIdPriceSchedules(c, id)
+}
+
+// PostProductsIdPriceSchedules schedules a price for a product.
+func (s *Handler) PostProductsIdPriceSchedules(c *gin.Context, id int) {
+	s.PriceHandler.PostProductsIdPriceSchedules(c, id)
+}
+
+// DeleteProductsIdPriceSchedulesScheduleId cancels or ends a scheduled price.
+func (s *Handler) DeleteProductsIdPriceSchedulesScheduleId(c *gin.Context, id int, scheduleId int) {
+	s.PriceHandler.DeleteProductsIdPriceSchedulesScheduleId(c, id, scheduleId)
+}
+
+// GetProductsIdTaxRates retrieves the tax rate schedule of a product.
+func (s *Handler) GetProductsIdTaxRates(c *gin.Context, id int) {
+	s.TaxRateHandler.GetProductsIdTaxRates(c, id)
+}
+
+// PostProductsIdTaxRates schedules a tax rate for a product.
+func (s *Handler) PostProductsIdTaxRates(c *gin.Context, id int) {
+	s.TaxRateHandler.PostProductsIdTaxRates(c, id)
+}
+
+// GetTaxRateChanges retrieves all bulk tax rate changes.
+func (s *Handler) GetTaxRateChanges(c *gin.Context) {
+	s.TaxRateHandler.GetTaxRateChanges(c)
+}
+
+// PostTaxRateChanges schedules a bulk tax rate change.
+func (s *Handler) PostTaxRateChanges(c *gin.Context) {
+	s.TaxRateHandler.PostTaxRateChanges(c)
+}
+
+// PostTaxRateChangesPreview previews the products affected by a bulk tax rate change.
+func (s *Handler) PostTaxRateChangesPreview(c *gin.Context) {
+	s.TaxRateHandler.PostTaxRateChangesPreview(c)
+}
+
+// DeleteTaxRateChangesId cancels a bulk tax rate change by ID.
+func (s *Handler) DeleteTaxRateChangesId(c *gin.Context, id int) {
+	s.TaxRateHandler.DeleteTaxRateChangesId(c, id)
+}
+
 // GetSales retrieves all sales.
 func (s *Handler) GetSales(c *gin.Context) {
 	s.SalesHandler.GetSales(c)
@@ -80,6 +399,11 @@ func (s *Handler) PostSales(c *gin.Context) {
 	s.SalesHandler.PostSales(c)
 }
 
+// PostSalesPreview prices a cart without recording a sale.
+func (s *Handler) PostSalesPreview(c *gin.Context) {
+	s.SalesHandler.PostSalesPreview(c)
+}
+
 // DeleteSalesId deletes a sale by ID.
 func (s *Handler) DeleteSalesId(c *gin.Context, id int) {
 	s.SalesHandler.DeleteSalesId(c, id)
@@ -95,6 +419,21 @@ func (s *Handler) GetSalesIdReceipt(c *gin.Context, id int) {
 	s.SalesHandler.GetSalesIdReceipt(c, id)
 }
 
+// GetSalesIdEwaybill retrieves the e-way bill generated for a sale.
+func (s *Handler) GetSalesIdEwaybill(c *gin.Context, id int) {
+	s.EWayBillHandler.GetSalesIdEwaybill(c, id)
+}
+
+// PostSalesIdEwaybill generates the e-way bill JSON for a sale.
+func (s *Handler) PostSalesIdEwaybill(c *gin.Context, id int) {
+	s.EWayBillHandler.PostSalesIdEwaybill(c, id)
+}
+
+// PutSalesIdEwaybill records the e-way bill number issued for a sale.
+func (s *Handler) PutSalesIdEwaybill(c *gin.Context, id int) {
+	s.EWayBillHandler.PutSalesIdEwaybill(c, id)
+}
+
 // GetSettings retrieves the settings.
 func (s *Handler) GetSettings(c *gin.Context) {
 	s.SettingsHandler.GetSettings(c)
@@ -104,3 +443,303 @@ func (s *Handler) GetSettings(c *gin.Context) {
 func (s *Handler) PutSettings(c *gin.Context) {
 	s.SettingsHandler.PutSettings(c)
 }
+
+// GetCustomers retrieves all customers.
+func (s *Handler) GetCustomers(c *gin.Context) {
+	s.CustomerHandler.GetCustomers(c)
+}
+
+// PostCustomers creates a new customer.
+func (s *Handler) PostCustomers(c *gin.Context) {
+	s.CustomerHandler.PostCustomers(c)
+}
+
+// GetCustomersId retrieves a customer by ID.
+func (s *Handler) GetCustomersId(c *gin.Context, id int) {
+	s.CustomerHandler.GetCustomersId(c, id)
+}
+
+// PutCustomersId updates a customer by ID.
+func (s *Handler) PutCustomersId(c *gin.Context, id int) {
+	s.CustomerHandler.PutCustomersId(c, id)
+}
+
+// GetCustomerGroups retrieves all customer groups.
+func (s *Handler) GetCustomerGroups(c *gin.Context) {
+	s.CustomerHandler.GetCustomerGroups(c)
+}
+
+// PostCustomerGroups creates a new customer group.
+func (s *Handler) PostCustomerGroups(c *gin.Context) {
+	s.CustomerHandler.PostCustomerGroups(c)
+}
+
+// PutCustomerGroupsId updates a customer group by ID.
+func (s *Handler) PutCustomerGroupsId(c *gin.Context, id int) {
+	s.CustomerHandler.PutCustomerGroupsId(c, id)
+}
+
+// DeleteCustomerGroupsId deletes a customer group by ID.
+func (s *Handler) DeleteCustomerGroupsId(c *gin.Context, id int) {
+	s.CustomerHandler.DeleteCustomerGroupsId(c, id)
+}
+
+// GetPriceLists retrieves all price lists.
+func (s *Handler) GetPriceLists(c *gin.Context) {
+	s.PriceListHandler.GetPriceLists(c)
+}
+
+// PostPriceLists creates a new price list.
+func (s *Handler) PostPriceLists(c *gin.Context) {
+	s.PriceListHandler.PostPriceLists(c)
+}
+
+// GetPriceListsId retrieves a price list by ID.
+func (s *Handler) GetPriceListsId(c *gin.Context, id int) {
+	s.PriceListHandler.GetPriceListsId(c, id)
+}
+
+// PutPriceListsId updates a price list by ID.
+func (s *Handler) PutPriceListsId(c *gin.Context, id int) {
+	s.PriceListHandler.PutPriceListsId(c, id)
+}
+
+// DeletePriceListsId deletes a price list by ID.
+func (s *Handler) DeletePriceListsId(c *gin.Context, id int) {
+	s.PriceListHandler.DeletePriceListsId(c, id)
+}
+
+// GetPriceListsIdPrices retrieves the product prices of a price list.
+func (s *Handler) GetPriceListsIdPrices(c *gin.Context, id int) {
+	s.PriceListHandler.GetPriceListsIdPrices(c, id)
+}
+
+// PutPriceListsIdPricesProductId sets the price tiers of a product on a price list.
+func (s *Handler) PutPriceListsIdPricesProductId(c *gin.Context, id int, productId int) {
+	s.PriceListHandler.PutPriceListsIdPricesProductId(c, id, productId)
+}
+
+// DeletePriceListsIdPricesProductId removes a product from a price list.
+func (s *Handler) DeletePriceListsIdPricesProductId(c *gin.Context, id int, productId int) {
+	s.PriceListHandler.DeletePriceListsIdPricesProductId(c, id, productId)
+}
+
+// GetPromotions retrieves all promotions.
+func (s *Handler) GetPromotions(c *gin.Context) {
+	s.PromotionHandler.GetPromotions(c)
+}
+
+// PostPromotions creates a new promotion.
+func (s *Handler) PostPromotions(c *gin.Context) {
+	s.PromotionHandler.PostPromotions(c)
+}
+
+// GetPromotionsId retrieves a promotion by ID.
+func (s *Handler) GetPromotionsId(c *gin.Context, id int) {
+	s.PromotionHandler.GetPromotionsId(c, id)
+}
+
+// PutPromotionsId updates a promotion by ID.
+func (s *Handler) PutPromotionsId(c *gin.Context, id int) {
+	s.PromotionHandler.PutPromotionsId(c, id)
+}
+
+// DeletePromotionsId deletes a promotion by ID.
+func (s *Handler) DeletePromotionsId(c *gin.Context, id int) {
+	s.PromotionHandler.DeletePromotionsId(c, id)
+}
+
+// GetSuppliers retrieves all suppliers.
+func (s *Handler) GetSuppliers(c *gin.Context) {
+	s.SupplierHandler.GetSuppliers(c)
+}
+
+// PostSuppliers creates a new supplier.
+func (s *Handler) PostSuppliers(c *gin.Context) {
+	s.SupplierHandler.PostSuppliers(c)
+}
+
+// GetSuppliersId retrieves a supplier by ID.
+func (s *Handler) GetSuppliersId(c *gin.Context, id int) {
+	s.SupplierHandler.GetSuppliersId(c, id)
+}
+
+// PutSuppliersId updates a supplier by ID.
+func (s *Handler) PutSuppliersId(c *gin.Context, id int) {
+	s.SupplierHandler.PutSuppliersId(c, id)
+}
+
+// GetSuppliersIdPurchaseOrders retrieves the purchase orders of a supplier.
+func (s *Handler) GetSuppliersIdPurchaseOrders(c *gin.Context, id int, params v1.GetSuppliersIdPurchaseOrdersParams) {
+	s.PurchaseHandler.GetSuppliersIdPurchaseOrders(c, id, params)
+}
+
+// GetPurchaseOrders retrieves all purchase orders.
+func (s *Handler) GetPurchaseOrders(c *gin.Context, params v1.GetPurchaseOrdersParams) {
+	s.PurchaseHandler.GetPurchaseOrders(c, params)
+}
+
+// PostPurchaseOrders places a new purchase order.
+func (s *Handler) PostPurchaseOrders(c *gin.Context) {
+	s.PurchaseHandler.PostPurchaseOrders(c)
+}
+
+// GetPurchaseOrdersId retrieves a purchase order by ID.
+func (s *Handler) GetPurchaseOrdersId(c *gin.Context, id int) {
+	s.PurchaseHandler.GetPurchaseOrdersId(c, id)
+}
+
+// PutPurchaseOrdersId edits a draft purchase order.
+func (s *Handler) PutPurchaseOrdersId(c *gin.Context, id int) {
+	s.PurchaseHandler.PutPurchaseOrdersId(c, id)
+}
+
+// PostPurchaseOrdersIdPlace places a draft purchase order.
+func (s *Handler) PostPurchaseOrdersIdPlace(c *gin.Context, id int) {
+	s.PurchaseHandler.PostPurchaseOrdersIdPlace(c, id)
+}
+
+// PostPurchaseOrdersIdClose closes a purchase order.
+func (s *Handler) PostPurchaseOrdersIdClose(c *gin.Context, id int) {
+	s.PurchaseHandler.PostPurchaseOrdersIdClose(c, id)
+}
+
+// GetPurchaseOrdersIdGoodsReceipts retrieves the goods receipt notes of a purchase order.
+func (s *Handler) GetPurchaseOrdersIdGoodsReceipts(c *gin.Context, id int) {
+	s.PurchaseHandler.GetPurchaseOrdersIdGoodsReceipts(c, id)
+}
+
+// PostPurchaseOrdersIdGoodsReceipts receives goods against a purchase order.
+func (s *Handler) PostPurchaseOrdersIdGoodsReceipts(c *gin.Context, id int) {
+	s.PurchaseHandler.PostPurchaseOrdersIdGoodsReceipts(c, id)
+}
+
+// GetGoodsReceiptsId retrieves a goods receipt note by ID.
+func (s *Handler) GetGoodsReceiptsId(c *gin.Context, id int) {
+	s.PurchaseHandler.GetGoodsReceiptsId(c, id)
+}
+
+// GetStocktakes retrieves all stocktakes.
+func (s *Handler) GetStocktakes(c *gin.Context, params v1.GetStocktakesParams) {
+	s.StocktakeHandler.GetStocktakes(c, params)
+}
+
+// PostStocktakes starts a new stocktake.
+func (s *Handler) PostStocktakes(c *gin.Context) {
+	s.StocktakeHandler.PostStocktakes(c)
+}
+
+// GetStocktakesId retrieves a stocktake by ID.
+func (s *Handler) GetStocktakesId(c *gin.Context, id int) {
+	s.StocktakeHandler.GetStocktakesId(c, id)
+}
+
+// GetStocktakesIdCounts retrieves the counts recorded for a stocktake.
+func (s *Handler) GetStocktakesIdCounts(c *gin.Context, id int) {
+	s.StocktakeHandler.GetStocktakesIdCounts(c, id)
+}
+
+// PostStocktakesIdCounts records counts for a stocktake.
+func (s *Handler) PostStocktakesIdCounts(c *gin.Context, id int) {
+	s.StocktakeHandler.PostStocktakesIdCounts(c, id)
+}
+
+// PostStocktakesIdApprove approves a stocktake and posts its variances.
+func (s *Handler) PostStocktakesIdApprove(c *gin.Context, id int) {
+	s.StocktakeHandler.PostStocktakesIdApprove(c, id)
+}
+
+// PostStocktakesIdCancel cancels a stocktake.
+func (s *Handler) PostStocktakesIdCancel(c *gin.Context, id int) {
+	s.StocktakeHandler.PostStocktakesIdCancel(c, id)
+}
+
+// GetStores retrieves all stores.
+func (s *Handler) GetStores(c *gin.Context) {
+	s.StoreHandler.GetStores(c)
+}
+
+// PostStores creates a new store.
+func (s *Handler) PostStores(c *gin.Context) {
+	s.StoreHandler.PostStores(c)
+}
+
+// GetStoresId retrieves a store by ID.
+func (s *Handler) GetStoresId(c *gin.Context, id int) {
+	s.StoreHandler.GetStoresId(c, id)
+}
+
+// PutStoresId updates a store by ID.
+func (s *Handler) PutStoresId(c *gin.Context, id int) {
+	s.StoreHandler.PutStoresId(c, id)
+}
+
+// GetTransfers retrieves stock transfers.
+func (s *Handler) GetTransfers(c *gin.Context, params v1.GetTransfersParams) {
+	s.TransferHandler.GetTransfers(c, params)
+}
+
+// PostTransfers creates a new stock transfer.
+func (s *Handler) PostTransfers(c *gin.Context) {
+	s.TransferHandler.PostTransfers(c)
+}
+
+// GetTransfersId retrieves a stock transfer by ID.
+func (s *Handler) GetTransfersId(c *gin.Context, id int) {
+	s.TransferHandler.GetTransfersId(c, id)
+}
+
+// PostTransfersIdDispatch dispatches a stock transfer.
+func (s *Handler) PostTransfersIdDispatch(c *gin.Context, id int) {
+	s.TransferHandler.PostTransfersIdDispatch(c, id)
+}
+
+// PostTransfersIdReceive records the receipt of a stock transfer.
+func (s *Handler) PostTransfersIdReceive(c *gin.Context, id int) {
+	s.TransferHandler.PostTransfersIdReceive(c, id)
+}
+
+// PostTransfersIdCancel cancels a stock transfer.
+func (s *Handler) PostTransfersIdCancel(c *gin.Context, id int) {
+	s.TransferHandler.PostTransfersIdCancel(c, id)
+}
+
+// GetTransfersIdChallan renders the delivery challan of a stock transfer.
+func (s *Handler) GetTransfersIdChallan(c *gin.Context, id int) {
+	s.TransferHandler.GetTransfersIdChallan(c, id)
+}
+
+// GetReportsTax retrieves the tax summary report.
+func (s *Handler) GetReportsTax(c *gin.Context, params v1.GetReportsTaxParams) {
+	s.ReportHandler.GetReportsTax(c, params)
+}
+
+// GetReportsCmp08 retrieves the quarterly CMP-08 summary.
+func (s *Handler) GetReportsCmp08(c *gin.Context, params v1.GetReportsCmp08Params) {
+	s.ReportHandler.GetReportsCmp08(c, params)
+}
+
+// GetReportsNearExpiry retrieves the batches nearing expiry.
+func (s *Handler) GetReportsNearExpiry(c *gin.Context, params v1.GetReportsNearExpiryParams) {
+	s.ReportHandler.GetReportsNearExpiry(c, params)
+}
+
+// GetReportsInputTax retrieves the input tax summary.
+func (s *Handler) GetReportsInputTax(c *gin.Context, params v1.GetReportsInputTaxParams) {
+	s.ReportHandler.GetReportsInputTax(c, params)
+}
+
+// GetReportsLowStock retrieves the products at or below their reorder level.
+func (s *Handler) GetReportsLowStock(c *gin.Context, params v1.GetReportsLowStockParams) {
+	s.ReportHandler.GetReportsLowStock(c, params)
+}
+
+// GetReportsStockValuation retrieves the value of stock at cost as of a date.
+func (s *Handler) GetReportsStockValuation(c *gin.Context, params v1.GetReportsStockValuationParams) {
+	s.ReportHandler.GetReportsStockValuation(c, params)
+}
+
+// PostReportsLowStockPurchaseOrders drafts purchase orders from the low-stock report.
+func (s *Handler) PostReportsLowStockPurchaseOrders(c *gin.Context, params v1.PostReportsLowStockPurchaseOrdersParams) {
+	s.PurchaseHandler.PostReportsLowStockPurchaseOrders(c, params)
+}
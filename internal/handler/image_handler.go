@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nitinjangam/pos-receipt-system/internal/service"
+	"go.uber.org/zap"
+)
+
+type ImageHandlerInterface interface {
+	GetProductsIdImages(c *gin.Context, id int)
+	PostProductsIdImages(c *gin.Context, id int)
+	DeleteProductsIdImagesImageId(c *gin.Context, id int, imageId int)
+	GetImagesKey(c *gin.Context, key string)
+}
+
+type ImageHandler struct {
+	imageService service.ImageServiceInterface
+	logger       *zap.SugaredLogger
+}
+
+func NewImageHandler(imageService service.ImageServiceInterface, logger *zap.SugaredLogger) ImageHandlerInterface {
+	return &ImageHandler{
+		imageService: imageService,
+		logger:       logger,
+	}
+}
+
+func (s *ImageHandler) GetProductsIdImages(c *gin.Context, id int) {
+	images, err := s.imageService.GetProductImages(c.Request.Context(), id)
+	if err != nil {
+		s.imageError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"images": images,
+	})
+}
+
+func (s *ImageHandler) PostProductsIdImages(c *gin.Context, id int) {
+	header, err := c.FormFile("image")
+	if err != nil {
+		s.logger.Debugw("Failed to read image upload", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		s.logger.Debugw("Failed to open image upload", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+	defer file.Close()
+
+	image, err := s.imageService.PostProductImage(c.Request.Context(), id, file)
+	if err != nil {
+		s.imageError(c, err)
+		return
+	}
+	c.JSON(201, gin.H{
+		"image": image,
+	})
+}
+
+func (s *ImageHandler) DeleteProductsIdImagesImageId(c *gin.Context, id int, imageId int) {
+	if err := s.imageService.DeleteProductImage(c.Request.Context(), id, imageId); err != nil {
+		s.imageError(c, err)
+		return
+	}
+	c.Status(204)
+}
+
+func (s *ImageHandler) GetImagesKey(c *gin.Context, key string) {
+	data, contentType, err := s.imageService.GetImageFile(c.Request.Context(), key)
+	if err != nil {
+		s.imageError(c, err)
+		return
+	}
+	// Keys are never reused, so a stored file never changes.
+	c.Header("Cache-Control", "public, max-age=31536000, immutable")
+	c.Header("X-Content-Type-Options", "nosniff")
+	c.Data(200, contentType, data)
+}
+
+func (s *ImageHandler) imageError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrProductNotFound):
+		c.JSON(404, gin.H{"message": "Product not found"})
+	case errors.Is(err, service.ErrImageNotFound):
+		c.JSON(404, gin.H{"message": "Image not found"})
+	case errors.Is(err, service.ErrInvalidImage):
+		c.JSON(400, gin.H{"message": err.Error()})
+	case errors.Is(err, service.ErrImageTooLarge):
+		c.JSON(413, gin.H{"message": err.Error()})
+	default:
+		s.logger.Debugw("Image request failed", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+	}
+}
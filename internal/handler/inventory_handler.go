@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/service"
+	"go.uber.org/zap"
+)
+
+type InventoryHandlerInterface interface {
+	GetProductsIdStock(c *gin.Context, id int)
+	GetProductsIdStockMovements(c *gin.Context, id int)
+	PostProductsIdStockMovements(c *gin.Context, id int)
+	GetProductsIdBatches(c *gin.Context, id int, params v1.GetProductsIdBatchesParams)
+}
+
+type InventoryHandler struct {
+	inventoryService service.InventoryServiceInterface
+	logger           *zap.SugaredLogger
+}
+
+func NewInventoryHandler(inventoryService service.InventoryServiceInterface, logger *zap.SugaredLogger) InventoryHandlerInterface {
+	return &InventoryHandler{
+		inventoryService: inventoryService,
+		logger:           logger,
+	}
+}
+
+func (s *InventoryHandler) GetProductsIdStock(c *gin.Context, id int) {
+	level, err := s.inventoryService.GetStockLevel(c.Request.Context(), id)
+	if err != nil {
+		s.inventoryError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"stock": level,
+	})
+}
+
+func (s *InventoryHandler) GetProductsIdStockMovements(c *gin.Context, id int) {
+	movements, err := s.inventoryService.GetStockMovements(c.Request.Context(), id)
+	if err != nil {
+		s.inventoryError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"movements": movements,
+	})
+}
+
+func (s *InventoryHandler) PostProductsIdStockMovements(c *gin.Context, id int) {
+	var request v1.StockMovementRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		s.logger.Debugw("Failed to bind stock movement", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	movement, err := s.inventoryService.PostStockMovement(c.Request.Context(), id, request)
+	if err != nil {
+		s.inventoryError(c, err)
+		return
+	}
+	c.JSON(201, gin.H{
+		"movement": movement,
+	})
+}
+
+func (s *InventoryHandler) GetProductsIdBatches(c *gin.Context, id int, params v1.GetProductsIdBatchesParams) {
+	batches, err := s.inventoryService.GetBatches(c.Request.Context(), id, params)
+	if err != nil {
+		s.inventoryError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"batches": batches,
+	})
+}
+
+func (s *InventoryHandler) inventoryError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrProductNotFound):
+		c.JSON(404, gin.H{"message": "Product not found"})
+	case errors.Is(err, service.ErrInvalidStockMovement):
+		c.JSON(400, gin.H{"message": err.Error()})
+	case errors.Is(err, service.ErrInsufficientStock):
+		c.JSON(409, gin.H{"message": err.Error()})
+	default:
+		s.logger.Debugw("Inventory request failed", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+	}
+}
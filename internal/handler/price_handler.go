@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/service"
+	"go.uber.org/zap"
+)
+
+type PriceHandlerInterface interface {
+	GetProductsIdPriceHistory(c *gin.Context, id int)
+	GetProductsIdPriceSchedules(c *gin.Context, id int)
+	PostProductsIdPriceSchedules(c *gin.Context, id int)
+	DeleteProductsIdPriceSchedulesScheduleId(c *gin.Context, id int, scheduleId int)
+}
+
+type PriceHandler struct {
+	priceService service.PriceServiceInterface
+	logger       *zap.SugaredLogger
+}
+
+func NewPriceHandler(priceService service.PriceServiceInterface, logger *zap.SugaredLogger) PriceHandlerInterface {
+	return &PriceHandler{
+		priceService: priceService,
+		logger:       logger,
+	}
+}
+
+func (s *PriceHandler) GetProductsIdPriceHistory(c *gin.Context, id int) {
+	changes, err := s.priceService.GetPriceHistory(c.Request.Context(), id)
+	if err != nil {
+		s.priceError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"priceChanges": changes,
+	})
+}
+
+func (s *PriceHandler) GetProductsIdPriceSchedules(c *gin.Context, id int) {
+	schedules, err := s.priceService.GetPriceSchedules(c.Request.Context(), id)
+	if err != nil {
+		s.priceError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"priceSchedules": schedules,
+	})
+}
+
+func (s *PriceHandler) PostProductsIdPriceSchedules(c *gin.Context, id int) {
+	var schedule v1.PriceSchedule
+	if err := c.ShouldBindJSON(&schedule); err != nil {
+		s.logger.Debugw("Failed to bind price schedule", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	created, err := s.priceService.PostPriceSchedule(c.Request.Context(), id, schedule)
+	if err != nil {
+		s.priceError(c, err)
+		return
+	}
+	c.JSON(201, gin.H{
+		"priceSchedule": created,
+	})
+}
+
+func (s *PriceHandler) DeleteProductsIdPriceSchedulesScheduleId(c *gin.Context, id int, scheduleId int) {
+	if err := s.priceService.DeletePriceSchedule(c.Request.Context(), id, scheduleId); err != nil {
+		s.priceError(c, err)
+		return
+	}
+
+	c.JSON(204, gin.H{"message": "Price schedule cancelled"})
+}
+
+func (s *PriceHandler) priceError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrProductNotFound):
+		c.JSON(404, gin.H{"message": "Product not found"})
+	case errors.Is(err, service.ErrPriceScheduleNotFound):
+		c.JSON(404, gin.H{"message": "Price schedule not found"})
+	case errors.Is(err, service.ErrInvalidPriceSchedule):
+		c.JSON(400, gin.H{"message": err.Error()})
+	case errors.Is(err, service.ErrPriceScheduleConflict):
+		c.JSON(409, gin.H{"message": err.Error()})
+	case errors.Is(err, service.ErrPriceScheduleEnded):
+		c.JSON(409, gin.H{"message": "Price schedule has already ended"})
+	default:
+		s.logger.Debugw("Price request failed", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+	}
+}
@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/service"
+	"go.uber.org/zap"
+)
+
+type PriceListHandlerInterface interface {
+	GetPriceLists(c *gin.Context)
+	PostPriceLists(c *gin.Context)
+	GetPriceListsId(c *gin.Context, id int)
+	PutPriceListsId(c *gin.Context, id int)
+	DeletePriceListsId(c *gin.Context, id int)
+	GetPriceListsIdPrices(c *gin.Context, id int)
+	PutPriceListsIdPricesProductId(c *gin.Context, id int, productId int)
+	DeletePriceListsIdPricesProductId(c *gin.Context, id int, productId int)
+}
+
+type PriceListHandler struct {
+	priceListService service.PriceListServiceInterface
+	logger           *zap.SugaredLogger
+}
+
+func NewPriceListHandler(priceListService service.PriceListServiceInterface, logger *zap.SugaredLogger) PriceListHandlerInterface {
+	return &PriceListHandler{
+		priceListService: priceListService,
+		logger:           logger,
+	}
+}
+
+func (s *PriceListHandler) GetPriceLists(c *gin.Context) {
+	priceLists, err := s.priceListService.GetPriceLists(c.Request.Context())
+	if err != nil {
+		s.logger.Debugw("Failed to get price lists", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"priceLists": priceLists,
+	})
+}
+
+func (s *PriceListHandler) PostPriceLists(c *gin.Context) {
+	var priceList v1.PriceList
+	if err := c.ShouldBindJSON(&priceList); err != nil {
+		s.logger.Debugw("Failed to bind price list", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	created, err := s.priceListService.PostPriceList(c.Request.Context(), priceList)
+	if err != nil {
+		s.priceListError(c, err)
+		return
+	}
+	c.JSON(201, gin.H{
+		"priceList": created,
+	})
+}
+
+func (s *PriceListHandler) GetPriceListsId(c *gin.Context, id int) {
+	priceList, err := s.priceListService.GetPriceList(c.Request.Context(), id)
+	if err != nil {
+		s.priceListError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"priceList": priceList,
+	})
+}
+
+func (s *PriceListHandler) PutPriceListsId(c *gin.Context, id int) {
+	var priceList v1.PriceList
+	if err := c.ShouldBindJSON(&priceList); err != nil {
+		s.logger.Debugw("Failed to bind price list", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+	priceList.Id = &id
+
+	updated, err := s.priceListService.PutPriceList(c.Request.Context(), priceList)
+	if err != nil {
+		s.priceListError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"priceList": updated,
+	})
+}
+
+func (s *PriceListHandler) DeletePriceListsId(c *gin.Context, id int) {
+	if err := s.priceListService.DeletePriceList(c.Request.Context(), id); err != nil {
+		s.priceListError(c, err)
+		return
+	}
+	c.Status(204)
+}
+
+func (s *PriceListHandler) GetPriceListsIdPrices(c *gin.Context, id int) {
+	prices, err := s.priceListService.GetPrices(c.Request.Context(), id)
+	if err != nil {
+		s.priceListError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"prices": prices,
+	})
+}
+
+func (s *PriceListHandler) PutPriceListsIdPricesProductId(c *gin.Context, id int, productId int) {
+	var price v1.PriceListPrice
+	if err := c.ShouldBindJSON(&price); err != nil {
+		s.logger.Debugw("Failed to bind price list price", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	updated, err := s.priceListService.PutPrice(c.Request.Context(), id, productId, price)
+	if err != nil {
+		s.priceListError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"price": updated,
+	})
+}
+
+func (s *PriceListHandler) DeletePriceListsIdPricesProductId(c *gin.Context, id int, productId int) {
+	if err := s.priceListService.DeletePrice(c.Request.Context(), id, productId); err != nil {
+		s.priceListError(c, err)
+		return
+	}
+	c.Status(204)
+}
+
+func (s *PriceListHandler) priceListError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrPriceListNotFound):
+		c.JSON(404, gin.H{"message": "Price list not found"})
+	case errors.Is(err, service.ErrProductNotFound):
+		c.JSON(404, gin.H{"message": "Product not found"})
+	case errors.Is(err, service.ErrPriceNotFound):
+		c.JSON(404, gin.H{"message": "Product has no price on the price list"})
+	case errors.Is(err, service.ErrInvalidPriceList):
+		c.JSON(400, gin.H{"message": err.Error()})
+	case errors.Is(err, service.ErrPriceListInUse):
+		c.JSON(409, gin.H{"message": err.Error()})
+	default:
+		s.logger.Debugw("Price list request failed", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+	}
+}
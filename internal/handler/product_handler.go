@@ -1,6 +1,11 @@
 package handler
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/gin-gonic/gin"
 	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
 	"github.com/nitinjangam/pos-receipt-system/internal/service"
@@ -12,6 +17,18 @@ type ProductHandlerInterface interface {
 	PostProducts(c *gin.Context)
 	PutProductsId(c *gin.Context, id int)
 	DeleteProductsId(c *gin.Context, id int)
+	PostProductsIdArchive(c *gin.Context, id int)
+	PostProductsIdUnarchive(c *gin.Context, id int)
+	GetProductsLookup(c *gin.Context, params v1.GetProductsLookupParams)
+	GetProductsSearch(c *gin.Context, params v1.GetProductsSearchParams)
+	PostProductsImport(c *gin.Context, params v1.PostProductsImportParams)
+	GetProductsExport(c *gin.Context, params v1.GetProductsExportParams)
+	PatchProducts(c *gin.Context, params v1.PatchProductsParams)
+	GetProductsBulkUpdates(c *gin.Context)
+	PostProductsIdBarcodes(c *gin.Context, id int)
+	GetBarcodesCode(c *gin.Context, code string, params v1.GetBarcodesCodeParams)
+	GetProductsIdVariants(c *gin.Context, id int)
+	PostProductsIdVariants(c *gin.Context, id int)
 }
 
 type ProductHandler struct {
@@ -27,13 +44,13 @@ func NewProductHandler(productService service.ProductServiceInterface, logger *z
 }
 
 func (s *ProductHandler) GetProducts(c *gin.Context, params v1.GetProductsParams) {
-	productName := ""
-	if params.Name != nil {
-		productName = *params.Name
-	}
 	// get products
-	products, err := s.productService.GetProducts(c.Request.Context(), productName)
+	products, err := s.productService.GetProducts(c.Request.Context(), params)
 	if err != nil {
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			c.JSON(404, gin.H{"message": "Category not found"})
+			return
+		}
 		s.logger.Debugw("Failed to get products", "error", err)
 		c.JSON(500, gin.H{"message": "Internal Server Error"})
 		return
@@ -54,6 +71,9 @@ func (s *ProductHandler) PostProducts(c *gin.Context) {
 
 	// store products
 	if err := s.productService.PostProducts(c.Request.Context(), product); err != nil {
+		if productCodeError(c, err) {
+			return
+		}
 		s.logger.Debugw("Failed to post products", "error", err)
 		c.JSON(500, gin.H{"message": "Internal Server Error"})
 		return
@@ -70,9 +90,17 @@ func (s *ProductHandler) PutProductsId(c *gin.Context, id int) {
 		c.JSON(400, gin.H{"message": "Bad Request"})
 		return
 	}
+	product.Id = &id
 	// update product by id
 	updatedProduct, err := s.productService.PutProductsId(c.Request.Context(), product)
 	if err != nil {
+		if errors.Is(err, service.ErrProductNotFound) {
+			c.JSON(404, gin.H{"message": "Product not found"})
+			return
+		}
+		if productCodeError(c, err) {
+			return
+		}
 		s.logger.Debugw("Failed to update product", "error", err)
 		c.JSON(500, gin.H{"message": "Internal Server Error"})
 		return
@@ -87,10 +115,301 @@ func (s *ProductHandler) PutProductsId(c *gin.Context, id int) {
 func (s *ProductHandler) DeleteProductsId(c *gin.Context, id int) {
 	// delete product by id
 	if err := s.productService.DeleteProductsId(c.Request.Context(), id); err != nil {
-		s.logger.Debugw("Failed to delete product", "error", err)
-		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		switch {
+		case errors.Is(err, service.ErrProductNotFound):
+			c.JSON(404, gin.H{"message": "Product not found"})
+		case errors.Is(err, service.ErrProductSold), errors.Is(err, service.ErrProductPurchased), errors.Is(err, service.ErrProductStocked),
+			errors.Is(err, service.ErrProductConflict):
+			c.JSON(409, gin.H{"message": err.Error()})
+		default:
+			s.logger.Debugw("Failed to delete product", "error", err)
+			c.JSON(500, gin.H{"message": "Internal Server Error"})
+		}
 		return
 	}
 
 	c.JSON(204, gin.H{"message": "Product deleted successfully"})
 }
+
+func (s *ProductHandler) PostProductsIdArchive(c *gin.Context, id int) {
+	product, err := s.productService.ArchiveProduct(c.Request.Context(), id)
+	s.activeResponse(c, product, err)
+}
+
+func (s *ProductHandler) PostProductsIdUnarchive(c *gin.Context, id int) {
+	product, err := s.productService.UnarchiveProduct(c.Request.Context(), id)
+	s.activeResponse(c, product, err)
+}
+
+func (s *ProductHandler) activeResponse(c *gin.Context, product v1.Product, err error) {
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrProductNotFound):
+			c.JSON(404, gin.H{"message": "Product not found"})
+		case errors.Is(err, service.ErrInvalidProduct):
+			c.JSON(400, gin.H{"message": err.Error()})
+		default:
+			s.logger.Debugw("Failed to change product active flag", "error", err)
+			c.JSON(500, gin.H{"message": "Internal Server Error"})
+		}
+		return
+	}
+	c.JSON(200, gin.H{
+		"product": product,
+	})
+}
+
+func (s *ProductHandler) GetProductsLookup(c *gin.Context, params v1.GetProductsLookupParams) {
+	product, err := s.productService.LookupProduct(c.Request.Context(), params.Barcode)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidBarcode):
+			c.JSON(400, gin.H{"message": err.Error()})
+		case errors.Is(err, service.ErrProductNotFound):
+			c.JSON(404, gin.H{"message": "Product not found"})
+		default:
+			s.logger.Debugw("Failed to look up product", "error", err)
+			c.JSON(500, gin.H{"message": "Internal Server Error"})
+		}
+		return
+	}
+	c.JSON(200, gin.H{
+		"product": product,
+	})
+}
+
+func (s *ProductHandler) GetProductsSearch(c *gin.Context, params v1.GetProductsSearchParams) {
+	results, err := s.productService.SearchProducts(c.Request.Context(), params)
+	if err != nil {
+		s.logger.Debugw("Failed to search products", "error", err, "query", params.Q)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"search": results,
+	})
+}
+
+func (s *ProductHandler) PostProductsImport(c *gin.Context, params v1.PostProductsImportParams) {
+	header, err := c.FormFile("file")
+	if err != nil {
+		s.logger.Debugw("Failed to read import file", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		s.logger.Debugw("Failed to open import file", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+	defer file.Close()
+
+	var mapping map[string]string
+	if raw := c.PostForm("mapping"); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &mapping); err != nil {
+			c.JSON(400, gin.H{"message": "mapping must be a JSON object from product field to column header"})
+			return
+		}
+	}
+
+	upload := service.ProductImport{
+		Filename: header.Filename,
+		File:     file,
+		Mapping:  mapping,
+		Sheet:    c.PostForm("sheet"),
+	}
+	if params.Mode != nil {
+		upload.Mode = *params.Mode
+	}
+	if params.DryRun != nil {
+		upload.DryRun = *params.DryRun
+	}
+	report, err := s.productService.ImportProducts(c.Request.Context(), upload)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidImport) {
+			c.JSON(400, gin.H{"message": err.Error()})
+			return
+		}
+		s.logger.Debugw("Failed to import products", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+
+	// A real import with invalid rows writes nothing.
+	status := 200
+	if !upload.DryRun && len(*report.Errors) > 0 {
+		status = 422
+	}
+	c.JSON(status, gin.H{
+		"report": report,
+	})
+}
+
+// exportContentTypes maps each export format to its media type.
+var exportContentTypes = map[v1.GetProductsExportParamsFormat]string{
+	v1.Csv:  "text/csv; charset=utf-8",
+	v1.Xlsx: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	v1.Json: "application/json; charset=utf-8",
+}
+
+func (s *ProductHandler) GetProductsExport(c *gin.Context, params v1.GetProductsExportParams) {
+	format := v1.Csv
+	if params.Format != nil {
+		format = *params.Format
+	}
+	c.Header("Content-Type", exportContentTypes[format])
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="products-%s.%s"`, time.Now().Format("20060102"), format))
+
+	err := s.productService.ExportProducts(c.Request.Context(), params, c.Writer)
+	if err == nil {
+		return
+	}
+	if c.Writer.Written() {
+		// The response is under way; all that is left is to cut it short.
+		s.logger.Errorw("Product export failed mid-stream", "error", err)
+		c.Abort()
+		return
+	}
+	c.Writer.Header().Del("Content-Disposition")
+	if errors.Is(err, service.ErrCategoryNotFound) {
+		c.JSON(404, gin.H{"message": "Category not found"})
+		return
+	}
+	s.logger.Debugw("Failed to export products", "error", err)
+	c.JSON(500, gin.H{"message": "Internal Server Error"})
+}
+
+func (s *ProductHandler) PatchProducts(c *gin.Context, params v1.PatchProductsParams) {
+	var update v1.ProductBulkUpdate
+	if err := c.ShouldBindJSON(&update); err != nil {
+		s.logger.Debugw("Failed to bind bulk update", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	dryRun := params.DryRun != nil && *params.DryRun
+	result, err := s.productService.BulkUpdateProducts(c.Request.Context(), update, dryRun)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidBulkUpdate) {
+			c.JSON(400, gin.H{"message": err.Error()})
+			return
+		}
+		s.logger.Debugw("Failed to bulk update products", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"bulkUpdate": result,
+	})
+}
+
+func (s *ProductHandler) GetProductsBulkUpdates(c *gin.Context) {
+	updates, err := s.productService.GetBulkUpdates(c.Request.Context())
+	if err != nil {
+		s.logger.Debugw("Failed to get bulk updates", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"bulkUpdates": updates,
+	})
+}
+
+func (s *ProductHandler) PostProductsIdBarcodes(c *gin.Context, id int) {
+	product, err := s.productService.GenerateBarcode(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, service.ErrProductNotFound) {
+			c.JSON(404, gin.H{"message": "Product not found"})
+			return
+		}
+		if productCodeError(c, err) {
+			return
+		}
+		s.logger.Debugw("Failed to generate barcode", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(201, gin.H{
+		"product": product,
+	})
+}
+
+func (s *ProductHandler) GetBarcodesCode(c *gin.Context, code string, params v1.GetBarcodesCodeParams) {
+	format := v1.Svg
+	if params.Format != nil {
+		format = *params.Format
+	}
+	image, err := s.productService.GetBarcodeImage(code, format)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidBarcode) {
+			c.JSON(400, gin.H{"message": err.Error()})
+			return
+		}
+		s.logger.Debugw("Failed to render barcode", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+
+	contentType := "image/svg+xml"
+	if format == v1.Png {
+		contentType = "image/png"
+	}
+	c.Data(200, contentType, image)
+}
+
+func (s *ProductHandler) GetProductsIdVariants(c *gin.Context, id int) {
+	variants, err := s.productService.GetVariants(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, service.ErrProductNotFound) {
+			c.JSON(404, gin.H{"message": "Product not found"})
+			return
+		}
+		s.logger.Debugw("Failed to get variants", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"variants": variants,
+	})
+}
+
+func (s *ProductHandler) PostProductsIdVariants(c *gin.Context, id int) {
+	var request v1.VariantGeneration
+	if err := c.ShouldBindJSON(&request); err != nil {
+		s.logger.Debugw("Failed to bind variant options", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	variants, err := s.productService.GenerateVariants(c.Request.Context(), id, request)
+	if err != nil {
+		if errors.Is(err, service.ErrProductNotFound) {
+			c.JSON(404, gin.H{"message": "Product not found"})
+			return
+		}
+		if productCodeError(c, err) {
+			return
+		}
+		s.logger.Debugw("Failed to generate variants", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(201, gin.H{
+		"variants": variants,
+	})
+}
+
+// productCodeError writes the response for SKU and barcode validation errors
+// and reports whether err was one of them.
+func productCodeError(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, service.ErrInvalidProduct):
+		c.JSON(400, gin.H{"message": err.Error()})
+	case errors.Is(err, service.ErrProductConflict):
+		c.JSON(409, gin.H{"message": err.Error()})
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/service"
+	"go.uber.org/zap"
+)
+
+type PromotionHandlerInterface interface {
+	GetPromotions(c *gin.Context)
+	PostPromotions(c *gin.Context)
+	GetPromotionsId(c *gin.Context, id int)
+	PutPromotionsId(c *gin.Context, id int)
+	DeletePromotionsId(c *gin.Context, id int)
+}
+
+type PromotionHandler struct {
+	promotionService service.PromotionServiceInterface
+	logger           *zap.SugaredLogger
+}
+
+func NewPromotionHandler(promotionService service.PromotionServiceInterface, logger *zap.SugaredLogger) PromotionHandlerInterface {
+	return &PromotionHandler{
+		promotionService: promotionService,
+		logger:           logger,
+	}
+}
+
+func (s *PromotionHandler) GetPromotions(c *gin.Context) {
+	promotions, err := s.promotionService.GetPromotions(c.Request.Context())
+	if err != nil {
+		s.logger.Debugw("Failed to get promotions", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"promotions": promotions,
+	})
+}
+
+func (s *PromotionHandler) PostPromotions(c *gin.Context) {
+	var promotion v1.Promotion
+	if err := c.ShouldBindJSON(&promotion); err != nil {
+		s.logger.Debugw("Failed to bind promotion", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	created, err := s.promotionService.PostPromotion(c.Request.Context(), promotion)
+	if err != nil {
+		s.promotionError(c, err)
+		return
+	}
+	c.JSON(201, gin.H{
+		"promotion": created,
+	})
+}
+
+func (s *PromotionHandler) GetPromotionsId(c *gin.Context, id int) {
+	promotion, err := s.promotionService.GetPromotion(c.Request.Context(), id)
+	if err != nil {
+		s.promotionError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"promotion": promotion,
+	})
+}
+
+func (s *PromotionHandler) PutPromotionsId(c *gin.Context, id int) {
+	var promotion v1.Promotion
+	if err := c.ShouldBindJSON(&promotion); err != nil {
+		s.logger.Debugw("Failed to bind promotion", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+	promotion.Id = &id
+
+	updated, err := s.promotionService.PutPromotion(c.Request.Context(), promotion)
+	if err != nil {
+		s.promotionError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"promotion": updated,
+	})
+}
+
+func (s *PromotionHandler) DeletePromotionsId(c *gin.Context, id int) {
+	if err := s.promotionService.DeletePromotion(c.Request.Context(), id); err != nil {
+		s.promotionError(c, err)
+		return
+	}
+	c.Status(204)
+}
+
+func (s *PromotionHandler) promotionError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrPromotionNotFound):
+		c.JSON(404, gin.H{"message": "Promotion not found"})
+	case errors.Is(err, service.ErrInvalidPromotion):
+		c.JSON(400, gin.H{"message": err.Error()})
+	case errors.Is(err, service.ErrPromotionInUse):
+		c.JSON(409, gin.H{"message": err.Error()})
+	default:
+		s.logger.Debugw("Promotion request failed", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+	}
+}
@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/service"
+	"go.uber.org/zap"
+)
+
+type PurchaseHandlerInterface interface {
+	GetPurchaseOrders(c *gin.Context, params v1.GetPurchaseOrdersParams)
+	PostPurchaseOrders(c *gin.Context)
+	GetPurchaseOrdersId(c *gin.Context, id int)
+	PutPurchaseOrdersId(c *gin.Context, id int)
+	PostPurchaseOrdersIdPlace(c *gin.Context, id int)
+	PostPurchaseOrdersIdClose(c *gin.Context, id int)
+	GetPurchaseOrdersIdGoodsReceipts(c *gin.Context, id int)
+	PostPurchaseOrdersIdGoodsReceipts(c *gin.Context, id int)
+	GetGoodsReceiptsId(c *gin.Context, id int)
+	GetSuppliersIdPurchaseOrders(c *gin.Context, id int, params v1.GetSuppliersIdPurchaseOrdersParams)
+	PostReportsLowStockPurchaseOrders(c *gin.Context, params v1.PostReportsLowStockPurchaseOrdersParams)
+}
+
+type PurchaseHandler struct {
+	purchaseService service.PurchaseServiceInterface
+	logger          *zap.SugaredLogger
+}
+
+func NewPurchaseHandler(purchaseService service.PurchaseServiceInterface, logger *zap.SugaredLogger) PurchaseHandlerInterface {
+	return &PurchaseHandler{
+		purchaseService: purchaseService,
+		logger:          logger,
+	}
+}
+
+func (s *PurchaseHandler) GetPurchaseOrders(c *gin.Context, params v1.GetPurchaseOrdersParams) {
+	orders, err := s.purchaseService.GetPurchaseOrders(c.Request.Context(), params)
+	if err != nil {
+		s.purchaseError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"purchaseOrders": orders,
+	})
+}
+
+func (s *PurchaseHandler) PostPurchaseOrders(c *gin.Context) {
+	var order v1.PurchaseOrder
+	if err := c.ShouldBindJSON(&order); err != nil {
+		s.logger.Debugw("Failed to bind purchase order", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	created, err := s.purchaseService.PostPurchaseOrder(c.Request.Context(), order)
+	if err != nil {
+		s.purchaseError(c, err)
+		return
+	}
+	c.JSON(201, gin.H{
+		"purchaseOrder": created,
+	})
+}
+
+func (s *PurchaseHandler) GetPurchaseOrdersId(c *gin.Context, id int) {
+	order, err := s.purchaseService.GetPurchaseOrder(c.Request.Context(), id)
+	if err != nil {
+		s.purchaseError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"purchaseOrder": order,
+	})
+}
+
+func (s *PurchaseHandler) PutPurchaseOrdersId(c *gin.Context, id int) {
+	var order v1.PurchaseOrder
+	if err := c.ShouldBindJSON(&order); err != nil {
+		s.logger.Debugw("Failed to bind purchase order", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	updated, err := s.purchaseService.PutPurchaseOrder(c.Request.Context(), id, order)
+	if err != nil {
+		s.purchaseError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"purchaseOrder": updated,
+	})
+}
+
+func (s *PurchaseHandler) PostPurchaseOrdersIdPlace(c *gin.Context, id int) {
+	order, err := s.purchaseService.PlacePurchaseOrder(c.Request.Context(), id)
+	if err != nil {
+		s.purchaseError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"purchaseOrder": order,
+	})
+}
+
+func (s *PurchaseHandler) PostPurchaseOrdersIdClose(c *gin.Context, id int) {
+	order, err := s.purchaseService.ClosePurchaseOrder(c.Request.Context(), id)
+	if err != nil {
+		s.purchaseError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"purchaseOrder": order,
+	})
+}
+
+func (s *PurchaseHandler) GetPurchaseOrdersIdGoodsReceipts(c *gin.Context, id int) {
+	receipts, err := s.purchaseService.GetGoodsReceipts(c.Request.Context(), id)
+	if err != nil {
+		s.purchaseError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"goodsReceipts": receipts,
+	})
+}
+
+func (s *PurchaseHandler) PostPurchaseOrdersIdGoodsReceipts(c *gin.Context, id int) {
+	var receipt v1.GoodsReceipt
+	if err := c.ShouldBindJSON(&receipt); err != nil {
+		s.logger.Debugw("Failed to bind goods receipt", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	created, err := s.purchaseService.PostGoodsReceipt(c.Request.Context(), id, receipt)
+	if err != nil {
+		s.purchaseError(c, err)
+		return
+	}
+	c.JSON(201, gin.H{
+		"goodsReceipt": created,
+	})
+}
+
+func (s *PurchaseHandler) GetGoodsReceiptsId(c *gin.Context, id int) {
+	receipt, err := s.purchaseService.GetGoodsReceipt(c.Request.Context(), id)
+	if err != nil {
+		s.purchaseError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"goodsReceipt": receipt,
+	})
+}
+
+func (s *PurchaseHandler) GetSuppliersIdPurchaseOrders(c *gin.Context, id int, params v1.GetSuppliersIdPurchaseOrdersParams) {
+	orders, err := s.purchaseService.GetSupplierPurchaseOrders(c.Request.Context(), id, params)
+	if err != nil {
+		s.purchaseError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"purchaseOrders": orders,
+	})
+}
+
+func (s *PurchaseHandler) PostReportsLowStockPurchaseOrders(c *gin.Context, params v1.PostReportsLowStockPurchaseOrdersParams) {
+	drafts, err := s.purchaseService.DraftLowStockPurchaseOrders(c.Request.Context(), params)
+	if err != nil {
+		s.purchaseError(c, err)
+		return
+	}
+	c.JSON(201, gin.H{
+		"purchaseOrders": drafts.PurchaseOrders,
+		"unassigned":     drafts.Unassigned,
+	})
+}
+
+func (s *PurchaseHandler) purchaseError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrSupplierNotFound):
+		c.JSON(404, gin.H{"message": "Supplier not found"})
+	case errors.Is(err, service.ErrPurchaseOrderNotFound):
+		c.JSON(404, gin.H{"message": "Purchase order not found"})
+	case errors.Is(err, service.ErrGoodsReceiptNotFound):
+		c.JSON(404, gin.H{"message": "Goods receipt note not found"})
+	case errors.Is(err, service.ErrInvalidPurchaseOrder), errors.Is(err, service.ErrInvalidGoodsReceipt):
+		c.JSON(400, gin.H{"message": err.Error()})
+	case errors.Is(err, service.ErrPurchaseOrderClosed), errors.Is(err, service.ErrPurchaseOrderPlaced):
+		c.JSON(409, gin.H{"message": err.Error()})
+	default:
+		s.logger.Debugw("Purchase request failed", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+	}
+}
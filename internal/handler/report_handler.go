@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/service"
+	"go.uber.org/zap"
+)
+
+type ReportHandlerInterface interface {
+	GetReportsTax(c *gin.Context, params v1.GetReportsTaxParams)
+	GetReportsCmp08(c *gin.Context, params v1.GetReportsCmp08Params)
+	GetReportsNearExpiry(c *gin.Context, params v1.GetReportsNearExpiryParams)
+	GetReportsInputTax(c *gin.Context, params v1.GetReportsInputTaxParams)
+	GetReportsLowStock(c *gin.Context, params v1.GetReportsLowStockParams)
+	GetReportsStockValuation(c *gin.Context, params v1.GetReportsStockValuationParams)
+}
+
+type ReportHandler struct {
+	reportService service.ReportServiceInterface
+	logger        *zap.SugaredLogger
+}
+
+func NewReportHandler(reportService service.ReportServiceInterface, logger *zap.SugaredLogger) ReportHandlerInterface {
+	return &ReportHandler{
+		reportService: reportService,
+		logger:        logger,
+	}
+}
+
+func (s *ReportHandler) GetReportsTax(c *gin.Context, params v1.GetReportsTaxParams) {
+	report, err := s.reportService.GetTaxReport(c.Request.Context(), params)
+	if err != nil {
+		s.logger.Debugw("Failed to get tax report", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"report": report,
+	})
+}
+
+func (s *ReportHandler) GetReportsCmp08(c *gin.Context, params v1.GetReportsCmp08Params) {
+	report, err := s.reportService.GetCMP08Report(c.Request.Context(), params)
+	if err != nil {
+		s.logger.Debugw("Failed to get CMP-08 report", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"report": report,
+	})
+}
+
+func (s *ReportHandler) GetReportsNearExpiry(c *gin.Context, params v1.GetReportsNearExpiryParams) {
+	report, err := s.reportService.GetNearExpiryReport(c.Request.Context(), params)
+	if err != nil {
+		s.logger.Debugw("Failed to get near-expiry report", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"report": report,
+	})
+}
+
+func (s *ReportHandler) GetReportsInputTax(c *gin.Context, params v1.GetReportsInputTaxParams) {
+	report, err := s.reportService.GetInputTaxReport(c.Request.Context(), params)
+	if err != nil {
+		s.logger.Debugw("Failed to get input tax report", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"report": report,
+	})
+}
+
+func (s *ReportHandler) GetReportsLowStock(c *gin.Context, params v1.GetReportsLowStockParams) {
+	report, err := s.reportService.GetLowStockReport(c.Request.Context(), params)
+	if err != nil {
+		s.logger.Debugw("Failed to get low-stock report", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"report": report,
+	})
+}
+
+func (s *ReportHandler) GetReportsStockValuation(c *gin.Context, params v1.GetReportsStockValuationParams) {
+	report, err := s.reportService.GetStockValuationReport(c.Request.Context(), params)
+	if err != nil {
+		if errors.Is(err, service.ErrCategoryNotFound) {
+			c.JSON(404, gin.H{"message": "Category not found"})
+			return
+		}
+		s.logger.Debugw("Failed to get stock valuation report", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"report": report,
+	})
+}
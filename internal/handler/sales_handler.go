@@ -2,8 +2,11 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/gin-gonic/gin"
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
 	"github.com/nitinjangam/pos-receipt-system/internal/service"
 	"go.uber.org/zap"
 )
@@ -12,6 +15,7 @@ import (
 type SalesHandlerInterface interface {
 	GetSales(c *gin.Context)
 	PostSales(c *gin.Context)
+	PostSalesPreview(c *gin.Context)
 	DeleteSalesId(c *gin.Context, id int)
 	PutSalesId(c *gin.Context, id int)
 	GetSalesIdReceipt(c *gin.Context, id int)
@@ -32,26 +36,86 @@ func NewSalesHandler(ctx context.Context, logger *zap.SugaredLogger, salesServic
 }
 
 func (s *SalesHandler) GetSales(c *gin.Context) {
-	// return status not implemented
-	c.JSON(501, gin.H{
-		"message": "Not Implemented",
+	sales, err := s.salesService.GetSales(c.Request.Context())
+	if err != nil {
+		s.logger.Debugw("Failed to get sales", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"sales": sales,
 	})
 }
 
 func (s *SalesHandler) PostSales(c *gin.Context) {
-	// return status not implemented
-	c.JSON(501, gin.H{
-		"message": "Not Implemented",
+	var request v1.PostSalesJSONRequestBody
+	if err := c.ShouldBindJSON(&request); err != nil {
+		s.logger.Debugw("Failed to bind sale", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	sale, err := s.salesService.PostSales(c.Request.Context(), request)
+	if err != nil {
+		if errors.Is(err, service.ErrProductNotFound) || errors.Is(err, service.ErrInvalidProduct) || errors.Is(err, service.ErrCustomerNotFound) ||
+			errors.Is(err, service.ErrInvalidQuantity) || errors.Is(err, service.ErrPriceListNotFound) {
+			c.JSON(400, gin.H{"message": err.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrInsufficientStock) || errors.Is(err, service.ErrBatchExpired) {
+			c.JSON(409, gin.H{"message": err.Error()})
+			return
+		}
+		s.logger.Debugw("Failed to create sale", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(201, gin.H{
+		"sale": sale,
 	})
 }
 
-func (s *SalesHandler) DeleteSalesId(c *gin.Context, id int) {
-	// return status not implemented
-	c.JSON(501, gin.H{
-		"message": "Not Implemented",
+func (s *SalesHandler) PostSalesPreview(c *gin.Context) {
+	var request v1.PostSalesPreviewJSONRequestBody
+	if err := c.ShouldBindJSON(&request); err != nil {
+		s.logger.Debugw("Failed to bind sale", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	sale, err := s.salesService.PreviewSale(c.Request.Context(), request)
+	if err != nil {
+		if errors.Is(err, service.ErrProductNotFound) || errors.Is(err, service.ErrInvalidProduct) || errors.Is(err, service.ErrCustomerNotFound) ||
+			errors.Is(err, service.ErrInvalidQuantity) || errors.Is(err, service.ErrPriceListNotFound) {
+			c.JSON(400, gin.H{"message": err.Error()})
+			return
+		}
+		s.logger.Debugw("Failed to preview sale", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"sale": sale,
 	})
 }
 
+func (s *SalesHandler) DeleteSalesId(c *gin.Context, id int) {
+	if err := s.salesService.VoidSale(c.Request.Context(), id); err != nil {
+		if errors.Is(err, service.ErrSaleNotFound) {
+			c.JSON(404, gin.H{"message": "Sale not found"})
+			return
+		}
+		if errors.Is(err, service.ErrSaleVoided) {
+			c.JSON(409, gin.H{"message": err.Error()})
+			return
+		}
+		s.logger.Debugw("Failed to void sale", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.Status(204)
+}
+
 func (s *SalesHandler) PutSalesId(c *gin.Context, id int) {
 	// return status not implemented
 	c.JSON(501, gin.H{
@@ -60,8 +124,17 @@ func (s *SalesHandler) PutSalesId(c *gin.Context, id int) {
 }
 
 func (s *SalesHandler) GetSalesIdReceipt(c *gin.Context, id int) {
-	// return status not implemented
-	c.JSON(501, gin.H{
-		"message": "Not Implemented",
-	})
+	pdf, err := s.salesService.GetSaleReceipt(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, service.ErrSaleNotFound) {
+			c.JSON(404, gin.H{"message": "Sale not found"})
+			return
+		}
+		s.logger.Debugw("Failed to generate receipt", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("inline; filename=\"invoice-%d.pdf\"", id))
+	c.Data(200, "application/pdf", pdf)
 }
@@ -1,7 +1,10 @@
 package handler
 
 import (
+	"errors"
+
 	"github.com/gin-gonic/gin"
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
 	"github.com/nitinjangam/pos-receipt-system/internal/service"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
@@ -27,15 +30,36 @@ func NewSettingsHandler(tracer trace.Tracer, logger *zap.SugaredLogger, settings
 }
 
 func (s *SettingsHandler) GetSettings(c *gin.Context) {
-	// return status not implemented
-	c.JSON(501, gin.H{
-		"message": "Not Implemented",
+	settings, err := s.settingsService.GetSettings(c.Request.Context())
+	if err != nil {
+		s.logger.Debugw("Failed to get settings", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"settings": settings,
 	})
 }
 
 func (s *SettingsHandler) PutSettings(c *gin.Context) {
-	// return status not implemented
-	c.JSON(501, gin.H{
-		"message": "Not Implemented",
+	var settings v1.Settings
+	if err := c.ShouldBindJSON(&settings); err != nil {
+		s.logger.Debugw("Failed to bind settings", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	updated, err := s.settingsService.PutSettings(c.Request.Context(), settings)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidSettings) {
+			c.JSON(400, gin.H{"message": err.Error()})
+			return
+		}
+		s.logger.Debugw("Failed to update settings", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"settings": updated,
 	})
 }
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/service"
+	"go.uber.org/zap"
+)
+
+type StocktakeHandlerInterface interface {
+	GetStocktakes(c *gin.Context, params v1.GetStocktakesParams)
+	PostStocktakes(c *gin.Context)
+	GetStocktakesId(c *gin.Context, id int)
+	GetStocktakesIdCounts(c *gin.Context, id int)
+	PostStocktakesIdCounts(c *gin.Context, id int)
+	PostStocktakesIdApprove(c *gin.Context, id int)
+	PostStocktakesIdCancel(c *gin.Context, id int)
+}
+
+type StocktakeHandler struct {
+	stocktakeService service.StocktakeServiceInterface
+	logger           *zap.SugaredLogger
+}
+
+func NewStocktakeHandler(stocktakeService service.StocktakeServiceInterface, logger *zap.SugaredLogger) StocktakeHandlerInterface {
+	return &StocktakeHandler{
+		stocktakeService: stocktakeService,
+		logger:           logger,
+	}
+}
+
+func (s *StocktakeHandler) GetStocktakes(c *gin.Context, params v1.GetStocktakesParams) {
+	stocktakes, err := s.stocktakeService.GetStocktakes(c.Request.Context(), params)
+	if err != nil {
+		s.stocktakeError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"stocktakes": stocktakes,
+	})
+}
+
+func (s *StocktakeHandler) PostStocktakes(c *gin.Context) {
+	var stocktake v1.Stocktake
+	if err := c.ShouldBindJSON(&stocktake); err != nil {
+		s.logger.Debugw("Failed to bind stocktake", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	created, err := s.stocktakeService.PostStocktake(c.Request.Context(), stocktake)
+	if err != nil {
+		s.stocktakeError(c, err)
+		return
+	}
+	c.JSON(201, gin.H{
+		"stocktake": created,
+	})
+}
+
+func (s *StocktakeHandler) GetStocktakesId(c *gin.Context, id int) {
+	stocktake, err := s.stocktakeService.GetStocktake(c.Request.Context(), id)
+	if err != nil {
+		s.stocktakeError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"stocktake": stocktake,
+	})
+}
+
+func (s *StocktakeHandler) GetStocktakesIdCounts(c *gin.Context, id int) {
+	counts, err := s.stocktakeService.GetStocktakeCounts(c.Request.Context(), id)
+	if err != nil {
+		s.stocktakeError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"counts": counts,
+	})
+}
+
+func (s *StocktakeHandler) PostStocktakesIdCounts(c *gin.Context, id int) {
+	var request v1.StocktakeCountRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		s.logger.Debugw("Failed to bind stocktake counts", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	stocktake, err := s.stocktakeService.PostStocktakeCounts(c.Request.Context(), id, request)
+	if err != nil {
+		s.stocktakeError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"stocktake": stocktake,
+	})
+}
+
+func (s *StocktakeHandler) PostStocktakesIdApprove(c *gin.Context, id int) {
+	var approval v1.StocktakeApproval
+	if err := c.ShouldBindJSON(&approval); err != nil {
+		s.logger.Debugw("Failed to bind stocktake approval", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	stocktake, err := s.stocktakeService.ApproveStocktake(c.Request.Context(), id, approval)
+	if err != nil {
+		s.stocktakeError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"stocktake": stocktake,
+	})
+}
+
+func (s *StocktakeHandler) PostStocktakesIdCancel(c *gin.Context, id int) {
+	stocktake, err := s.stocktakeService.CancelStocktake(c.Request.Context(), id)
+	if err != nil {
+		s.stocktakeError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"stocktake": stocktake,
+	})
+}
+
+func (s *StocktakeHandler) stocktakeError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrStocktakeNotFound):
+		c.JSON(404, gin.H{"message": "Stocktake not found"})
+	case errors.Is(err, service.ErrInvalidStocktake):
+		c.JSON(400, gin.H{"message": err.Error()})
+	case errors.Is(err, service.ErrStocktakeInProgress), errors.Is(err, service.ErrStocktakeClosed):
+		c.JSON(409, gin.H{"message": err.Error()})
+	default:
+		s.logger.Debugw("Stocktake request failed", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+	}
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/service"
+	"go.uber.org/zap"
+)
+
+type StoreHandlerInterface interface {
+	GetStores(c *gin.Context)
+	PostStores(c *gin.Context)
+	GetStoresId(c *gin.Context, id int)
+	PutStoresId(c *gin.Context, id int)
+}
+
+type StoreHandler struct {
+	storeService service.StoreServiceInterface
+	logger       *zap.SugaredLogger
+}
+
+func NewStoreHandler(storeService service.StoreServiceInterface, logger *zap.SugaredLogger) StoreHandlerInterface {
+	return &StoreHandler{
+		storeService: storeService,
+		logger:       logger,
+	}
+}
+
+func (s *StoreHandler) GetStores(c *gin.Context) {
+	stores, err := s.storeService.GetStores(c.Request.Context())
+	if err != nil {
+		s.logger.Debugw("Failed to get stores", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"stores": stores,
+	})
+}
+
+func (s *StoreHandler) PostStores(c *gin.Context) {
+	var store v1.Store
+	if err := c.ShouldBindJSON(&store); err != nil {
+		s.logger.Debugw("Failed to bind store", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	created, err := s.storeService.PostStore(c.Request.Context(), store)
+	if err != nil {
+		s.storeError(c, err)
+		return
+	}
+	c.JSON(201, gin.H{
+		"store": created,
+	})
+}
+
+func (s *StoreHandler) GetStoresId(c *gin.Context, id int) {
+	store, err := s.storeService.GetStore(c.Request.Context(), id)
+	if err != nil {
+		s.storeError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"store": store,
+	})
+}
+
+func (s *StoreHandler) PutStoresId(c *gin.Context, id int) {
+	var store v1.Store
+	if err := c.ShouldBindJSON(&store); err != nil {
+		s.logger.Debugw("Failed to bind store", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+	store.Id = &id
+
+	updated, err := s.storeService.PutStore(c.Request.Context(), store)
+	if err != nil {
+		s.storeError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"store": updated,
+	})
+}
+
+func (s *StoreHandler) storeError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrStoreNotFound):
+		c.JSON(404, gin.H{"message": "Store not found"})
+	case errors.Is(err, service.ErrInvalidStore):
+		c.JSON(400, gin.H{"message": err.Error()})
+	default:
+		s.logger.Debugw("Store request failed", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+	}
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/service"
+	"go.uber.org/zap"
+)
+
+type SupplierHandlerInterface interface {
+	GetSuppliers(c *gin.Context)
+	PostSuppliers(c *gin.Context)
+	GetSuppliersId(c *gin.Context, id int)
+	PutSuppliersId(c *gin.Context, id int)
+}
+
+type SupplierHandler struct {
+	supplierService service.SupplierServiceInterface
+	logger          *zap.SugaredLogger
+}
+
+func NewSupplierHandler(supplierService service.SupplierServiceInterface, logger *zap.SugaredLogger) SupplierHandlerInterface {
+	return &SupplierHandler{
+		supplierService: supplierService,
+		logger:          logger,
+	}
+}
+
+func (s *SupplierHandler) GetSuppliers(c *gin.Context) {
+	suppliers, err := s.supplierService.GetSuppliers(c.Request.Context())
+	if err != nil {
+		s.logger.Debugw("Failed to get suppliers", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"suppliers": suppliers,
+	})
+}
+
+func (s *SupplierHandler) PostSuppliers(c *gin.Context) {
+	var supplier v1.Supplier
+	if err := c.ShouldBindJSON(&supplier); err != nil {
+		s.logger.Debugw("Failed to bind supplier", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	created, err := s.supplierService.PostSupplier(c.Request.Context(), supplier)
+	if err != nil {
+		s.supplierError(c, err)
+		return
+	}
+	c.JSON(201, gin.H{
+		"supplier": created,
+	})
+}
+
+func (s *SupplierHandler) GetSuppliersId(c *gin.Context, id int) {
+	supplier, err := s.supplierService.GetSupplier(c.Request.Context(), id)
+	if err != nil {
+		s.supplierError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"supplier": supplier,
+	})
+}
+
+func (s *SupplierHandler) PutSuppliersId(c *gin.Context, id int) {
+	var supplier v1.Supplier
+	if err := c.ShouldBindJSON(&supplier); err != nil {
+		s.logger.Debugw("Failed to bind supplier", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+	supplier.Id = &id
+
+	updated, err := s.supplierService.PutSupplier(c.Request.Context(), supplier)
+	if err != nil {
+		s.supplierError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"supplier": updated,
+	})
+}
+
+func (s *SupplierHandler) supplierError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrSupplierNotFound):
+		c.JSON(404, gin.H{"message": "Supplier not found"})
+	case errors.Is(err, service.ErrInvalidSupplier):
+		c.JSON(400, gin.H{"message": err.Error()})
+	default:
+		s.logger.Debugw("Supplier request failed", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+	}
+}
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/service"
+	"go.uber.org/zap"
+)
+
+type TaxRateHandlerInterface interface {
+	GetProductsIdTaxRates(c *gin.Context, id int)
+	PostProductsIdTaxRates(c *gin.Context, id int)
+	GetTaxRateChanges(c *gin.Context)
+	PostTaxRateChanges(c *gin.Context)
+	PostTaxRateChangesPreview(c *gin.Context)
+	DeleteTaxRateChangesId(c *gin.Context, id int)
+}
+
+type TaxRateHandler struct {
+	taxRateService service.TaxRateServiceInterface
+	logger         *zap.SugaredLogger
+}
+
+func NewTaxRateHandler(taxRateService service.TaxRateServiceInterface, logger *zap.SugaredLogger) TaxRateHandlerInterface {
+	return &TaxRateHandler{
+		taxRateService: taxRateService,
+		logger:         logger,
+	}
+}
+
+func (s *TaxRateHandler) GetProductsIdTaxRates(c *gin.Context, id int) {
+	rates, err := s.taxRateService.GetProductTaxRates(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, service.ErrProductNotFound) {
+			c.JSON(404, gin.H{"message": "Product not found"})
+			return
+		}
+		s.logger.Debugw("Failed to get tax rates", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"taxRates": rates,
+	})
+}
+
+func (s *TaxRateHandler) PostProductsIdTaxRates(c *gin.Context, id int) {
+	var rate v1.TaxRate
+	if err := c.ShouldBindJSON(&rate); err != nil {
+		s.logger.Debugw("Failed to bind tax rate", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	created, err := s.taxRateService.PostProductTaxRate(c.Request.Context(), id, rate)
+	if err != nil {
+		if errors.Is(err, service.ErrProductNotFound) {
+			c.JSON(404, gin.H{"message": "Product not found"})
+			return
+		}
+		s.logger.Debugw("Failed to create tax rate", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(201, gin.H{
+		"taxRate": created,
+	})
+}
+
+func (s *TaxRateHandler) GetTaxRateChanges(c *gin.Context) {
+	changes, err := s.taxRateService.GetTaxRateChanges(c.Request.Context())
+	if err != nil {
+		s.logger.Debugw("Failed to get tax rate changes", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"taxRateChanges": changes,
+	})
+}
+
+func (s *TaxRateHandler) PostTaxRateChanges(c *gin.Context) {
+	var change v1.TaxRateChange
+	if err := c.ShouldBindJSON(&change); err != nil {
+		s.logger.Debugw("Failed to bind tax rate change", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	created, err := s.taxRateService.PostTaxRateChange(c.Request.Context(), change)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidTaxRateChange) {
+			c.JSON(400, gin.H{"message": err.Error()})
+			return
+		}
+		s.logger.Debugw("Failed to create tax rate change", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(201, gin.H{
+		"taxRateChange": created,
+	})
+}
+
+func (s *TaxRateHandler) PostTaxRateChangesPreview(c *gin.Context) {
+	var change v1.TaxRateChange
+	if err := c.ShouldBindJSON(&change); err != nil {
+		s.logger.Debugw("Failed to bind tax rate change", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	preview, err := s.taxRateService.PreviewTaxRateChange(c.Request.Context(), change)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidTaxRateChange) {
+			c.JSON(400, gin.H{"message": err.Error()})
+			return
+		}
+		s.logger.Debugw("Failed to preview tax rate change", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"products": preview,
+	})
+}
+
+func (s *TaxRateHandler) DeleteTaxRateChangesId(c *gin.Context, id int) {
+	if err := s.taxRateService.DeleteTaxRateChange(c.Request.Context(), id); err != nil {
+		switch {
+		case errors.Is(err, service.ErrTaxRateChangeNotFound):
+			c.JSON(404, gin.H{"message": "Tax rate change not found"})
+		case errors.Is(err, service.ErrTaxRateChangeInEffect):
+			c.JSON(409, gin.H{"message": "Tax rate change is already in effect"})
+		default:
+			s.logger.Debugw("Failed to delete tax rate change", "error", err)
+			c.JSON(500, gin.H{"message": "Internal Server Error"})
+		}
+		return
+	}
+
+	c.JSON(204, gin.H{"message": "Tax rate change cancelled"})
+}
@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/service"
+	"go.uber.org/zap"
+)
+
+type TransferHandlerInterface interface {
+	GetTransfers(c *gin.Context, params v1.GetTransfersParams)
+	PostTransfers(c *gin.Context)
+	GetTransfersId(c *gin.Context, id int)
+	PostTransfersIdDispatch(c *gin.Context, id int)
+	PostTransfersIdReceive(c *gin.Context, id int)
+	PostTransfersIdCancel(c *gin.Context, id int)
+	GetTransfersIdChallan(c *gin.Context, id int)
+}
+
+type TransferHandler struct {
+	transferService service.TransferServiceInterface
+	logger          *zap.SugaredLogger
+}
+
+func NewTransferHandler(transferService service.TransferServiceInterface, logger *zap.SugaredLogger) TransferHandlerInterface {
+	return &TransferHandler{
+		transferService: transferService,
+		logger:          logger,
+	}
+}
+
+func (s *TransferHandler) GetTransfers(c *gin.Context, params v1.GetTransfersParams) {
+	transfers, err := s.transferService.GetTransfers(c.Request.Context(), params)
+	if err != nil {
+		s.transferError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"transfers": transfers,
+	})
+}
+
+func (s *TransferHandler) PostTransfers(c *gin.Context) {
+	var transfer v1.Transfer
+	if err := c.ShouldBindJSON(&transfer); err != nil {
+		s.logger.Debugw("Failed to bind transfer", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	created, err := s.transferService.PostTransfer(c.Request.Context(), transfer)
+	if err != nil {
+		s.transferError(c, err)
+		return
+	}
+	c.JSON(201, gin.H{
+		"transfer": created,
+	})
+}
+
+func (s *TransferHandler) GetTransfersId(c *gin.Context, id int) {
+	transfer, err := s.transferService.GetTransfer(c.Request.Context(), id)
+	if err != nil {
+		s.transferError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"transfer": transfer,
+	})
+}
+
+func (s *TransferHandler) PostTransfersIdDispatch(c *gin.Context, id int) {
+	transfer, err := s.transferService.DispatchTransfer(c.Request.Context(), id)
+	if err != nil {
+		s.transferError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"transfer": transfer,
+	})
+}
+
+func (s *TransferHandler) PostTransfersIdReceive(c *gin.Context, id int) {
+	var request v1.TransferReceipt
+	if err := c.ShouldBindJSON(&request); err != nil {
+		s.logger.Debugw("Failed to bind transfer receipt", "error", err)
+		c.JSON(400, gin.H{"message": "Bad Request"})
+		return
+	}
+
+	transfer, err := s.transferService.ReceiveTransfer(c.Request.Context(), id, request)
+	if err != nil {
+		s.transferError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"transfer": transfer,
+	})
+}
+
+func (s *TransferHandler) PostTransfersIdCancel(c *gin.Context, id int) {
+	transfer, err := s.transferService.CancelTransfer(c.Request.Context(), id)
+	if err != nil {
+		s.transferError(c, err)
+		return
+	}
+	c.JSON(200, gin.H{
+		"transfer": transfer,
+	})
+}
+
+func (s *TransferHandler) GetTransfersIdChallan(c *gin.Context, id int) {
+	pdf, err := s.transferService.GetTransferChallan(c.Request.Context(), id)
+	if err != nil {
+		s.transferError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("inline; filename=\"challan-%d.pdf\"", id))
+	c.Data(200, "application/pdf", pdf)
+}
+
+func (s *TransferHandler) transferError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrTransferNotFound):
+		c.JSON(404, gin.H{"message": "Transfer not found"})
+	case errors.Is(err, service.ErrInvalidTransfer):
+		c.JSON(400, gin.H{"message": err.Error()})
+	case errors.Is(err, service.ErrTransferConflict), errors.Is(err, service.ErrInsufficientStock), errors.Is(err, service.ErrBatchExpired):
+		c.JSON(409, gin.H{"message": err.Error()})
+	default:
+		s.logger.Debugw("Transfer request failed", "error", err)
+		c.JSON(500, gin.H{"message": "Internal Server Error"})
+	}
+}
@@ -0,0 +1,112 @@
+// Package media checks uploaded images and makes thumbnails of them.
+package media
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif" // registers the GIF decoder
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // registers the WebP decoder
+)
+
+const (
+	// maxPixels bounds the decoded size of an image, so that a small file
+	// cannot expand into gigabytes of pixels.
+	maxPixels = 40_000_000
+	// jpegQuality is the quality of JPEG thumbnails.
+	jpegQuality = 85
+)
+
+var (
+	ErrUnsupported = errors.New("unsupported image type")
+	ErrInvalid     = errors.New("invalid image")
+)
+
+// extensions maps the content types accepted to the file extension they are
+// stored with.
+var extensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// Image describes an uploaded image and carries its thumbnail.
+type Image struct {
+	ContentType string
+	Extension   string
+	Width       int
+	Height      int
+
+	Thumbnail            []byte
+	ThumbnailContentType string
+	ThumbnailExtension   string
+}
+
+// Process sniffs the content type of the data, which must be a JPEG, PNG,
+// GIF or WebP image, and makes a thumbnail that fits in a square of the given
+// size. The declared content type of an upload is not trusted. JPEG images
+// get a JPEG thumbnail and the others a PNG one, keeping transparency.
+func Process(data []byte, thumbnailSize int) (Image, error) {
+	contentType := http.DetectContentType(data)
+	extension, ok := extensions[contentType]
+	if !ok {
+		return Image{}, fmt.Errorf("%w: %s", ErrUnsupported, contentType)
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return Image{}, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxPixels {
+		return Image{}, fmt.Errorf("%w: %dx%d pixels is too large", ErrInvalid, config.Width, config.Height)
+	}
+	source, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return Image{}, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+
+	img := Image{
+		ContentType: contentType,
+		Extension:   extension,
+		Width:       config.Width,
+		Height:      config.Height,
+	}
+	thumbnail := resize(source, thumbnailSize)
+	var buf bytes.Buffer
+	if contentType == "image/jpeg" {
+		err = jpeg.Encode(&buf, thumbnail, &jpeg.Options{Quality: jpegQuality})
+		img.ThumbnailContentType, img.ThumbnailExtension = "image/jpeg", ".jpg"
+	} else {
+		err = png.Encode(&buf, thumbnail)
+		img.ThumbnailContentType, img.ThumbnailExtension = "image/png", ".png"
+	}
+	if err != nil {
+		return Image{}, err
+	}
+	img.Thumbnail = buf.Bytes()
+	return img, nil
+}
+
+// resize scales the image down to fit in a square of the given size, keeping
+// its aspect ratio. Smaller images are left at their size.
+func resize(source image.Image, size int) image.Image {
+	bounds := source.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width > size || height > size {
+		if width >= height {
+			width, height = size, max(height*size/width, 1)
+		} else {
+			width, height = max(width*size/height, 1), size
+		}
+	}
+	thumbnail := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(thumbnail, thumbnail.Bounds(), source, bounds, draw.Src, nil)
+	return thumbnail
+}
@@ -0,0 +1,311 @@
+// Package promotion validates promotion rules and applies them to the lines
+// of a sale.
+package promotion
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+)
+
+// TimeLayout is the format of the times of day a promotion is limited to.
+const TimeLayout = "15:04"
+
+// weekdays maps time.Weekday, which starts on Sunday, onto the API names.
+var weekdays = [...]v1.Weekday{v1.Sun, v1.Mon, v1.Tue, v1.Wed, v1.Thu, v1.Fri, v1.Sat}
+
+// Line is a sale line as promotions see it.
+type Line struct {
+	ProductID int
+	// ParentID is the product the line's product is a variant of, if any.
+	ParentID int
+	// Categories holds the category of the product and every parent of it.
+	Categories []int
+	Quantity   float64
+	UnitPrice  float64
+	// Subtotal is the value of the line before promotions.
+	Subtotal float64
+	// Excluded lines, such as the components of a bundle, never qualify.
+	Excluded bool
+}
+
+// Validate checks that the action is complete and that the conditions and
+// validity window make sense. It does not check that the products and
+// categories exist.
+func Validate(promotion v1.Promotion) error {
+	if promotion.StartsAt != nil && promotion.EndsAt != nil && !promotion.EndsAt.After(*promotion.StartsAt) {
+		return errors.New("endsAt must be after startsAt")
+	}
+
+	action := promotion.Action
+	value := float64(valueOf(action.Value))
+	switch action.Type {
+	case v1.Percentage:
+		if value <= 0 || value > 100 {
+			return errors.New("a percentage must be more than 0 and at most 100")
+		}
+	case v1.Flat:
+		if value <= 0 {
+			return errors.New("a flat discount needs a value greater than zero")
+		}
+	case v1.FreeItem:
+		if valueOf(action.BuyQuantity) < 1 || valueOf(action.FreeQuantity) < 1 {
+			return errors.New("a free item offer needs a buy and a free quantity of at least 1")
+		}
+	default:
+		return fmt.Errorf("unknown action type %q", action.Type)
+	}
+
+	if promotion.Conditions == nil {
+		return nil
+	}
+	conditions := *promotion.Conditions
+	if conditions.MinQuantity != nil && *conditions.MinQuantity <= 0 {
+		return errors.New("minQuantity must be greater than zero")
+	}
+	if conditions.MinBasketTotal != nil && *conditions.MinBasketTotal < 0 {
+		return errors.New("minBasketTotal cannot be negative")
+	}
+	for _, day := range valueOf(conditions.DaysOfWeek) {
+		if weekday(day) < 0 {
+			return fmt.Errorf("unknown day of week %q", day)
+		}
+	}
+	for _, t := range []*string{conditions.StartTime, conditions.EndTime} {
+		if t == nil {
+			continue
+		}
+		if _, err := time.Parse(TimeLayout, *t); err != nil {
+			return fmt.Errorf("time of day %q is not HH:MM", *t)
+		}
+	}
+	if conditions.StartTime != nil && conditions.EndTime != nil && *conditions.StartTime == *conditions.EndTime {
+		return errors.New("startTime and endTime are the same")
+	}
+	return nil
+}
+
+// Apply applies the promotions, in the order given, to the lines of a sale
+// made at the given time. Each promotion works on what the ones before it
+// left of a line. It returns the discount on each line and the promotions
+// that took anything off, with the amount they took.
+func Apply(promotions []v1.Promotion, lines []Line, at time.Time) ([]float64, []v1.AppliedPromotion) {
+	discounts := make([]float64, len(lines))
+	var basket float64
+	for _, line := range lines {
+		basket += line.Subtotal
+	}
+
+	var applied []v1.AppliedPromotion
+	for _, promotion := range promotions {
+		stackable := promotion.Stackable == nil || *promotion.Stackable
+		if !stackable && len(applied) > 0 {
+			continue
+		}
+		if !runsAt(promotion, at) {
+			continue
+		}
+		conditions := valueOf(promotion.Conditions)
+		if conditions.MinBasketTotal != nil && basket < float64(*conditions.MinBasketTotal) {
+			continue
+		}
+
+		var qualifying []int
+		var quantity float64
+		for i, line := range lines {
+			if qualifies(conditions, line) {
+				qualifying = append(qualifying, i)
+				quantity += line.Quantity
+			}
+		}
+		if len(qualifying) == 0 {
+			continue
+		}
+		if conditions.MinQuantity != nil && quantity < *conditions.MinQuantity-1e-9 {
+			continue
+		}
+
+		amounts := discount(promotion.Action, lines, discounts, qualifying, quantity)
+		var total float64
+		for j, i := range qualifying {
+			discounts[i] = round2(discounts[i] + amounts[j])
+			total += amounts[j]
+		}
+		if total = round2(total); total <= 0 {
+			continue
+		}
+		applied = append(applied, v1.AppliedPromotion{
+			PromotionId: promotion.Id,
+			Name:        &promotion.Name,
+			Discount:    float32Ptr(total),
+		})
+		if !stackable {
+			break
+		}
+	}
+	return discounts, applied
+}
+
+// runsAt reports whether the promotion is active, within its validity window
+// and on one of its days and hours at the given time. Days and hours are
+// local time.
+func runsAt(promotion v1.Promotion, at time.Time) bool {
+	if promotion.Active != nil && !*promotion.Active {
+		return false
+	}
+	if promotion.StartsAt != nil && at.Before(*promotion.StartsAt) {
+		return false
+	}
+	if promotion.EndsAt != nil && !at.Before(*promotion.EndsAt) {
+		return false
+	}
+
+	local := at.Local()
+	conditions := valueOf(promotion.Conditions)
+	if days := valueOf(conditions.DaysOfWeek); len(days) > 0 {
+		found := false
+		for _, day := range days {
+			found = found || weekday(day) == int(local.Weekday())
+		}
+		if !found {
+			return false
+		}
+	}
+
+	now := local.Format(TimeLayout)
+	start, end := valueOf(conditions.StartTime), valueOf(conditions.EndTime)
+	switch {
+	case start != "" && end != "" && end < start:
+		// The window runs past midnight.
+		return now >= start || now < end
+	case start != "" && now < start:
+		return false
+	case end != "" && now >= end:
+		return false
+	}
+	return true
+}
+
+// qualifies reports whether the line is one the promotion applies to.
+func qualifies(conditions v1.PromotionConditions, line Line) bool {
+	if line.Excluded {
+		return false
+	}
+	products, categories := valueOf(conditions.ProductIds), valueOf(conditions.CategoryIds)
+	if len(products) == 0 && len(categories) == 0 {
+		return true
+	}
+	for _, id := range products {
+		if id == line.ProductID || id == line.ParentID {
+			return true
+		}
+	}
+	for _, id := range categories {
+		for _, category := range line.Categories {
+			if id == category {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// discount returns what the action takes off each qualifying line, never
+// more than is left of it.
+func discount(action v1.PromotionAction, lines []Line, discounts []float64, qualifying []int, quantity float64) []float64 {
+	amounts := make([]float64, len(qualifying))
+	left := make([]float64, len(qualifying))
+	var totalLeft float64
+	for j, i := range qualifying {
+		left[j] = math.Max(lines[i].Subtotal-discounts[i], 0)
+		totalLeft += left[j]
+	}
+
+	value := float64(valueOf(action.Value))
+	switch action.Type {
+	case v1.Percentage:
+		for j := range qualifying {
+			amounts[j] = round2(left[j] * value / 100)
+		}
+	case v1.Flat:
+		amounts = apportion(math.Min(round2(value), round2(totalLeft)), left)
+	case v1.FreeItem:
+		// Whole sets of buy plus free items earn the free ones, which are
+		// taken from the cheapest lines.
+		set := float64(valueOf(action.BuyQuantity) + valueOf(action.FreeQuantity))
+		free := math.Floor(quantity/set+1e-9) * float64(valueOf(action.FreeQuantity))
+		order := make([]int, len(qualifying))
+		for j := range order {
+			order[j] = j
+		}
+		sort.SliceStable(order, func(a, b int) bool {
+			return lines[qualifying[order[a]]].UnitPrice < lines[qualifying[order[b]]].UnitPrice
+		})
+		for _, j := range order {
+			if free <= 0 {
+				break
+			}
+			line := lines[qualifying[j]]
+			units := math.Min(free, line.Quantity)
+			amounts[j] = math.Min(round2(units*line.UnitPrice), round2(left[j]))
+			free -= units
+		}
+	}
+	return amounts
+}
+
+// apportion shares the total out in proportion to the weights, rounded to
+// paise. The rounding difference goes to the largest weight so that the
+// shares add up to the total.
+func apportion(total float64, weights []float64) []float64 {
+	shares := make([]float64, len(weights))
+	var sum float64
+	for _, w := range weights {
+		sum += w
+	}
+	if sum <= 0 || total <= 0 {
+		return shares
+	}
+	largest, spread := 0, 0.0
+	for i, w := range weights {
+		shares[i] = round2(total * w / sum)
+		spread += shares[i]
+		if w > weights[largest] {
+			largest = i
+		}
+	}
+	shares[largest] = round2(shares[largest] + total - spread)
+	return shares
+}
+
+// weekday returns the time.Weekday number of the day, or -1 if the day is
+// unknown.
+func weekday(day v1.Weekday) int {
+	for i, d := range weekdays {
+		if d == day {
+			return i
+		}
+	}
+	return -1
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+func float32Ptr(v float64) *float32 {
+	f := float32(v)
+	return &f
+}
+
+func valueOf[T any](v *T) T {
+	var zero T
+	if v == nil {
+		return zero
+	}
+	return *v
+}
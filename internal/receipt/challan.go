@@ -0,0 +1,162 @@
+package receipt
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/gst"
+	"github.com/nitinjangam/pos-receipt-system/internal/uom"
+)
+
+var challanColumns = []column{
+	{"#", 8, "C"},
+	{"Item", 82, "L"},
+	{"HSN", 22, "C"},
+	{"Qty", 26, "R"},
+	{"Unit Cost", 26, "R"},
+	{"Value", 26, "R"},
+}
+
+// party is the consignor or consignee named on a delivery challan.
+type party struct {
+	name                                 string
+	address, city, pincode, state, gstin *string
+}
+
+// RenderChallan writes the delivery challan the goods of a dispatched
+// transfer travel under to w. The business in the settings is the
+// consignor and the store the goods go to the consignee. Goods are listed at
+// the cost they left stock at, as a transfer between the business's own
+// stores is not a sale.
+func RenderChallan(w io.Writer, transfer v1.Transfer, settings v1.Settings, store v1.Store) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(true, pageMargin)
+	pdf.SetTitle(fmt.Sprintf("Delivery Challan %d", valueOf(transfer.Id)), false)
+	pdf.AddPage()
+	doc := &document{pdf: pdf, tr: pdf.UnicodeTranslatorFromDescriptor("")}
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 10, "Delivery Challan", "", 1, "C", false, 0, "")
+	pdf.SetFont("Helvetica", "I", 9)
+	pdf.CellFormat(0, lineHeight, "Transfer of stock between stores, not for sale", "", 1, "C", false, 0, "")
+
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(95, lineHeight, fmt.Sprintf("Challan No: %d", valueOf(transfer.Id)), "", 0, "L", false, 0, "")
+	dispatchedAt := ""
+	if transfer.DispatchedAt != nil {
+		dispatchedAt = transfer.DispatchedAt.Local().Format(dateLayout)
+	}
+	pdf.CellFormat(95, lineHeight, "Date: "+dispatchedAt, "", 1, "R", false, 0, "")
+	pdf.Ln(2)
+
+	consignor := valueOf(settings.BusinessName)
+	doc.writeParty("Consignor", party{consignor, settings.Address, settings.City, settings.Pincode, settings.StateCode, settings.Gstin})
+	doc.writeParty("Consignee", party{store.Name, store.Address, store.City, store.Pincode, store.StateCode, store.Gstin})
+	if store.StateCode != nil {
+		pdf.SetFont("Helvetica", "", 10)
+		pdf.CellFormat(0, lineHeight, "Place of Supply: "+stateLabel(*store.StateCode), "", 1, "L", false, 0, "")
+		pdf.Ln(2)
+	}
+
+	doc.writeChallanItems(transfer)
+
+	pdf.Ln(2)
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(150, lineHeight, "Total Value at Cost", "", 0, "R", false, 0, "")
+	pdf.CellFormat(40, lineHeight, "Rs. "+fmt.Sprintf("%.2f", valueOf(transfer.Value)), "", 1, "R", false, 0, "")
+
+	if note := strings.TrimSpace(valueOf(transfer.Note)); note != "" {
+		pdf.Ln(2)
+		pdf.SetFont("Helvetica", "", 10)
+		pdf.MultiCell(0, lineHeight, doc.tr("Note: "+note), "", "L", false)
+	}
+
+	pdf.Ln(16)
+	pdf.SetFont("Helvetica", "", 10)
+	if consignor != "" {
+		pdf.CellFormat(0, lineHeight, doc.tr("For "+consignor), "", 1, "R", false, 0, "")
+	}
+	pdf.Ln(10)
+	pdf.CellFormat(0, lineHeight, "Authorised Signatory", "", 1, "R", false, 0, "")
+
+	return pdf.Output(w)
+}
+
+func (d *document) writeParty(title string, p party) {
+	pdf := d.pdf
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(0, lineHeight, title, "B", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(0, lineHeight, d.tr(p.name), "", 1, "L", false, 0, "")
+	if address := strings.TrimSpace(valueOf(p.address)); address != "" {
+		pdf.MultiCell(0, lineHeight, d.tr(address), "", "L", false)
+	}
+	if city := strings.TrimSpace(valueOf(p.city) + " " + valueOf(p.pincode)); city != "" {
+		pdf.CellFormat(0, lineHeight, d.tr(city), "", 1, "L", false, 0, "")
+	}
+	if p.state != nil {
+		pdf.CellFormat(0, lineHeight, "State: "+stateLabel(*p.state), "", 1, "L", false, 0, "")
+	}
+	if p.gstin != nil {
+		pdf.CellFormat(0, lineHeight, "GSTIN: "+*p.gstin, "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(2)
+}
+
+func (d *document) writeChallanItems(transfer v1.Transfer) {
+	pdf := d.pdf
+	pdf.SetFont("Helvetica", "B", 9)
+	var width float64
+	for _, col := range challanColumns {
+		pdf.CellFormat(col.width, lineHeight, col.title, "1", 0, col.align, false, 0, "")
+		width += col.width
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Helvetica", "", 9)
+	for i, item := range transfer.Items {
+		name := fmt.Sprintf("Product %d", item.ProductId)
+		if item.Name != nil {
+			name = *item.Name
+		}
+		if item.VariantLabel != nil {
+			name += " (" + *item.VariantLabel + ")"
+		}
+		row := []string{
+			fmt.Sprintf("%d", i+1),
+			d.fit(d.tr(name), challanColumns[1].width-2),
+			valueOf(item.HsnCode),
+			uom.Format(item.Quantity, string(valueOf(item.Unit))),
+			fmt.Sprintf("%.2f", valueOf(item.UnitCost)),
+			fmt.Sprintf("%.2f", valueOf(item.Value)),
+		}
+		for j, col := range challanColumns {
+			pdf.CellFormat(col.width, lineHeight, row[j], "1", 0, col.align, false, 0, "")
+		}
+		pdf.Ln(-1)
+
+		if item.Batches == nil {
+			continue
+		}
+		pdf.SetFont("Helvetica", "I", 8)
+		for _, batch := range *item.Batches {
+			text := fmt.Sprintf("Batch %s  Exp %s  MRP %s  Qty %s", batch.BatchNo, batch.ExpiresOn.Format(expiryLayout), amount(batch.Mrp),
+				uom.Format(batch.Quantity, string(valueOf(item.Unit))))
+			pdf.CellFormat(challanColumns[0].width, lineHeight, "", "1", 0, "", false, 0, "")
+			pdf.CellFormat(width-challanColumns[0].width, lineHeight, d.tr(text), "1", 1, "L", false, 0, "")
+		}
+		pdf.SetFont("Helvetica", "", 9)
+	}
+}
+
+// stateLabel names the state of a GST state code, followed by the code.
+func stateLabel(code string) string {
+	if name, ok := gst.StateName(code); ok {
+		return fmt.Sprintf("%s (%s)", name, code)
+	}
+	return code
+}
@@ -0,0 +1,364 @@
+// Package receipt renders sales, and the transfers of stock to other stores,
+// as printable PDF documents.
+package receipt
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/gst"
+	"github.com/nitinjangam/pos-receipt-system/internal/uom"
+)
+
+const (
+	pageMargin   = 10.0
+	lineHeight   = 6.0
+	dateLayout   = "02 Jan 2006 15:04"
+	expiryLayout = "02 Jan 2006"
+)
+
+// compositionDeclaration must appear at the top of every bill of supply
+// issued by a composition taxable person.
+const compositionDeclaration = "Composition taxable person, not eligible to collect tax on supplies"
+
+type column struct {
+	title string
+	width float64
+	align string
+}
+
+var columns = []column{
+	{"#", 8, "C"},
+	{"Item", 56, "L"},
+	{"Qty", 20, "R"},
+	{"Rate", 20, "R"},
+	{"Taxable", 22, "R"},
+	{"CGST", 22, "R"},
+	{"SGST", 22, "R"},
+	{"Total", 20, "R"},
+}
+
+// interstateColumns lay out a tax invoice charged integrated GST.
+var interstateColumns = []column{
+	{"#", 8, "C"},
+	{"Item", 56, "L"},
+	{"Qty", 20, "R"},
+	{"Rate", 20, "R"},
+	{"Taxable", 22, "R"},
+	{"IGST", 44, "R"},
+	{"Total", 20, "R"},
+}
+
+// supplyColumns lay out a bill of supply, which shows no tax.
+var supplyColumns = []column{
+	{"#", 8, "C"},
+	{"Item", 100, "L"},
+	{"Qty", 20, "R"},
+	{"Rate", 30, "R"},
+	{"Amount", 32, "R"},
+}
+
+// document wraps the PDF together with the translator that maps UTF-8 text
+// onto the code page of the built-in fonts.
+type document struct {
+	pdf *fpdf.Fpdf
+	tr  func(string) string
+}
+
+// Render writes the sale as a PDF tax invoice, or as a bill of supply for
+// sales made under the composition scheme, to w.
+func Render(w io.Writer, sale v1.Sale) error {
+	billOfSupply := valueOf(sale.DocumentType) == v1.BillOfSupply
+	title, number := "Tax Invoice", "Invoice No"
+	if billOfSupply {
+		title, number = "Bill of Supply", "Bill No"
+	}
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(true, pageMargin)
+	pdf.SetTitle(fmt.Sprintf("%s %d", title, valueOf(sale.Id)), false)
+	pdf.AddPage()
+	doc := &document{pdf: pdf, tr: pdf.UnicodeTranslatorFromDescriptor("")}
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 10, title, "", 1, "C", false, 0, "")
+	if billOfSupply {
+		pdf.SetFont("Helvetica", "I", 9)
+		pdf.CellFormat(0, lineHeight, compositionDeclaration, "", 1, "C", false, 0, "")
+	}
+
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(95, lineHeight, fmt.Sprintf("%s: %d", number, valueOf(sale.Id)), "", 0, "L", false, 0, "")
+	soldAt := ""
+	if sale.SoldAt != nil {
+		soldAt = sale.SoldAt.Local().Format(dateLayout)
+	}
+	pdf.CellFormat(95, lineHeight, "Date: "+soldAt, "", 1, "R", false, 0, "")
+	if sale.VoidedAt != nil {
+		pdf.SetFont("Helvetica", "B", 10)
+		pdf.CellFormat(0, lineHeight, "VOID - cancelled on "+sale.VoidedAt.Local().Format(dateLayout), "", 1, "C", false, 0, "")
+		pdf.SetFont("Helvetica", "", 10)
+	}
+	pdf.Ln(2)
+
+	if sale.BilledTo != nil {
+		doc.writeBilledTo(*sale.BilledTo)
+	}
+
+	if billOfSupply {
+		doc.writeSupplyItems(sale)
+		doc.writeSupplyTotal(sale)
+	} else {
+		doc.writeItems(sale)
+		doc.writeTotals(sale)
+	}
+
+	return pdf.Output(w)
+}
+
+func (d *document) writeBilledTo(customer v1.Customer) {
+	pdf := d.pdf
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(0, lineHeight, "Billed To", "B", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(0, lineHeight, d.tr(customer.LegalName), "", 1, "L", false, 0, "")
+	if customer.Address != nil && *customer.Address != "" {
+		pdf.MultiCell(0, lineHeight, d.tr(*customer.Address), "", "L", false)
+	}
+	if customer.StateCode != nil {
+		state := *customer.StateCode
+		if name, ok := gst.StateName(state); ok {
+			state = fmt.Sprintf("%s (%s)", name, state)
+		}
+		pdf.CellFormat(0, lineHeight, "State: "+state, "", 1, "L", false, 0, "")
+	}
+	if customer.Gstin != nil {
+		pdf.CellFormat(0, lineHeight, "GSTIN: "+*customer.Gstin, "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(2)
+}
+
+func (d *document) writeItems(sale v1.Sale) {
+	interstate := valueOf(sale.Interstate)
+	cols := columns
+	if interstate {
+		cols = interstateColumns
+	}
+	d.writeTable(cols, sale, func(number string, item v1.SaleItem, name string) []string {
+		cells := []string{
+			number,
+			name,
+			uom.Format(valueOf(item.Quantity), string(valueOf(item.Unit))),
+			amount(item.UnitPrice),
+			amount(item.Subtotal),
+		}
+		if interstate {
+			cells = append(cells, taxCell(item.IgstAmount, item.IgstRate))
+		} else {
+			cells = append(cells, taxCell(item.CgstAmount, item.CgstRate), taxCell(item.SgstAmount, item.SgstRate))
+		}
+		return append(cells, amount(item.LineTotal))
+	})
+}
+
+func (d *document) writeSupplyItems(sale v1.Sale) {
+	d.writeTable(supplyColumns, sale, func(number string, item v1.SaleItem, name string) []string {
+		return []string{
+			number,
+			name,
+			uom.Format(valueOf(item.Quantity), string(valueOf(item.Unit))),
+			amount(item.UnitPrice),
+			amount(item.LineTotal),
+		}
+	})
+}
+
+// writeTable prints a header row for cols followed by one row per sale line,
+// each followed by a row for the discount promotions took off it and a row
+// per batch the line was sold from. The components of
+// a bundle are listed, unnumbered, under a row for the bundle that shows its
+// price and total. The item name passed to cells is already fitted to the
+// second column.
+func (d *document) writeTable(cols []column, sale v1.Sale, cells func(number string, item v1.SaleItem, name string) []string) {
+	pdf := d.pdf
+	pdf.SetFont("Helvetica", "B", 9)
+	for _, col := range cols {
+		pdf.CellFormat(col.width, lineHeight, col.title, "1", 0, col.align, false, 0, "")
+	}
+	pdf.Ln(-1)
+
+	var width float64
+	for _, col := range cols {
+		width += col.width
+	}
+
+	pdf.SetFont("Helvetica", "", 9)
+	if sale.Items == nil {
+		return
+	}
+	bundleTotals := map[*v1.SaleItemBundle]float32{}
+	for _, item := range *sale.Items {
+		if item.Bundle != nil {
+			bundleTotals[item.Bundle] += valueOf(item.LineTotal)
+		}
+	}
+
+	var line int
+	var bundle *v1.SaleItemBundle
+	for _, item := range *sale.Items {
+		if item.Bundle != nil && item.Bundle != bundle {
+			bundle = item.Bundle
+			line++
+			d.writeBundleRow(cols, fmt.Sprintf("%d", line), *bundle, bundleTotals[bundle])
+		} else if item.Bundle == nil {
+			bundle = nil
+			line++
+		}
+
+		name := fmt.Sprintf("Product %d", valueOf(item.ProductId))
+		if item.Name != nil {
+			name = *item.Name
+		}
+		if item.VariantLabel != nil {
+			name += " (" + *item.VariantLabel + ")"
+		}
+		number := fmt.Sprintf("%d", line)
+		if bundle != nil {
+			number, name = "", "  "+name
+			pdf.SetFont("Helvetica", "I", 9)
+		}
+		row := cells(number, item, d.fit(d.tr(name), cols[1].width-2))
+		for j, col := range cols {
+			pdf.CellFormat(col.width, lineHeight, row[j], "1", 0, col.align, false, 0, "")
+		}
+		pdf.Ln(-1)
+		pdf.SetFont("Helvetica", "", 9)
+
+		if discount := valueOf(item.Discount); discount > 0 {
+			pdf.SetFont("Helvetica", "I", 8)
+			text := fmt.Sprintf("Less promotions %s on %s", amount(&discount), amount(float32Ptr(valueOf(item.Subtotal)+discount)))
+			pdf.CellFormat(cols[0].width, lineHeight, "", "1", 0, "", false, 0, "")
+			pdf.CellFormat(width-cols[0].width, lineHeight, d.tr(text), "1", 1, "L", false, 0, "")
+			pdf.SetFont("Helvetica", "", 9)
+		}
+
+		if item.Batches == nil {
+			continue
+		}
+		pdf.SetFont("Helvetica", "I", 8)
+		for _, batch := range *item.Batches {
+			text := fmt.Sprintf("Batch %s  Exp %s  MRP %s  Qty %s", valueOf(batch.BatchNo), valueOf(batch.ExpiresOn).Format(expiryLayout),
+				amount(batch.Mrp), uom.Format(valueOf(batch.Quantity), string(valueOf(item.Unit))))
+			pdf.CellFormat(cols[0].width, lineHeight, "", "1", 0, "", false, 0, "")
+			pdf.CellFormat(width-cols[0].width, lineHeight, d.tr(text), "1", 1, "L", false, 0, "")
+		}
+		pdf.SetFont("Helvetica", "", 9)
+	}
+}
+
+// writeBundleRow prints the row of a bundle: its quantity, price before tax
+// and the total of its components in the last column.
+func (d *document) writeBundleRow(cols []column, number string, bundle v1.SaleItemBundle, total float32) {
+	row := make([]string, len(cols))
+	row[0] = number
+	row[1] = d.fit(d.tr(valueOf(bundle.Name)), cols[1].width-2)
+	row[2] = uom.Format(valueOf(bundle.Quantity), string(valueOf(bundle.Unit)))
+	row[3] = amount(bundle.UnitPrice)
+	row[len(row)-1] = amount(&total)
+
+	pdf := d.pdf
+	pdf.SetFont("Helvetica", "B", 9)
+	for j, col := range cols {
+		pdf.CellFormat(col.width, lineHeight, row[j], "1", 0, col.align, false, 0, "")
+	}
+	pdf.Ln(-1)
+	pdf.SetFont("Helvetica", "", 9)
+}
+
+// writePromotions prints the value of the sale before promotions followed by
+// what each promotion applied took off it.
+func (d *document) writePromotions(sale v1.Sale) {
+	if sale.Promotions == nil || len(*sale.Promotions) == 0 {
+		return
+	}
+	pdf := d.pdf
+	pdf.SetFont("Helvetica", "", 10)
+	gross := valueOf(sale.Subtotal) + valueOf(sale.DiscountTotal)
+	pdf.CellFormat(150, lineHeight, "Value before promotions", "", 0, "R", false, 0, "")
+	pdf.CellFormat(40, lineHeight, "Rs. "+amount(&gross), "", 1, "R", false, 0, "")
+	for _, applied := range *sale.Promotions {
+		label := d.fit(d.tr("Less: "+valueOf(applied.Name)), 148)
+		discount := -valueOf(applied.Discount)
+		pdf.CellFormat(150, lineHeight, label, "", 0, "R", false, 0, "")
+		pdf.CellFormat(40, lineHeight, "Rs. "+amount(&discount), "", 1, "R", false, 0, "")
+	}
+}
+
+func (d *document) writeTotals(sale v1.Sale) {
+	pdf := d.pdf
+	type total struct {
+		label string
+		value *float32
+	}
+	totals := []total{{"Taxable Value", sale.Subtotal}, {"CGST", sale.CgstTotal}, {"SGST", sale.SgstTotal}}
+	if valueOf(sale.Interstate) {
+		totals = []total{{"Taxable Value", sale.Subtotal}, {"IGST", sale.IgstTotal}}
+	}
+	totals = append(totals, total{"Grand Total", sale.GrandTotal})
+
+	pdf.Ln(2)
+	d.writePromotions(sale)
+	for i, total := range totals {
+		if i == len(totals)-1 {
+			pdf.SetFont("Helvetica", "B", 10)
+		} else {
+			pdf.SetFont("Helvetica", "", 10)
+		}
+		pdf.CellFormat(150, lineHeight, total.label, "", 0, "R", false, 0, "")
+		pdf.CellFormat(40, lineHeight, "Rs. "+amount(total.value), "", 1, "R", false, 0, "")
+	}
+}
+
+func (d *document) writeSupplyTotal(sale v1.Sale) {
+	pdf := d.pdf
+	pdf.Ln(2)
+	d.writePromotions(sale)
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(150, lineHeight, "Total", "", 0, "R", false, 0, "")
+	pdf.CellFormat(40, lineHeight, "Rs. "+amount(sale.GrandTotal), "", 1, "R", false, 0, "")
+}
+
+// fit truncates s so that it is no wider than width at the current font.
+func (d *document) fit(s string, width float64) string {
+	if d.pdf.GetStringWidth(s) <= width {
+		return s
+	}
+	for len(s) > 0 && d.pdf.GetStringWidth(s+"...") > width {
+		s = strings.TrimSpace(s[:len(s)-1])
+	}
+	return s + "..."
+}
+
+func taxCell(value, rate *float32) string {
+	return fmt.Sprintf("%s (%g%%)", amount(value), valueOf(rate))
+}
+
+func amount(v *float32) string {
+	return fmt.Sprintf("%.2f", valueOf(v))
+}
+
+func float32Ptr(v float32) *float32 {
+	return &v
+}
+
+func valueOf[T any](v *T) T {
+	var zero T
+	if v == nil {
+		return zero
+	}
+	return *v
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+)
+
+// CategoryRepositoryInterface defines the methods for the category repository.
+type CategoryRepositoryInterface interface {
+	GetAllCategories(ctx context.Context) ([]v1.Category, error)
+	GetCategoryByID(ctx context.Context, id int) (*v1.Category, error)
+	GetCategoryByName(ctx context.Context, parentID *int, name string) (*v1.Category, error)
+	CreateCategory(ctx context.Context, category v1.Category) (int, error)
+	UpdateCategory(ctx context.Context, category v1.Category) error
+	DeleteCategory(ctx context.Context, id int) error
+	CountCategoryUsage(ctx context.Context, id int) (children int, products int, err error)
+}
+
+const selectCategories = "SELECT id, name, parent_id, hsn_code, cgst_rate, sgst_rate FROM categories"
+
+type CategoryRepository struct {
+	db *sql.DB
+}
+
+func NewCategoryRepository(db *sql.DB) *CategoryRepository {
+	return &CategoryRepository{
+		db: db,
+	}
+}
+
+func scanCategory(row interface{ Scan(dest ...any) error }) (v1.Category, error) {
+	var category v1.Category
+	err := row.Scan(&category.Id, &category.Name, &category.ParentId, &category.HsnCode, &category.CgstRate, &category.SgstRate)
+	return category, err
+}
+
+func (r *CategoryRepository) GetAllCategories(ctx context.Context) ([]v1.Category, error) {
+	var categories []v1.Category
+
+	rows, err := r.db.QueryContext(ctx, selectCategories+" ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		category, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, category)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *CategoryRepository) GetCategoryByID(ctx context.Context, id int) (*v1.Category, error) {
+	category, err := scanCategory(r.db.QueryRowContext(ctx, selectCategories+" WHERE id = ?", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Category not found
+		}
+		return nil, err
+	}
+	return &category, nil
+}
+
+// GetCategoryByName finds the category with the given name under the parent,
+// or among the top-level categories when parentID is nil.
+func (r *CategoryRepository) GetCategoryByName(ctx context.Context, parentID *int, name string) (*v1.Category, error) {
+	category, err := scanCategory(r.db.QueryRowContext(ctx, selectCategories+" WHERE COALESCE(parent_id, 0) = COALESCE(?, 0) AND name = ?", parentID, name))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Category not found
+		}
+		return nil, err
+	}
+	return &category, nil
+}
+
+func (r *CategoryRepository) CreateCategory(ctx context.Context, category v1.Category) (int, error) {
+	query := "INSERT INTO categories (name, parent_id, hsn_code, cgst_rate, sgst_rate) VALUES (?, ?, ?, ?, ?)"
+	result, err := r.db.ExecContext(ctx, query, category.Name, category.ParentId, category.HsnCode, category.CgstRate, category.SgstRate)
+	if err != nil {
+		return 0, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return int(id), nil
+}
+
+func (r *CategoryRepository) UpdateCategory(ctx context.Context, category v1.Category) error {
+	query := "UPDATE categories SET name = ?, parent_id = ?, hsn_code = ?, cgst_rate = ?, sgst_rate = ? WHERE id = ?"
+	_, err := r.db.ExecContext(ctx, query, category.Name, category.ParentId, category.HsnCode, category.CgstRate, category.SgstRate, category.Id)
+	return err
+}
+
+func (r *CategoryRepository) DeleteCategory(ctx context.Context, id int) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM categories WHERE id = ?", id)
+	return err
+}
+
+// CountCategoryUsage returns the number of direct subcategories and products
+// of the category.
+func (r *CategoryRepository) CountCategoryUsage(ctx context.Context, id int) (int, int, error) {
+	var children, products int
+	query := "SELECT (SELECT COUNT(*) FROM categories WHERE parent_id = ?), (SELECT COUNT(*) FROM products WHERE category_id = ?)"
+	if err := r.db.QueryRowContext(ctx, query, id, id).Scan(&children, &products); err != nil {
+		return 0, 0, err
+	}
+	return children, products, nil
+}
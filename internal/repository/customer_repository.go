@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+)
+
+// CustomerRepositoryInterface defines the methods for the customer repository.
+type CustomerRepositoryInterface interface {
+	GetAllCustomers(ctx context.Context) ([]v1.Customer, error)
+	GetCustomerByID(ctx context.Context, id int) (*v1.Customer, error)
+	GetCustomerByGSTIN(ctx context.Context, gstin string) (*v1.Customer, error)
+	CreateCustomer(ctx context.Context, customer v1.Customer) (int, error)
+	UpdateCustomer(ctx context.Context, customer v1.Customer) error
+	GetAllCustomerGroups(ctx context.Context) ([]v1.CustomerGroup, error)
+	GetCustomerGroupByID(ctx context.Context, id int) (*v1.CustomerGroup, error)
+	GetCustomerGroupByName(ctx context.Context, name string) (*v1.CustomerGroup, error)
+	CreateCustomerGroup(ctx context.Context, group v1.CustomerGroup) (int, error)
+	UpdateCustomerGroup(ctx context.Context, group v1.CustomerGroup) error
+	DeleteCustomerGroup(ctx context.Context, id int) error
+	CountGroupCustomers(ctx context.Context, id int) (int, error)
+}
+
+const selectCustomers = "SELECT id, legal_name, address, state_code, gstin, phone, group_id, price_list_id FROM customers"
+
+const selectCustomerGroups = "SELECT id, name, price_list_id FROM customer_groups"
+
+type CustomerRepository struct {
+	db *sql.DB
+}
+
+func NewCustomerRepository(db *sql.DB) *CustomerRepository {
+	return &CustomerRepository{
+		db: db,
+	}
+}
+
+func scanCustomer(row interface{ Scan(dest ...any) error }) (v1.Customer, error) {
+	var customer v1.Customer
+	err := row.Scan(&customer.Id, &customer.LegalName, &customer.Address, &customer.StateCode, &customer.Gstin, &customer.Phone, &customer.GroupId, &customer.PriceListId)
+	return customer, err
+}
+
+func (r *CustomerRepository) GetAllCustomers(ctx context.Context) ([]v1.Customer, error) {
+	var customers []v1.Customer
+
+	rows, err := r.db.QueryContext(ctx, selectCustomers+" ORDER BY legal_name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		customer, err := scanCustomer(rows)
+		if err != nil {
+			return nil, err
+		}
+		customers = append(customers, customer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return customers, nil
+}
+
+func (r *CustomerRepository) GetCustomerByID(ctx context.Context, id int) (*v1.Customer, error) {
+	customer, err := scanCustomer(r.db.QueryRowContext(ctx, selectCustomers+" WHERE id = ?", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Customer not found
+		}
+		return nil, err
+	}
+	return &customer, nil
+}
+
+func (r *CustomerRepository) GetCustomerByGSTIN(ctx context.Context, gstin string) (*v1.Customer, error) {
+	customer, err := scanCustomer(r.db.QueryRowContext(ctx, selectCustomers+" WHERE gstin = ?", gstin))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Customer not found
+		}
+		return nil, err
+	}
+	return &customer, nil
+}
+
+func (r *CustomerRepository) CreateCustomer(ctx context.Context, customer v1.Customer) (int, error) {
+	query := "INSERT INTO customers (legal_name, address, state_code, gstin, phone, group_id, price_list_id) VALUES (?, ?, ?, ?, ?, ?, ?)"
+	result, err := r.db.ExecContext(ctx, query, customer.LegalName, customer.Address, customer.StateCode, customer.Gstin, customer.Phone,
+		customer.GroupId, customer.PriceListId)
+	if err != nil {
+		return 0, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return int(id), nil
+}
+
+func (r *CustomerRepository) UpdateCustomer(ctx context.Context, customer v1.Customer) error {
+	query := "UPDATE customers SET legal_name = ?, address = ?, state_code = ?, gstin = ?, phone = ?, group_id = ?, price_list_id = ? WHERE id = ?"
+	_, err := r.db.ExecContext(ctx, query, customer.LegalName, customer.Address, customer.StateCode, customer.Gstin, customer.Phone,
+		customer.GroupId, customer.PriceListId, customer.Id)
+	return err
+}
+
+func scanCustomerGroup(row interface{ Scan(dest ...any) error }) (v1.CustomerGroup, error) {
+	var group v1.CustomerGroup
+	err := row.Scan(&group.Id, &group.Name, &group.PriceListId)
+	return group, err
+}
+
+func (r *CustomerRepository) GetAllCustomerGroups(ctx context.Context) ([]v1.CustomerGroup, error) {
+	groups := []v1.CustomerGroup{}
+
+	rows, err := r.db.QueryContext(ctx, selectCustomerGroups+" ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		group, err := scanCustomerGroup(rows)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+	return groups, rows.Err()
+}
+
+func (r *CustomerRepository) GetCustomerGroupByID(ctx context.Context, id int) (*v1.CustomerGroup, error) {
+	group, err := scanCustomerGroup(r.db.QueryRowContext(ctx, selectCustomerGroups+" WHERE id = ?", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Customer group not found
+		}
+		return nil, err
+	}
+	return &group, nil
+}
+
+// GetCustomerGroupByName looks the name up regardless of case.
+func (r *CustomerRepository) GetCustomerGroupByName(ctx context.Context, name string) (*v1.CustomerGroup, error) {
+	group, err := scanCustomerGroup(r.db.QueryRowContext(ctx, selectCustomerGroups+" WHERE name = ?", name))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Customer group not found
+		}
+		return nil, err
+	}
+	return &group, nil
+}
+
+func (r *CustomerRepository) CreateCustomerGroup(ctx context.Context, group v1.CustomerGroup) (int, error) {
+	query := "INSERT INTO customer_groups (name, price_list_id) VALUES (?, ?)"
+	result, err := r.db.ExecContext(ctx, query, group.Name, group.PriceListId)
+	if err != nil {
+		return 0, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return int(id), nil
+}
+
+func (r *CustomerRepository) UpdateCustomerGroup(ctx context.Context, group v1.CustomerGroup) error {
+	query := "UPDATE customer_groups SET name = ?, price_list_id = ? WHERE id = ?"
+	_, err := r.db.ExecContext(ctx, query, group.Name, group.PriceListId, group.Id)
+	return err
+}
+
+func (r *CustomerRepository) DeleteCustomerGroup(ctx context.Context, id int) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM customer_groups WHERE id = ?", id)
+	return err
+}
+
+// CountGroupCustomers returns the number of customers in the group.
+func (r *CustomerRepository) CountGroupCustomers(ctx context.Context, id int) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM customers WHERE group_id = ?", id).Scan(&count)
+	return count, err
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// ErrStatusChanged is returned when a record no longer has the status a
+// change was made from, because another request changed it first.
+var ErrStatusChanged = errors.New("status changed concurrently")
+
+// ErrInsufficientStock is returned when goods going out would take more than
+// is on hand, as read within the transaction that posts them.
+var ErrInsufficientStock = errors.New("insufficient stock")
+
+// ErrOverReceived is returned when goods received would take an order line
+// beyond its ordered quantity.
+var ErrOverReceived = errors.New("received more than is outstanding")
+
+// expectOneRow fails with ErrStatusChanged when a status change matched no
+// record.
+func expectOneRow(result sql.Result, record string, id int) error {
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n != 1 {
+		return fmt.Errorf("%w: %s %d", ErrStatusChanged, record, id)
+	}
+	return nil
+}
+
+// ErrOverReturned is returned when a return would take back more of a
+// product than its sale sold, net of earlier returns.
+var ErrOverReturned = errors.New("returned more than was sold")
+
+// ErrProductSold, ErrProductPurchased and ErrProductStocked are returned when
+// a product to be deleted is on sale lines, purchase order lines or in the
+// stock ledger, which keep it for good.
+var (
+	ErrProductSold      = errors.New("product has been sold")
+	ErrProductPurchased = errors.New("product has been purchased")
+	ErrProductStocked   = errors.New("product has stock movements")
+)
+
+// ErrProductInUse is returned when a product to be deleted is a component of
+// a bundle or on a stocktake or transfer.
+var ErrProductInUse = errors.New("product is in use")
+
+// ErrStocktakeInProgress is returned when a stocktake is started while
+// another is still counting.
+var ErrStocktakeInProgress = errors.New("another stocktake is counting")
+
+// ErrNegativeCount is returned when counts would take the quantity counted
+// for a product below zero.
+var ErrNegativeCount = errors.New("counted quantity below zero")
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+)
+
+// EWayBillRepositoryInterface defines the methods for the e-way bill repository.
+type EWayBillRepositoryInterface interface {
+	GetEWayBill(ctx context.Context, saleID int) (*v1.EWayBill, error)
+	SaveEWayBillPayload(ctx context.Context, saleID int, payload []byte) error
+	UpdateEWayBillNumber(ctx context.Context, saleID int, number v1.EWayBillNumber) error
+}
+
+type EWayBillRepository struct {
+	db *sql.DB
+}
+
+func NewEWayBillRepository(db *sql.DB) *EWayBillRepository {
+	return &EWayBillRepository{
+		db: db,
+	}
+}
+
+func (r *EWayBillRepository) GetEWayBill(ctx context.Context, saleID int) (*v1.EWayBill, error) {
+	var bill v1.EWayBill
+	var payload string
+	var ewbDate, validUpto sql.NullTime
+
+	query := "SELECT sale_id, payload, ewb_no, ewb_date, valid_upto, created_at FROM eway_bills WHERE sale_id = ?"
+	err := r.db.QueryRowContext(ctx, query, saleID).Scan(&bill.SaleId, &payload, &bill.EwbNo, &ewbDate, &validUpto, &bill.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // E-way bill not found
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(payload), &bill.Payload); err != nil {
+		return nil, err
+	}
+	if ewbDate.Valid {
+		bill.EwbDate = &ewbDate.Time
+	}
+	if validUpto.Valid {
+		bill.ValidUpto = &validUpto.Time
+	}
+	return &bill, nil
+}
+
+// SaveEWayBillPayload stores the payload generated for the sale, replacing
+// any earlier payload.
+func (r *EWayBillRepository) SaveEWayBillPayload(ctx context.Context, saleID int, payload []byte) error {
+	query := `INSERT INTO eway_bills (sale_id, payload) VALUES (?, ?)
+		ON CONFLICT(sale_id) DO UPDATE SET payload = excluded.payload, updated_at = CURRENT_TIMESTAMP`
+	_, err := r.db.ExecContext(ctx, query, saleID, string(payload))
+	return err
+}
+
+// UpdateEWayBillNumber records the number the portal issued for the sale's
+// e-way bill.
+func (r *EWayBillRepository) UpdateEWayBillNumber(ctx context.Context, saleID int, number v1.EWayBillNumber) error {
+	var ewbDate, validUpto any
+	if number.EwbDate != nil {
+		ewbDate = number.EwbDate.UTC()
+	}
+	if number.ValidUpto != nil {
+		validUpto = number.ValidUpto.UTC()
+	}
+
+	query := "UPDATE eway_bills SET ewb_no = ?, ewb_date = ?, valid_upto = ?, updated_at = CURRENT_TIMESTAMP WHERE sale_id = ?"
+	_, err := r.db.ExecContext(ctx, query, number.EwbNo, ewbDate, validUpto, saleID)
+	return err
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+)
+
+// ImageRepositoryInterface defines the methods for the product image repository.
+type ImageRepositoryInterface interface {
+	GetProductImages(ctx context.Context, productID int) ([]v1.ProductImage, error)
+	CountProductImages(ctx context.Context, productID int) (int, error)
+	CreateProductImage(ctx context.Context, image v1.ProductImage, imageKey, thumbnailKey string) (int, error)
+	DeleteProductImage(ctx context.Context, productID, imageID int) ([]string, error)
+}
+
+const selectProductImages = `SELECT id, product_id, url, thumbnail_url, content_type, size, width, height, created_at FROM product_images`
+
+type ImageRepository struct {
+	db *sql.DB
+}
+
+func NewImageRepository(db *sql.DB) *ImageRepository {
+	return &ImageRepository{
+		db: db,
+	}
+}
+
+// GetProductImages returns the images of the product in upload order.
+func (r *ImageRepository) GetProductImages(ctx context.Context, productID int) ([]v1.ProductImage, error) {
+	images := []v1.ProductImage{}
+
+	rows, err := r.db.QueryContext(ctx, selectProductImages+" WHERE product_id = ? ORDER BY id", productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var image v1.ProductImage
+		if err := rows.Scan(&image.Id, &image.ProductId, &image.Url, &image.ThumbnailUrl, &image.ContentType, &image.Size, &image.Width,
+			&image.Height, &image.CreatedAt); err != nil {
+			return nil, err
+		}
+		images = append(images, image)
+	}
+	return images, rows.Err()
+}
+
+func (r *ImageRepository) CountProductImages(ctx context.Context, productID int) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM product_images WHERE product_id = ?", productID).Scan(&count)
+	return count, err
+}
+
+// CreateProductImage records an image whose files are stored under the given
+// keys and returns its ID. The creation time is stored as RFC 3339 text.
+func (r *ImageRepository) CreateProductImage(ctx context.Context, image v1.ProductImage, imageKey, thumbnailKey string) (int, error) {
+	query := `INSERT INTO product_images (product_id, image_key, thumbnail_key, url, thumbnail_url, content_type, size, width, height, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	result, err := r.db.ExecContext(ctx, query, image.ProductId, imageKey, thumbnailKey, image.Url, image.ThumbnailUrl, image.ContentType,
+		image.Size, image.Width, image.Height, time.Now().UTC().Format(time.RFC3339Nano))
+	if err != nil {
+		return 0, err
+	}
+	id, err := result.LastInsertId()
+	return int(id), err
+}
+
+// DeleteProductImage deletes the image of the product and returns the keys
+// of its files, or none when the product has no such image.
+func (r *ImageRepository) DeleteProductImage(ctx context.Context, productID, imageID int) ([]string, error) {
+	return r.deleteImages(ctx, " WHERE product_id = ? AND id = ?", productID, imageID)
+}
+
+func (r *ImageRepository) deleteImages(ctx context.Context, where string, args ...any) ([]string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var keys []string
+	rows, err := tx.QueryContext(ctx, "SELECT image_key, thumbnail_key FROM product_images"+where, args...)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var imageKey, thumbnailKey string
+		if err := rows.Scan(&imageKey, &thumbnailKey); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		keys = append(keys, imageKey, thumbnailKey)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM product_images"+where, args...); err != nil {
+		return nil, err
+	}
+	return keys, tx.Commit()
+}
@@ -0,0 +1,430 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// InventoryRepositoryInterface defines the methods for the inventory repository.
+type InventoryRepositoryInterface interface {
+	GetStockLevel(ctx context.Context, productID int) (*v1.StockLevel, error)
+	GetStockMovements(ctx context.Context, productID int) ([]v1.StockMovement, error)
+	GetReturnedQuantity(ctx context.Context, saleID, productID int) (float64, error)
+	CreateStockMovement(ctx context.Context, movement v1.StockMovement, batch *v1.ProductBatch, method v1.ValuationMethod,
+		allowNegative bool) (v1.StockMovement, error)
+	GetBatches(ctx context.Context, productID int, includeEmpty bool) ([]v1.ProductBatch, error)
+	GetBatchByNumber(ctx context.Context, productID int, batchNo string) (*v1.ProductBatch, error)
+}
+
+const selectStockMovements = `SELECT id, product_id, quantity, (SELECT unit FROM products WHERE products.id = product_id), reason, sale_id,
+	transfer_id, batch_id, (SELECT batch_no FROM product_batches WHERE product_batches.id = batch_id), note, balance, ROUND(unit_cost, 4), stock_value, created_at
+	FROM stock_movements`
+
+// selectReturnedQuantity sums the returns posted against a sale for a
+// product.
+const selectReturnedQuantity = "SELECT COALESCE(SUM(quantity), 0) FROM stock_movements WHERE sale_id = ? AND product_id = ? AND reason = ?"
+
+// unitCostOf is the cost per unit of the stock on hand of product p: its
+// cost value over the quantity, or the cost of the latest goods brought in
+// when there is nothing on hand to value.
+const unitCostOf = `CASE WHEN p.stock_on_hand > 0 THEN p.stock_value / p.stock_on_hand
+	ELSE COALESCE((SELECT l.unit_cost FROM stock_cost_layers l WHERE l.product_id = p.id ORDER BY l.id DESC LIMIT 1), p.cost_price, 0) END`
+
+// selectBatches reads batches with the name and unit of their product.
+const selectBatches = `SELECT b.id, b.product_id, p.name, p.variant_label, b.batch_no, b.expires_on, b.mrp, b.quantity, p.unit, b.received_at
+	FROM product_batches b JOIN products p ON p.id = b.product_id`
+
+type InventoryRepository struct {
+	db *sql.DB
+}
+
+func NewInventoryRepository(db *sql.DB) *InventoryRepository {
+	return &InventoryRepository{
+		db: db,
+	}
+}
+
+func scanStockMovement(row interface{ Scan(dest ...any) error }) (v1.StockMovement, error) {
+	var movement v1.StockMovement
+	err := row.Scan(&movement.Id, &movement.ProductId, &movement.Quantity, &movement.Unit, &movement.Reason, &movement.SaleId,
+		&movement.TransferId, &movement.BatchId, &movement.BatchNo, &movement.Note, &movement.Balance, &movement.UnitCost, &movement.StockValue, &movement.CreatedAt)
+	return movement, err
+}
+
+func scanBatch(row interface{ Scan(dest ...any) error }) (v1.ProductBatch, error) {
+	var batch v1.ProductBatch
+	var expiresOn string
+	err := row.Scan(&batch.Id, &batch.ProductId, &batch.Name, &batch.VariantLabel, &batch.BatchNo, &expiresOn, &batch.Mrp, &batch.Quantity,
+		&batch.Unit, &batch.ReceivedAt)
+	if err != nil {
+		return batch, err
+	}
+	batch.ExpiresOn, err = parseDate(expiresOn)
+	return batch, err
+}
+
+// parseDate reads a YYYY-MM-DD column.
+func parseDate(value string) (*openapi_types.Date, error) {
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, err
+	}
+	return &openapi_types.Date{Time: t}, nil
+}
+
+func (r *InventoryRepository) GetStockLevel(ctx context.Context, productID int) (*v1.StockLevel, error) {
+	level := v1.StockLevel{ProductId: &productID}
+	var lastMovementAt sql.NullTime
+
+	// Join the latest movement rather than selecting MAX(created_at) so that
+	// the driver still sees a DATETIME column.
+	query := `SELECT p.stock_on_hand, p.unit, p.stock_value, ROUND(` + unitCostOf + `, 4), m.created_at FROM products p
+		LEFT JOIN stock_movements m ON m.id = (SELECT MAX(id) FROM stock_movements WHERE product_id = p.id)
+		WHERE p.id = ?`
+	err := r.db.QueryRowContext(ctx, query, productID).Scan(&level.OnHand, &level.Unit, &level.StockValue, &level.UnitCost, &lastMovementAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Product not found
+		}
+		return nil, err
+	}
+	if lastMovementAt.Valid {
+		level.LastMovementAt = &lastMovementAt.Time
+	}
+	return &level, nil
+}
+
+func (r *InventoryRepository) GetStockMovements(ctx context.Context, productID int) ([]v1.StockMovement, error) {
+	movements := []v1.StockMovement{}
+
+	rows, err := r.db.QueryContext(ctx, selectStockMovements+" WHERE product_id = ? ORDER BY id", productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		movement, err := scanStockMovement(rows)
+		if err != nil {
+			return nil, err
+		}
+		movements = append(movements, movement)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return movements, nil
+}
+
+// GetReturnedQuantity sums the returns already posted against the sale for
+// the product.
+func (r *InventoryRepository) GetReturnedQuantity(ctx context.Context, saleID, productID int) (float64, error) {
+	var returned float64
+	if err := r.db.QueryRowContext(ctx, selectReturnedQuantity, saleID, productID, v1.StockMovementReasonReturn).Scan(&returned); err != nil {
+		return 0, err
+	}
+	return returned, nil
+}
+
+// CreateStockMovement posts the movement, into or out of the batch when one
+// is given, costing goods that go out by the valuation method. A batch
+// without an ID is created first; the MRP of an existing batch is updated
+// when the batch carries one.
+//
+// What the service checked beforehand is checked again within the
+// transaction, for movements posted at the same time: goods going out must be
+// in stock as ensureInStock requires, and a return must not take back more
+// than the sale sold, net of earlier returns, nor reference a voided sale.
+func (r *InventoryRepository) CreateStockMovement(ctx context.Context, movement v1.StockMovement, batch *v1.ProductBatch,
+	method v1.ValuationMethod, allowNegative bool) (v1.StockMovement, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return v1.StockMovement{}, err
+	}
+	defer tx.Rollback()
+
+	if batch != nil {
+		batchID, err := upsertBatch(ctx, tx, *movement.ProductId, *batch)
+		if err != nil {
+			return v1.StockMovement{}, err
+		}
+		movement.BatchId = &batchID
+	}
+	if movement.SaleId != nil {
+		if err := ensureReturnable(ctx, tx, movement); err != nil {
+			return v1.StockMovement{}, err
+		}
+	}
+	if *movement.Quantity < 0 {
+		if err := ensureInStock(ctx, tx, movement, allowNegative); err != nil {
+			return v1.StockMovement{}, err
+		}
+	}
+
+	id, err := postStockMovement(ctx, tx, movement, nil, method)
+	if err != nil {
+		return v1.StockMovement{}, err
+	}
+	created, err := scanStockMovement(tx.QueryRowContext(ctx, selectStockMovements+" WHERE id = ?", id))
+	if err != nil {
+		return v1.StockMovement{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return v1.StockMovement{}, err
+	}
+	return created, nil
+}
+
+// upsertBatch creates the batch of the product, or updates the MRP of an
+// existing one when the batch carries one, and returns its ID.
+func upsertBatch(ctx context.Context, tx *sql.Tx, productID int, batch v1.ProductBatch) (int, error) {
+	var batchID int
+	query := `INSERT INTO product_batches (product_id, batch_no, expires_on, mrp, received_at) VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(product_id, batch_no) DO UPDATE SET mrp = COALESCE(excluded.mrp, mrp) RETURNING id`
+	err := tx.QueryRowContext(ctx, query, productID, batch.BatchNo, batch.ExpiresOn.Format(time.DateOnly), batch.Mrp,
+		time.Now().UTC()).Scan(&batchID)
+	return batchID, err
+}
+
+// postStockMovement appends a movement to the ledger and updates the cached
+// stock on hand and stock value of the product, and the stock on hand of the
+// batch when the movement has one, within the caller's transaction. It
+// returns the ID of the new movement. The balance is rounded so that
+// fractional quantities do not accumulate floating-point error. saleItemID
+// ties a sale movement to its line.
+//
+// Goods coming in are costed at the movement's unit cost, or the current unit
+// cost when it has none, and open a cost layer; goods going out use up the
+// layers first in, first out and are costed by the valuation method.
+func postStockMovement(ctx context.Context, tx *sql.Tx, movement v1.StockMovement, saleItemID *int, method v1.ValuationMethod) (int, error) {
+	var onHand, stockValue, currentCost float64
+	query := "SELECT p.stock_on_hand, p.stock_value, " + unitCostOf + " FROM products p WHERE p.id = ?"
+	if err := tx.QueryRowContext(ctx, query, movement.ProductId).Scan(&onHand, &stockValue, &currentCost); err != nil {
+		return 0, err
+	}
+	quantity := *movement.Quantity
+	balance := math.Round((onHand+quantity)*1e6) / 1e6
+
+	var unitCost, value float64
+	if quantity > 0 {
+		unitCost = currentCost
+		if movement.UnitCost != nil {
+			unitCost = *movement.UnitCost
+		}
+		value = roundCost(stockValue + quantity*unitCost)
+		if onHand < 0 {
+			// The goods first make up for what was sold short, which was
+			// costed at the time; what is left is valued at this cost.
+			value = roundCost(balance * unitCost)
+		}
+	} else {
+		cost, err := useCostLayers(ctx, tx, *movement.ProductId, -quantity, currentCost, method, onHand, stockValue)
+		if err != nil {
+			return 0, err
+		}
+		value = roundCost(stockValue - cost)
+		if balance == 0 {
+			cost, value = stockValue, 0
+		}
+		if quantity != 0 {
+			unitCost = cost / -quantity
+		}
+	}
+
+	now := time.Now().UTC()
+	query = "UPDATE products SET stock_on_hand = ?, stock_value = ?, updated_at = ? WHERE id = ?"
+	if _, err := tx.ExecContext(ctx, query, balance, value, now, movement.ProductId); err != nil {
+		return 0, err
+	}
+	if movement.BatchId != nil {
+		query = "UPDATE product_batches SET quantity = ROUND(quantity + ?, 6) WHERE id = ?"
+		if _, err := tx.ExecContext(ctx, query, movement.Quantity, movement.BatchId); err != nil {
+			return 0, err
+		}
+	}
+
+	query = `INSERT INTO stock_movements (product_id, quantity, reason, sale_id, sale_item_id, transfer_id, batch_id, note, balance, unit_cost,
+		stock_value, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	result, err := tx.ExecContext(ctx, query, movement.ProductId, movement.Quantity, movement.Reason, movement.SaleId, saleItemID,
+		movement.TransferId, movement.BatchId, movement.Note, balance, unitCost, value, now)
+	if err != nil {
+		return 0, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	if quantity > 0 {
+		remaining := math.Round((quantity-min(quantity, max(-onHand, 0)))*1e6) / 1e6
+		query = `INSERT INTO stock_cost_layers (product_id, stock_movement_id, quantity, remaining, unit_cost, created_at)
+			VALUES (?, ?, ?, ?, ?, ?)`
+		if _, err := tx.ExecContext(ctx, query, movement.ProductId, id, quantity, remaining, unitCost, now); err != nil {
+			return 0, err
+		}
+	}
+	return int(id), nil
+}
+
+// ensureInStock fails with ErrInsufficientStock when the goods going out
+// would take the batch below nothing or, unless negative stock is allowed,
+// the product's stock on hand.
+func ensureInStock(ctx context.Context, tx *sql.Tx, movement v1.StockMovement, allowNegative bool) error {
+	quantity := -*movement.Quantity
+	if movement.BatchId != nil {
+		var left float64
+		if err := tx.QueryRowContext(ctx, "SELECT quantity FROM product_batches WHERE id = ?", movement.BatchId).Scan(&left); err != nil {
+			return err
+		}
+		if math.Round((left-quantity)*1e6)/1e6 < 0 {
+			return fmt.Errorf("%w: batch %d of product %d has %g left, %g requested", ErrInsufficientStock, *movement.BatchId,
+				*movement.ProductId, left, quantity)
+		}
+	}
+	if allowNegative {
+		return nil
+	}
+	var onHand float64
+	if err := tx.QueryRowContext(ctx, "SELECT stock_on_hand FROM products WHERE id = ?", movement.ProductId).Scan(&onHand); err != nil {
+		return err
+	}
+	if math.Round((onHand-quantity)*1e6)/1e6 < 0 {
+		return fmt.Errorf("%w: product %d has %g on hand, %g requested", ErrInsufficientStock, *movement.ProductId, onHand, quantity)
+	}
+	return nil
+}
+
+// ensureReturnable fails when the sale the return references has been
+// voided, with ErrStatusChanged, or when the return would take back more of
+// the product than the sale sold net of earlier returns, with
+// ErrOverReturned.
+func ensureReturnable(ctx context.Context, tx *sql.Tx, movement v1.StockMovement) error {
+	var voided bool
+	var sold float64
+	query := `SELECT s.voided_at IS NOT NULL, COALESCE((SELECT SUM(i.quantity) FROM sale_items i WHERE i.sale_id = s.id AND i.product_id = ?), 0)
+		FROM sales s WHERE s.id = ?`
+	if err := tx.QueryRowContext(ctx, query, movement.ProductId, movement.SaleId).Scan(&voided, &sold); err != nil {
+		return err
+	}
+	if voided {
+		return fmt.Errorf("%w: sale %d", ErrStatusChanged, *movement.SaleId)
+	}
+	var returned float64
+	if err := tx.QueryRowContext(ctx, selectReturnedQuantity, movement.SaleId, movement.ProductId, v1.StockMovementReasonReturn).Scan(&returned); err != nil {
+		return err
+	}
+	if math.Round((returned+*movement.Quantity)*1e6)/1e6 > sold {
+		return fmt.Errorf("%w: sale %d sold %g of product %d, of which %g has been returned", ErrOverReturned, *movement.SaleId, sold,
+			*movement.ProductId, returned)
+	}
+	return nil
+}
+
+// useCostLayers takes the quantity out of the product's cost layers, oldest
+// first, and returns the cost of the goods going out. Under FIFO that is the
+// cost of the layers used, with anything beyond them at the cost of the last
+// layer used; under the weighted average it is the average cost of the stock
+// on hand. Goods beyond what is on hand are costed at the current unit cost.
+func useCostLayers(ctx context.Context, tx *sql.Tx, productID int, quantity, currentCost float64, method v1.ValuationMethod,
+	onHand, stockValue float64) (float64, error) {
+	type layer struct {
+		id                  int
+		remaining, unitCost float64
+	}
+	var layers []layer
+	query := "SELECT id, remaining, unit_cost FROM stock_cost_layers WHERE product_id = ? AND remaining > 0 ORDER BY id"
+	rows, err := tx.QueryContext(ctx, query, productID)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var l layer
+		if err := rows.Scan(&l.id, &l.remaining, &l.unitCost); err != nil {
+			return 0, err
+		}
+		layers = append(layers, l)
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	rows.Close()
+
+	var fifoCost float64
+	left, lastCost := quantity, currentCost
+	for _, l := range layers {
+		if left <= 0 {
+			break
+		}
+		used := min(left, l.remaining)
+		query := "UPDATE stock_cost_layers SET remaining = ROUND(remaining - ?, 6) WHERE id = ?"
+		if _, err := tx.ExecContext(ctx, query, used, l.id); err != nil {
+			return 0, err
+		}
+		fifoCost += used * l.unitCost
+		left = math.Round((left-used)*1e6) / 1e6
+		lastCost = l.unitCost
+	}
+
+	if method == v1.Fifo {
+		return roundCost(fifoCost + left*lastCost), nil
+	}
+	if onHand > 0 {
+		currentCost = stockValue / onHand
+	}
+	return roundCost(quantity * currentCost), nil
+}
+
+// roundCost rounds a cost value to the paisa.
+func roundCost(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+// GetBatches returns the batches of the product, first to expire first,
+// leaving out those with nothing on hand unless includeEmpty is set.
+func (r *InventoryRepository) GetBatches(ctx context.Context, productID int, includeEmpty bool) ([]v1.ProductBatch, error) {
+	where := " WHERE b.product_id = ? AND b.quantity > 0"
+	if includeEmpty {
+		where = " WHERE b.product_id = ?"
+	}
+	return queryBatches(ctx, r.db, where+" ORDER BY b.expires_on, b.id", productID)
+}
+
+func (r *InventoryRepository) GetBatchByNumber(ctx context.Context, productID int, batchNo string) (*v1.ProductBatch, error) {
+	batch, err := scanBatch(r.db.QueryRowContext(ctx, selectBatches+" WHERE b.product_id = ? AND b.batch_no = ?", productID, batchNo))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Batch not found
+		}
+		return nil, err
+	}
+	return &batch, nil
+}
+
+func queryBatches(ctx context.Context, db *sql.DB, where string, args ...any) ([]v1.ProductBatch, error) {
+	batches := []v1.ProductBatch{}
+
+	rows, err := db.QueryContext(ctx, selectBatches+where, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		batch, err := scanBatch(rows)
+		if err != nil {
+			return nil, err
+		}
+		batches = append(batches, batch)
+	}
+	return batches, rows.Err()
+}
@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+)
+
+// PriceListRepositoryInterface defines the methods for the price list repository.
+type PriceListRepositoryInterface interface {
+	GetAllPriceLists(ctx context.Context) ([]v1.PriceList, error)
+	GetPriceListByID(ctx context.Context, id int) (*v1.PriceList, error)
+	GetPriceListByName(ctx context.Context, name string) (*v1.PriceList, error)
+	GetDefaultPriceList(ctx context.Context) (*v1.PriceList, error)
+	CreatePriceList(ctx context.Context, priceList v1.PriceList) (int, error)
+	UpdatePriceList(ctx context.Context, priceList v1.PriceList) error
+	DeletePriceList(ctx context.Context, id int) error
+	CountPriceListUsage(ctx context.Context, id int) (int, int, int, error)
+	GetPrices(ctx context.Context, priceListID int, productID *int) ([]v1.PriceListPrice, error)
+	SetPrices(ctx context.Context, priceListID, productID int, tiers []v1.PriceTier) error
+	DeletePrices(ctx context.Context, priceListID, productID int) (int, error)
+	GetTierPrice(ctx context.Context, priceListID, productID int, quantity float64) (*float32, error)
+}
+
+const selectPriceLists = `SELECT l.id, l.name, l.description, l.is_default,
+	(SELECT COUNT(DISTINCT p.product_id) FROM price_list_prices p WHERE p.price_list_id = l.id) FROM price_lists l`
+
+type PriceListRepository struct {
+	db *sql.DB
+}
+
+func NewPriceListRepository(db *sql.DB) *PriceListRepository {
+	return &PriceListRepository{
+		db: db,
+	}
+}
+
+func scanPriceList(row interface{ Scan(dest ...any) error }) (v1.PriceList, error) {
+	var priceList v1.PriceList
+	err := row.Scan(&priceList.Id, &priceList.Name, &priceList.Description, &priceList.IsDefault, &priceList.Products)
+	return priceList, err
+}
+
+func (r *PriceListRepository) GetAllPriceLists(ctx context.Context) ([]v1.PriceList, error) {
+	priceLists := []v1.PriceList{}
+
+	rows, err := r.db.QueryContext(ctx, selectPriceLists+" ORDER BY l.name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		priceList, err := scanPriceList(rows)
+		if err != nil {
+			return nil, err
+		}
+		priceLists = append(priceLists, priceList)
+	}
+	return priceLists, rows.Err()
+}
+
+func (r *PriceListRepository) GetPriceListByID(ctx context.Context, id int) (*v1.PriceList, error) {
+	return r.getPriceList(ctx, " WHERE l.id = ?", id)
+}
+
+// GetPriceListByName looks the name up regardless of case.
+func (r *PriceListRepository) GetPriceListByName(ctx context.Context, name string) (*v1.PriceList, error) {
+	return r.getPriceList(ctx, " WHERE l.name = ?", name)
+}
+
+func (r *PriceListRepository) GetDefaultPriceList(ctx context.Context) (*v1.PriceList, error) {
+	return r.getPriceList(ctx, " WHERE l.is_default = 1")
+}
+
+func (r *PriceListRepository) getPriceList(ctx context.Context, where string, args ...any) (*v1.PriceList, error) {
+	priceList, err := scanPriceList(r.db.QueryRowContext(ctx, selectPriceLists+where, args...))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Price list not found
+		}
+		return nil, err
+	}
+	return &priceList, nil
+}
+
+// CreatePriceList stores the price list and returns its ID. A new default
+// list takes over from the previous one.
+func (r *PriceListRepository) CreatePriceList(ctx context.Context, priceList v1.PriceList) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	if err := clearDefaultPriceList(ctx, tx, priceList); err != nil {
+		return 0, err
+	}
+	query := "INSERT INTO price_lists (name, description, is_default) VALUES (?, ?, COALESCE(?, 0))"
+	result, err := tx.ExecContext(ctx, query, priceList.Name, priceList.Description, priceList.IsDefault)
+	if err != nil {
+		return 0, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return int(id), tx.Commit()
+}
+
+// UpdatePriceList updates the price list. A new default list takes over from
+// the previous one.
+func (r *PriceListRepository) UpdatePriceList(ctx context.Context, priceList v1.PriceList) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := clearDefaultPriceList(ctx, tx, priceList); err != nil {
+		return err
+	}
+	query := "UPDATE price_lists SET name = ?, description = ?, is_default = COALESCE(?, 0) WHERE id = ?"
+	if _, err := tx.ExecContext(ctx, query, priceList.Name, priceList.Description, priceList.IsDefault, priceList.Id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func clearDefaultPriceList(ctx context.Context, tx *sql.Tx, priceList v1.PriceList) error {
+	if priceList.IsDefault == nil || !*priceList.IsDefault {
+		return nil
+	}
+	_, err := tx.ExecContext(ctx, "UPDATE price_lists SET is_default = 0 WHERE is_default = 1")
+	return err
+}
+
+// DeletePriceList deletes the price list together with its prices.
+func (r *PriceListRepository) DeletePriceList(ctx context.Context, id int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM price_list_prices WHERE price_list_id = ?", id); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM price_lists WHERE id = ?", id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// CountPriceListUsage returns the number of customers and customer groups
+// assigned the price list and of sales priced from it.
+func (r *PriceListRepository) CountPriceListUsage(ctx context.Context, id int) (int, int, int, error) {
+	var customers, groups, sales int
+	query := `SELECT (SELECT COUNT(*) FROM customers WHERE price_list_id = ?), (SELECT COUNT(*) FROM customer_groups WHERE price_list_id = ?),
+		(SELECT COUNT(*) FROM sales WHERE price_list_id = ?)`
+	if err := r.db.QueryRowContext(ctx, query, id, id, id).Scan(&customers, &groups, &sales); err != nil {
+		return 0, 0, 0, err
+	}
+	return customers, groups, sales, nil
+}
+
+// GetPrices returns the prices on the list by product, or those of a single
+// product, with the tiers of each product by minimum quantity.
+func (r *PriceListRepository) GetPrices(ctx context.Context, priceListID int, productID *int) ([]v1.PriceListPrice, error) {
+	prices := []v1.PriceListPrice{}
+
+	query := `SELECT t.product_id, p.name, p.variant_label, p.unit, t.min_quantity, t.price
+		FROM price_list_prices t JOIN products p ON p.id = t.product_id WHERE t.price_list_id = ?`
+	args := []any{priceListID}
+	if productID != nil {
+		query += " AND t.product_id = ?"
+		args = append(args, *productID)
+	}
+	rows, err := r.db.QueryContext(ctx, query+" ORDER BY p.name, p.id, t.min_quantity", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var price v1.PriceListPrice
+		var tier v1.PriceTier
+		if err := rows.Scan(&price.ProductId, &price.Name, &price.VariantLabel, &price.Unit, &tier.MinQuantity, &tier.Price); err != nil {
+			return nil, err
+		}
+		if n := len(prices); n > 0 && *prices[n-1].ProductId == *price.ProductId {
+			prices[n-1].Tiers = append(prices[n-1].Tiers, tier)
+			continue
+		}
+		price.Tiers = []v1.PriceTier{tier}
+		prices = append(prices, price)
+	}
+	return prices, rows.Err()
+}
+
+// SetPrices replaces the tiers of the product on the list.
+func (r *PriceListRepository) SetPrices(ctx context.Context, priceListID, productID int, tiers []v1.PriceTier) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := "DELETE FROM price_list_prices WHERE price_list_id = ? AND product_id = ?"
+	if _, err := tx.ExecContext(ctx, query, priceListID, productID); err != nil {
+		return err
+	}
+	for _, tier := range tiers {
+		query := "INSERT INTO price_list_prices (price_list_id, product_id, min_quantity, price) VALUES (?, ?, ?, ?)"
+		if _, err := tx.ExecContext(ctx, query, priceListID, productID, tier.MinQuantity, tier.Price); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// DeletePrices removes the product from the list and returns the number of
+// tiers removed.
+func (r *PriceListRepository) DeletePrices(ctx context.Context, priceListID, productID int) (int, error) {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM price_list_prices WHERE price_list_id = ? AND product_id = ?", priceListID, productID)
+	if err != nil {
+		return 0, err
+	}
+	n, err := result.RowsAffected()
+	return int(n), err
+}
+
+// GetTierPrice returns the price of the tier with the largest minimum
+// quantity that the quantity reaches, or nil when the list has no such tier
+// for the product.
+func (r *PriceListRepository) GetTierPrice(ctx context.Context, priceListID, productID int, quantity float64) (*float32, error) {
+	var price float32
+	query := `SELECT price FROM price_list_prices WHERE price_list_id = ? AND product_id = ? AND min_quantity <= ?
+		ORDER BY min_quantity DESC LIMIT 1`
+	err := r.db.QueryRowContext(ctx, query, priceListID, productID, quantity+1e-9).Scan(&price)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &price, nil
+}
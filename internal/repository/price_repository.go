@@ -0,0 +1,232 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/audit"
+)
+
+// PriceRepositoryInterface defines the methods for the price repository.
+type PriceRepositoryInterface interface {
+	GetPriceChanges(ctx context.Context, productID int) ([]v1.PriceChange, error)
+	GetPriceSchedules(ctx context.Context, productID int) ([]v1.PriceSchedule, error)
+	GetPriceScheduleByID(ctx context.Context, id int) (*v1.PriceSchedule, error)
+	CreatePriceSchedule(ctx context.Context, schedule v1.PriceSchedule, changes []v1.PriceChange) (v1.PriceSchedule, error)
+	EndPriceSchedule(ctx context.Context, id int, at time.Time, changes []v1.PriceChange) error
+	DeletePriceSchedule(ctx context.Context, id int) error
+}
+
+const selectPriceChanges = `SELECT id, product_id, field, old_value, new_value, effective_from, source, bulk_update_id, changed_by, changed_at
+	FROM product_price_changes`
+
+const selectPriceSchedules = "SELECT id, product_id, price, starts_at, ends_at, note, created_by, created_at FROM price_schedules"
+
+type PriceRepository struct {
+	db *sql.DB
+}
+
+func NewPriceRepository(db *sql.DB) *PriceRepository {
+	return &PriceRepository{
+		db: db,
+	}
+}
+
+func scanPriceChange(row interface{ Scan(dest ...any) error }) (v1.PriceChange, error) {
+	var change v1.PriceChange
+	err := row.Scan(&change.Id, &change.ProductId, &change.Field, &change.OldValue, &change.NewValue, &change.EffectiveFrom, &change.Source,
+		&change.BulkUpdateId, &change.ChangedBy, &change.ChangedAt)
+	return change, err
+}
+
+func scanPriceSchedule(row interface{ Scan(dest ...any) error }) (v1.PriceSchedule, error) {
+	var schedule v1.PriceSchedule
+	err := row.Scan(&schedule.Id, &schedule.ProductId, &schedule.Price, &schedule.StartsAt, &schedule.EndsAt, &schedule.Note,
+		&schedule.CreatedBy, &schedule.CreatedAt)
+	return schedule, err
+}
+
+// GetPriceChanges returns the recorded changes of the product, the latest to
+// take effect first.
+func (r *PriceRepository) GetPriceChanges(ctx context.Context, productID int) ([]v1.PriceChange, error) {
+	changes := []v1.PriceChange{}
+
+	rows, err := r.db.QueryContext(ctx, selectPriceChanges+" WHERE product_id = ? ORDER BY effective_from DESC, id DESC", productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		change, err := scanPriceChange(rows)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, rows.Err()
+}
+
+func (r *PriceRepository) GetPriceSchedules(ctx context.Context, productID int) ([]v1.PriceSchedule, error) {
+	schedules := []v1.PriceSchedule{}
+
+	rows, err := r.db.QueryContext(ctx, selectPriceSchedules+" WHERE product_id = ? ORDER BY starts_at, id", productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		schedule, err := scanPriceSchedule(rows)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules, rows.Err()
+}
+
+func (r *PriceRepository) GetPriceScheduleByID(ctx context.Context, id int) (*v1.PriceSchedule, error) {
+	schedule, err := scanPriceSchedule(r.db.QueryRowContext(ctx, selectPriceSchedules+" WHERE id = ?", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Price schedule not found
+		}
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// CreatePriceSchedule stores the schedule together with the price changes it
+// makes in one transaction.
+func (r *PriceRepository) CreatePriceSchedule(ctx context.Context, schedule v1.PriceSchedule, changes []v1.PriceChange) (v1.PriceSchedule, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return v1.PriceSchedule{}, err
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	schedule.StartsAt = schedule.StartsAt.UTC()
+	if schedule.EndsAt != nil {
+		endsAt := schedule.EndsAt.UTC()
+		schedule.EndsAt = &endsAt
+	}
+	schedule.CreatedBy, schedule.CreatedAt = audit.User(ctx), &now
+
+	query := "INSERT INTO price_schedules (product_id, price, starts_at, ends_at, note, created_by, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)"
+	result, err := tx.ExecContext(ctx, query, schedule.ProductId, schedule.Price, schedule.StartsAt, schedule.EndsAt, schedule.Note,
+		schedule.CreatedBy, now)
+	if err != nil {
+		return v1.PriceSchedule{}, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return v1.PriceSchedule{}, err
+	}
+	scheduleID := int(id)
+	schedule.Id = &scheduleID
+
+	if err := insertPriceChanges(ctx, tx, changes, nil, &scheduleID); err != nil {
+		return v1.PriceSchedule{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return v1.PriceSchedule{}, err
+	}
+	return schedule, nil
+}
+
+// EndPriceSchedule moves the end of a schedule that is in effect to the given
+// instant, replacing the change back to the regular price it recorded.
+func (r *PriceRepository) EndPriceSchedule(ctx context.Context, id int, at time.Time, changes []v1.PriceChange) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := endPriceSchedule(ctx, tx, id, at.UTC()); err != nil {
+		return err
+	}
+	if err := insertPriceChanges(ctx, tx, changes, nil, &id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// DeletePriceSchedule removes a schedule that has not started together with
+// the price changes it would have made.
+func (r *PriceRepository) DeletePriceSchedule(ctx context.Context, id int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM product_price_changes WHERE price_schedule_id = ?", id); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM price_schedules WHERE id = ?", id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// endPriceSchedule ends the schedule at the given instant and drops the
+// changes it would have made after it.
+func endPriceSchedule(ctx context.Context, tx *sql.Tx, id int, at time.Time) error {
+	if _, err := tx.ExecContext(ctx, "UPDATE price_schedules SET ends_at = ? WHERE id = ?", at, id); err != nil {
+		return err
+	}
+	_, err := tx.ExecContext(ctx, "DELETE FROM product_price_changes WHERE price_schedule_id = ? AND effective_from > ?", id, at)
+	return err
+}
+
+// insertPriceChanges records the changes against the user of the request,
+// with the scheduled tax rate or price they belong to, if any, and the bulk
+// update each change carries.
+func insertPriceChanges(ctx context.Context, tx *sql.Tx, changes []v1.PriceChange, taxRateID, priceScheduleID *int) error {
+	query := `INSERT INTO product_price_changes (product_id, field, old_value, new_value, effective_from, source, tax_rate_id, price_schedule_id,
+		bulk_update_id, changed_by, changed_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	now := time.Now().UTC()
+	for _, change := range changes {
+		_, err := tx.ExecContext(ctx, query, change.ProductId, change.Field, change.OldValue, change.NewValue, change.EffectiveFrom.UTC(),
+			change.Source, taxRateID, priceScheduleID, change.BulkUpdateId, audit.User(ctx), now)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PriceChanges returns the changes of the price and tax rates from before to
+// after, effective from the given instant. Fields that after leaves unset
+// are not compared.
+func PriceChanges(before, after v1.Product, source v1.PriceChangeSource, effectiveFrom time.Time) []v1.PriceChange {
+	fields := []struct {
+		field         v1.PriceChangeField
+		value, update *float32
+	}{
+		{v1.PriceChangeFieldPrice, before.Price, after.Price},
+		{v1.PriceChangeFieldCgstRate, before.CgstRate, after.CgstRate},
+		{v1.PriceChangeFieldSgstRate, before.SgstRate, after.SgstRate},
+	}
+
+	var changes []v1.PriceChange
+	for _, f := range fields {
+		if f.update == nil || (f.value != nil && *f.value == *f.update) {
+			continue
+		}
+		changes = append(changes, v1.PriceChange{
+			ProductId:     before.Id,
+			Field:         &f.field,
+			OldValue:      f.value,
+			NewValue:      f.update,
+			EffectiveFrom: &effectiveFrom,
+			Source:        &source,
+		})
+	}
+	return changes
+}
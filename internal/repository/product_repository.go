@@ -3,8 +3,14 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
 
 	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/audit"
 )
 
 // ProductRepositoryInterface defines the methods for the product repository.
@@ -12,9 +18,57 @@ type ProductRepositoryInterface interface {
 	GetProductByName(ctx context.Context, name string) (*v1.Product, error)
 	GetAllProducts(ctx context.Context) ([]v1.Product, error)
 	GetProductByID(ctx context.Context, id int) (*v1.Product, error)
+	GetProductAt(ctx context.Context, id int, at time.Time) (*v1.Product, error)
+	GetProductsByHSNAt(ctx context.Context, hsnCode string, at time.Time) ([]v1.Product, error)
+	GetProductsInCategory(ctx context.Context, categoryID int) ([]v1.Product, error)
+	GetProductsInCategoryAt(ctx context.Context, categoryID int, at time.Time) ([]v1.Product, error)
+	GetVariants(ctx context.Context, parentID int) ([]v1.Product, error)
+	GetBundlesWithComponent(ctx context.Context, productID int) ([]v1.Product, error)
+	ExportProducts(ctx context.Context, categoryID *int, updatedSince *time.Time, fn func(v1.Product) error) error
+	GetProductBySKU(ctx context.Context, sku string) (*v1.Product, error)
+	SearchProducts(ctx context.Context, match string, limit, offset int) ([]v1.ProductSearchResult, int, error)
+	GetSearchTerms(ctx context.Context, minLength, maxLength int) ([]string, error)
+	GetProductByBarcode(ctx context.Context, barcodes []string) (*v1.Product, error)
 	CreateProduct(ctx context.Context, product v1.Product) error
-	UpdateProduct(ctx context.Context, product v1.Product) error
-	DeleteProduct(ctx context.Context, id int) error
+	CreateVariants(ctx context.Context, parentID int, options []v1.VariantOption, variants []v1.Product) error
+	UpdateProducts(ctx context.Context, products []v1.Product, rates []v1.TaxRate) error
+	ImportProducts(ctx context.Context, created, updated []v1.Product, rates []v1.TaxRate) error
+	BulkUpdateProducts(ctx context.Context, update v1.ProductBulkUpdate, products []v1.Product, rates []v1.TaxRate) (int, error)
+	GetBulkUpdates(ctx context.Context) ([]v1.ProductBulkUpdate, error)
+	GetBulkUpdateByID(ctx context.Context, id int) (*v1.ProductBulkUpdate, error)
+	AddBarcode(ctx context.Context, productID int, barcode string) error
+	SetActive(ctx context.Context, ids []int, active bool) error
+	DeleteProduct(ctx context.Context, id int) ([]string, error)
+}
+
+// selectProducts reads products with the price and CGST/SGST rates effective
+// at the bound instant. Rates come from the latest product_tax_rates entry
+// that has taken effect, falling back to the rates stored on the product
+// itself; the price comes from the price schedule in effect, falling back to
+// the regular price. Bind the instant with instant(). Barcodes are read as a
+// comma-separated list in the order they were added, and images and bundle
+// components as JSON arrays in the order they were added. Variant options and
+// option values are stored as JSON.
+const selectProducts = `SELECT p.id, p.name, COALESCE(s.price, p.price), p.price, s.id, p.description, p.hsn_code, p.sku, p.stock_on_hand, p.category_id,
+	p.parent_id, p.variant_label, p.option_values, p.variant_options, p.unit, p.purchase_unit, p.purchase_unit_factor, p.updated_at, p.active,
+	p.batch_tracked, p.cost_price, p.reorder_level, p.reorder_quantity, p.supplier_id, COALESCE((SELECT r.cgst_rate FROM product_tax_rates r WHERE r.product_id = p.id AND r.effective_from <= ? ORDER BY r.effective_from DESC, r.id DESC LIMIT 1), p.cgst_rate),
+	COALESCE((SELECT r.sgst_rate FROM product_tax_rates r WHERE r.product_id = p.id AND r.effective_from <= ? ORDER BY r.effective_from DESC, r.id DESC LIMIT 1), p.sgst_rate),
+	(SELECT GROUP_CONCAT(barcode) FROM (SELECT b.barcode FROM product_barcodes b WHERE b.product_id = p.id ORDER BY b.id)),
+	(SELECT json_group_array(json_object('id', i.id, 'productId', i.product_id, 'url', i.url, 'thumbnailUrl', i.thumbnail_url,
+		'contentType', i.content_type, 'size', i.size, 'width', i.width, 'height', i.height, 'createdAt', i.created_at))
+		FROM (SELECT * FROM product_images i WHERE i.product_id = p.id ORDER BY i.id) i),
+	(SELECT json_group_array(json_object('productId', c.product_id, 'quantity', c.quantity, 'name', c.name, 'variantLabel', c.variant_label,
+		'unit', c.unit, 'stockOnHand', c.stock_on_hand))
+		FROM (SELECT bc.product_id, bc.quantity, cp.name, cp.variant_label, cp.unit, cp.stock_on_hand FROM bundle_components bc
+			JOIN products cp ON cp.id = bc.product_id WHERE bc.bundle_id = p.id ORDER BY bc.id) c)
+	FROM products p
+	LEFT JOIN price_schedules s ON s.id = (SELECT ps.id FROM price_schedules ps WHERE ps.product_id = p.id AND ps.starts_at <= ?
+		AND (ps.ends_at IS NULL OR ps.ends_at > ?) ORDER BY ps.starts_at DESC, ps.id DESC LIMIT 1)`
+
+// instant returns the arguments binding the instant of selectProducts.
+func instant(at time.Time, args ...any) []any {
+	at = at.UTC()
+	return append([]any{at, at, at, at}, args...)
 }
 
 type ProductRepository struct {
@@ -27,19 +81,74 @@ func NewProductRepository(db *sql.DB) *ProductRepository {
 	}
 }
 
-func (r *ProductRepository) GetAllProducts(ctx context.Context) ([]v1.Product, error) {
+func scanProduct(row interface{ Scan(dest ...any) error }) (v1.Product, error) {
+	var product v1.Product
+	var barcodes, images, components, optionValues, variantOptions sql.NullString
+	err := row.Scan(&product.Id, &product.Name, &product.Price, &product.RegularPrice, &product.PriceScheduleId, &product.Description, &product.HsnCode, &product.Sku, &product.StockOnHand, &product.CategoryId,
+		&product.ParentId, &product.VariantLabel, &optionValues, &variantOptions, &product.Unit, &product.PurchaseUnit, &product.PurchaseUnitFactor, &product.UpdatedAt, &product.Active, &product.BatchTracked, &product.CostPrice, &product.ReorderLevel, &product.ReorderQuantity, &product.SupplierId, &product.CgstRate, &product.SgstRate, &barcodes, &images, &components)
+	if err != nil {
+		return product, err
+	}
+	if optionValues.Valid {
+		if err := json.Unmarshal([]byte(optionValues.String), &product.OptionValues); err != nil {
+			return product, err
+		}
+	}
+	if variantOptions.Valid {
+		if err := json.Unmarshal([]byte(variantOptions.String), &product.VariantOptions); err != nil {
+			return product, err
+		}
+	}
+	list := []string{}
+	if barcodes.Valid {
+		list = strings.Split(barcodes.String, ",")
+	}
+	product.Barcodes = &list
+	product.Images = &[]v1.ProductImage{}
+	if images.Valid {
+		if err := json.Unmarshal([]byte(images.String), product.Images); err != nil {
+			return product, err
+		}
+	}
+	product.BundleComponents = &[]v1.BundleComponent{}
+	if components.Valid {
+		if err := json.Unmarshal([]byte(components.String), product.BundleComponents); err != nil {
+			return product, err
+		}
+	}
+	if len(*product.BundleComponents) > 0 {
+		stock := bundleStock(*product.BundleComponents)
+		product.StockOnHand = &stock
+	}
+	return product, nil
+}
+
+// bundleStock returns the number of whole bundles the stock of the
+// components makes up. A bundle keeps no stock of its own.
+func bundleStock(components []v1.BundleComponent) float64 {
+	stock := math.Inf(1)
+	for _, component := range components {
+		onHand := 0.0
+		if component.StockOnHand != nil {
+			onHand = *component.StockOnHand
+		}
+		stock = math.Min(stock, math.Floor(onHand/component.Quantity+1e-9))
+	}
+	return math.Max(stock, 0)
+}
+
+func (r *ProductRepository) queryProducts(ctx context.Context, at time.Time, where string, args ...any) ([]v1.Product, error) {
 	var products []v1.Product
 
-	query := "SELECT id, name, price, description, cgst_rate, sgst_rate FROM products"
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := r.db.QueryContext(ctx, selectProducts+where, instant(at, args...)...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		var product v1.Product
-		if err := rows.Scan(&product.Id, &product.Name, &product.Price, &product.Description, &product.CgstRate, &product.SgstRate); err != nil {
+		product, err := scanProduct(rows)
+		if err != nil {
 			return nil, err
 		}
 		products = append(products, product)
@@ -52,11 +161,177 @@ func (r *ProductRepository) GetAllProducts(ctx context.Context) ([]v1.Product, e
 	return products, nil
 }
 
-func (r *ProductRepository) GetProductByName(ctx context.Context, name string) (*v1.Product, error) {
-	var product v1.Product
+func (r *ProductRepository) GetAllProducts(ctx context.Context) ([]v1.Product, error) {
+	return r.queryProducts(ctx, time.Now(), "")
+}
+
+func (r *ProductRepository) GetProductsByHSNAt(ctx context.Context, hsnCode string, at time.Time) ([]v1.Product, error) {
+	return r.queryProducts(ctx, at, " WHERE p.hsn_code = ?", hsnCode)
+}
+
+// GetProductsInCategory returns the products in the category or any of its
+// descendants.
+func (r *ProductRepository) GetProductsInCategory(ctx context.Context, categoryID int) ([]v1.Product, error) {
+	return r.GetProductsInCategoryAt(ctx, categoryID, time.Now())
+}
+
+// GetProductsInCategoryAt returns the products in the category or any of its
+// descendants, with the prices and rates they have at the given instant.
+func (r *ProductRepository) GetProductsInCategoryAt(ctx context.Context, categoryID int, at time.Time) ([]v1.Product, error) {
+	return r.queryProducts(ctx, at, " WHERE p.category_id IN "+categoryTree, categoryID)
+}
+
+// exportPageSize is the number of products ExportProducts reads at a time.
+const exportPageSize = 500
+
+// ExportProducts calls fn with each product in ID order. The products are
+// read a page at a time and fn is only called once the page's rows are
+// closed, so that a slow consumer does not hold the database connection. The
+// filters are optional.
+func (r *ProductRepository) ExportProducts(ctx context.Context, categoryID *int, updatedSince *time.Time, fn func(v1.Product) error) error {
+	conditions := []string{"p.id > ?"}
+	var args []any
+	if categoryID != nil {
+		conditions = append(conditions, "p.category_id IN "+categoryTree)
+		args = append(args, *categoryID)
+	}
+	if updatedSince != nil {
+		conditions = append(conditions, "p.updated_at >= ?")
+		args = append(args, updatedSince.UTC())
+	}
+	where := " WHERE " + strings.Join(conditions, " AND ") + " ORDER BY p.id LIMIT ?"
+
+	// Every page is priced at the same instant.
+	now := time.Now()
+	lastID := 0
+	for {
+		products, err := r.queryProducts(ctx, now, where, append(append([]any{lastID}, args...), exportPageSize)...)
+		if err != nil {
+			return err
+		}
+		for _, product := range products {
+			if err := fn(product); err != nil {
+				return err
+			}
+		}
+		if len(products) < exportPageSize {
+			return nil
+		}
+		lastID = *products[len(products)-1].Id
+	}
+}
+
+// GetVariants returns the variants generated from the parent product in the
+// order they were created.
+func (r *ProductRepository) GetVariants(ctx context.Context, parentID int) ([]v1.Product, error) {
+	return r.queryProducts(ctx, time.Now(), " WHERE p.parent_id = ? ORDER BY p.id", parentID)
+}
+
+// GetBundlesWithComponent returns the bundles the product is a component of.
+func (r *ProductRepository) GetBundlesWithComponent(ctx context.Context, productID int) ([]v1.Product, error) {
+	return r.queryProducts(ctx, time.Now(), " WHERE p.id IN (SELECT bundle_id FROM bundle_components WHERE product_id = ?) ORDER BY p.id", productID)
+}
+
+// SearchProducts runs an FTS5 match expression against the search index and
+// returns one page of products, best match first, with the matching words
+// marked, together with the total number of matches. Name and SKU matches
+// weigh most; phonetic matches least.
+func (r *ProductRepository) SearchProducts(ctx context.Context, match string, limit, offset int) ([]v1.ProductSearchResult, int, error) {
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM products_fts WHERE products_fts MATCH ?", match).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT rowid, highlight(products_fts, 0, '<mark>', '</mark>'), snippet(products_fts, 1, '<mark>', '</mark>', '…', 12),
+		highlight(products_fts, 2, '<mark>', '</mark>')
+		FROM products_fts WHERE products_fts MATCH ? ORDER BY bm25(products_fts, 10.0, 2.0, 8.0, 8.0, 1.0), rowid LIMIT ? OFFSET ?`
+	rows, err := r.db.QueryContext(ctx, query, match, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	var ids []int
+	var highlights []v1.SearchHighlight
+	for rows.Next() {
+		var id int
+		var highlight v1.SearchHighlight
+		if err := rows.Scan(&id, &highlight.Name, &highlight.Description, &highlight.Sku); err != nil {
+			rows.Close()
+			return nil, 0, err
+		}
+		ids = append(ids, id)
+		highlights = append(highlights, highlight)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	// Products are read once the index rows are closed, as the pool has a
+	// single connection.
+	results := []v1.ProductSearchResult{}
+	for i, id := range ids {
+		product, err := r.GetProductByID(ctx, id)
+		if err != nil {
+			return nil, 0, err
+		}
+		if product == nil {
+			continue
+		}
+		results = append(results, v1.ProductSearchResult{Product: product, Highlight: &highlights[i]})
+	}
+	return results, total, nil
+}
+
+// GetSearchTerms returns the distinct terms in the search index whose length
+// is within the bounds.
+func (r *ProductRepository) GetSearchTerms(ctx context.Context, minLength, maxLength int) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT term FROM products_fts_vocab WHERE length(term) BETWEEN ? AND ?", minLength, maxLength)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
-	query := "SELECT id, name, price, description, cgst_rate, sgst_rate FROM products WHERE name = ?"
-	err := r.db.QueryRowContext(ctx, query, name).Scan(&product.Id, &product.Name, &product.Price, &product.Description, &product.CgstRate, &product.SgstRate)
+	var terms []string
+	for rows.Next() {
+		var term string
+		if err := rows.Scan(&term); err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+	}
+	return terms, rows.Err()
+}
+
+func (r *ProductRepository) GetProductBySKU(ctx context.Context, sku string) (*v1.Product, error) {
+	return r.getProduct(ctx, " WHERE p.sku = ?", sku)
+}
+
+// GetProductByBarcode returns the product carrying any of the given
+// barcodes.
+func (r *ProductRepository) GetProductByBarcode(ctx context.Context, barcodes []string) (*v1.Product, error) {
+	where := " WHERE p.id = (SELECT product_id FROM product_barcodes WHERE barcode IN (?" + strings.Repeat(", ?", len(barcodes)-1) + ") LIMIT 1)"
+	args := make([]any, len(barcodes))
+	for i, barcode := range barcodes {
+		args[i] = barcode
+	}
+	return r.getProduct(ctx, where, args...)
+}
+
+// getProduct returns the first product matching the filter with the price
+// and tax rates effective now.
+func (r *ProductRepository) getProduct(ctx context.Context, where string, args ...any) (*v1.Product, error) {
+	product, err := scanProduct(r.db.QueryRowContext(ctx, selectProducts+where, instant(time.Now(), args...)...))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Product not found
+		}
+		return nil, err
+	}
+	return &product, nil
+}
+
+func (r *ProductRepository) GetProductByName(ctx context.Context, name string) (*v1.Product, error) {
+	product, err := scanProduct(r.db.QueryRowContext(ctx, selectProducts+" WHERE p.name = ?", instant(time.Now(), name)...))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil // Product not found
@@ -68,43 +343,498 @@ func (r *ProductRepository) GetProductByName(ctx context.Context, name string) (
 }
 
 func (r *ProductRepository) GetProductByID(ctx context.Context, id int) (*v1.Product, error) {
-	var product v1.Product
+	return r.GetProductAt(ctx, id, time.Now())
+}
 
-	query := "SELECT id, name, price, description, cgst_rate, sgst_rate FROM products WHERE id = ?"
-	err := r.db.QueryRowContext(ctx, query, id).Scan(&product.Id, &product.Name, &product.Price, &product.Description, &product.CgstRate, &product.SgstRate)
+// GetProductAt returns the product with the price and tax rates that were
+// effective at the given instant.
+func (r *ProductRepository) GetProductAt(ctx context.Context, id int, at time.Time) (*v1.Product, error) {
+	product, err := scanProduct(r.db.QueryRowContext(ctx, selectProducts+" WHERE p.id = ?", instant(at, id)...))
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return &product, nil // Product not found
+			return nil, nil // Product not found
 		}
-		return &product, err // Other error
+		return nil, err // Other error
 	}
 
 	return &product, nil
 }
 
 func (r *ProductRepository) CreateProduct(ctx context.Context, product v1.Product) error {
-	query := "INSERT INTO products (name, description, price, cgst_rate, sgst_rate) VALUES (?, ?, ?, ?, ?)"
-	_, err := r.db.ExecContext(ctx, query, product.Name, product.Description, product.Price, product.CgstRate, product.SgstRate)
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := insertProduct(ctx, tx, product); err != nil {
 		return err // Return error if insertion fails
 	}
-	return nil // Return nil if insertion is successful
+	return tx.Commit()
+}
+
+// CreateVariants stores the options of the parent product and inserts the
+// new variants in one transaction.
+func (r *ProductRepository) CreateVariants(ctx context.Context, parentID int, options []v1.VariantOption, variants []v1.Product) error {
+	encoded, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := "UPDATE products SET variant_options = ?, updated_at = ? WHERE id = ?"
+	if _, err := tx.ExecContext(ctx, query, string(encoded), time.Now().UTC(), parentID); err != nil {
+		return err
+	}
+	for _, variant := range variants {
+		variant.ParentId = &parentID
+		if err := insertProduct(ctx, tx, variant); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// insertProduct inserts the product, with the variant columns when it has a
+// parent, its barcodes and its bundle components.
+func insertProduct(ctx context.Context, tx *sql.Tx, product v1.Product) error {
+	var optionValues any
+	if product.OptionValues != nil {
+		encoded, err := json.Marshal(product.OptionValues)
+		if err != nil {
+			return err
+		}
+		optionValues = string(encoded)
+	}
+
+	query := `INSERT INTO products (name, description, price, cgst_rate, sgst_rate, hsn_code, sku, category_id, parent_id, variant_label, option_values,
+		unit, purchase_unit, purchase_unit_factor, batch_tracked, reorder_level, reorder_quantity, supplier_id, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, COALESCE(?, 0), ?, ?, ?, ?)`
+	result, err := tx.ExecContext(ctx, query, product.Name, product.Description, product.Price, product.CgstRate, product.SgstRate, product.HsnCode,
+		product.Sku, product.CategoryId, product.ParentId, product.VariantLabel, optionValues, product.Unit, product.PurchaseUnit, product.PurchaseUnitFactor,
+		product.BatchTracked, product.ReorderLevel, product.ReorderQuantity, product.SupplierId, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	if err := insertBarcodes(ctx, tx, int(id), product.Barcodes); err != nil {
+		return err
+	}
+	return insertBundleComponents(ctx, tx, int(id), product.BundleComponents)
+}
+
+// UpdateProducts replaces the fields, barcodes and bundle components of the
+// products, recording the given tax rate changes, in one transaction, so
+// that a product and the updates it carries over to its variants change
+// together.
+func (r *ProductRepository) UpdateProducts(ctx context.Context, products []v1.Product, rates []v1.TaxRate) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, product := range products {
+		if err := updateProduct(ctx, tx, product, nil); err != nil {
+			return err // Return error if update fails
+		}
+	}
+	for _, rate := range rates {
+		if _, err := insertTaxRate(ctx, tx, rate); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// ImportProducts inserts and updates the products of an import, recording
+// the given tax rate changes, in one transaction.
+func (r *ProductRepository) ImportProducts(ctx context.Context, created, updated []v1.Product, rates []v1.TaxRate) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, product := range created {
+		if err := insertProduct(ctx, tx, product); err != nil {
+			return err
+		}
+	}
+	for _, product := range updated {
+		if err := updateProduct(ctx, tx, product, nil); err != nil {
+			return err
+		}
+	}
+	for _, rate := range rates {
+		query := "INSERT INTO product_tax_rates (product_id, cgst_rate, sgst_rate, effective_from) VALUES (?, ?, ?, ?)"
+		if _, err := tx.ExecContext(ctx, query, rate.ProductId, rate.CgstRate, rate.SgstRate, rate.EffectiveFrom.UTC()); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// BulkUpdateProducts records the bulk update and updates its products, with
+// the given tax rate changes, in one transaction, and returns the ID of the
+// bulk update. The products are complete, as UpdateProducts expects them.
+func (r *ProductRepository) BulkUpdateProducts(ctx context.Context, update v1.ProductBulkUpdate, products []v1.Product,
+	rates []v1.TaxRate) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var productIDs, filter *string
+	if update.ProductIds != nil {
+		if productIDs, err = jsonText(*update.ProductIds); err != nil {
+			return 0, err
+		}
+	}
+	if update.Filter != nil {
+		if filter, err = jsonText(*update.Filter); err != nil {
+			return 0, err
+		}
+	}
+	changes, err := jsonText(update.Changes)
+	if err != nil {
+		return 0, err
+	}
+	items, err := jsonText(update.Products)
+	if err != nil {
+		return 0, err
+	}
+	query := `INSERT INTO product_bulk_updates (product_ids, filter, changes, note, items, changed_by, changed_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`
+	result, err := tx.ExecContext(ctx, query, productIDs, filter, changes, update.Note, items, audit.User(ctx), time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	bulkUpdateID := int(lastID)
+
+	for _, product := range products {
+		if err := updateProduct(ctx, tx, product, &bulkUpdateID); err != nil {
+			return 0, err
+		}
+	}
+	for _, rate := range rates {
+		if _, err := insertTaxRate(ctx, tx, rate); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return bulkUpdateID, nil
+}
+
+// jsonText encodes v as JSON text to store in a column.
+func jsonText(v any) (*string, error) {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	text := string(encoded)
+	return &text, nil
+}
+
+const selectBulkUpdates = "SELECT id, product_ids, filter, changes, note, items, changed_by, changed_at FROM product_bulk_updates"
+
+// scanBulkUpdate reads a bulk update, decoding the columns stored as JSON.
+func scanBulkUpdate(row interface{ Scan(dest ...any) error }) (v1.ProductBulkUpdate, error) {
+	var update v1.ProductBulkUpdate
+	var productIDs, filter sql.NullString
+	var changes, items string
+	if err := row.Scan(&update.Id, &productIDs, &filter, &changes, &update.Note, &items, &update.ChangedBy, &update.ChangedAt); err != nil {
+		return update, err
+	}
+	if productIDs.Valid {
+		if err := json.Unmarshal([]byte(productIDs.String), &update.ProductIds); err != nil {
+			return update, err
+		}
+	}
+	if filter.Valid {
+		if err := json.Unmarshal([]byte(filter.String), &update.Filter); err != nil {
+			return update, err
+		}
+	}
+	if err := json.Unmarshal([]byte(changes), &update.Changes); err != nil {
+		return update, err
+	}
+	if err := json.Unmarshal([]byte(items), &update.Products); err != nil {
+		return update, err
+	}
+	if update.Products == nil {
+		update.Products = &[]v1.ProductBulkUpdateItem{}
+	}
+	updated := len(*update.Products)
+	update.Updated = &updated
+	return update, nil
+}
+
+// GetBulkUpdates returns the bulk updates, oldest first, with the products
+// each one changed.
+func (r *ProductRepository) GetBulkUpdates(ctx context.Context) ([]v1.ProductBulkUpdate, error) {
+	updates := []v1.ProductBulkUpdate{}
+
+	rows, err := r.db.QueryContext(ctx, selectBulkUpdates+" ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		update, err := scanBulkUpdate(rows)
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, update)
+	}
+	return updates, rows.Err()
+}
+
+func (r *ProductRepository) GetBulkUpdateByID(ctx context.Context, id int) (*v1.ProductBulkUpdate, error) {
+	update, err := scanBulkUpdate(r.db.QueryRowContext(ctx, selectBulkUpdates+" WHERE id = ?", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Bulk update not found
+		}
+		return nil, err
+	}
+	return &update, nil
+}
+
+// updateProduct updates the product and records the changes to its price
+// and tax rates, against the bulk update when it is made in one. The price
+// is the regular price; changing it ends a price schedule that is in effect.
+func updateProduct(ctx context.Context, tx *sql.Tx, product v1.Product, bulkUpdateID *int) error {
+	now := time.Now().UTC()
+	before, err := scanProduct(tx.QueryRowContext(ctx, selectProducts+" WHERE p.id = ?", instant(now, product.Id)...))
+	if err != nil {
+		return err
+	}
+	after := product
+	if before.RegularPrice != nil && product.Price != nil && *before.RegularPrice == *product.Price {
+		after.Price = nil
+	} else if before.PriceScheduleId != nil {
+		if err := endPriceSchedule(ctx, tx, *before.PriceScheduleId, now); err != nil {
+			return err
+		}
+	}
+	source := v1.PriceChangeSourceEdit
+	if bulkUpdateID != nil {
+		source = v1.PriceChangeSourceBulkUpdate
+	}
+	changes := PriceChanges(before, after, source, now)
+	for i := range changes {
+		changes[i].BulkUpdateId = bulkUpdateID
+	}
+	if err := insertPriceChanges(ctx, tx, changes, nil, nil); err != nil {
+		return err
+	}
+
+	query := `UPDATE products SET name = ?, price = ?, description = ?, sgst_rate = ?, cgst_rate = ?, hsn_code = ?, sku = ?, category_id = ?,
+		unit = ?, purchase_unit = ?, purchase_unit_factor = ?, batch_tracked = COALESCE(?, 0), reorder_level = ?, reorder_quantity = ?, supplier_id = ?,
+		updated_at = ? WHERE id = ?`
+	_, err = tx.ExecContext(ctx, query, product.Name, product.Price, product.Description, product.SgstRate, product.CgstRate, product.HsnCode,
+		product.Sku, product.CategoryId, product.Unit, product.PurchaseUnit, product.PurchaseUnitFactor, product.BatchTracked, product.ReorderLevel,
+		product.ReorderQuantity, product.SupplierId, now, product.Id)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM product_barcodes WHERE product_id = ?", product.Id); err != nil {
+		return err
+	}
+	if err := insertBarcodes(ctx, tx, *product.Id, product.Barcodes); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM bundle_components WHERE bundle_id = ?", product.Id); err != nil {
+		return err
+	}
+	return insertBundleComponents(ctx, tx, *product.Id, product.BundleComponents)
 }
 
-func (r *ProductRepository) UpdateProduct(ctx context.Context, product v1.Product) error {
-	query := "UPDATE products SET name = ?, price = ?, description = ?, sgst_rate = ?, cgst_rate = ? WHERE id = ?"
-	_, err := r.db.ExecContext(ctx, query, product.Name, product.Price, product.Description, product.SgstRate, product.CgstRate, product.Id)
+// SetActive archives or unarchives the products in one transaction.
+func (r *ProductRepository) SetActive(ctx context.Context, ids []int, active bool) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return err // Return error if update fails
+		return err
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	for _, id := range ids {
+		if _, err := tx.ExecContext(ctx, "UPDATE products SET active = ?, updated_at = ? WHERE id = ?", active, now, id); err != nil {
+			return err
+		}
 	}
-	return nil // Return nil if update is successful
+	return tx.Commit()
 }
 
-func (r *ProductRepository) DeleteProduct(ctx context.Context, id int) error {
-	query := "DELETE FROM products WHERE id = ?"
-	_, err := r.db.ExecContext(ctx, query, id)
+func (r *ProductRepository) AddBarcode(ctx context.Context, productID int, barcode string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return err // Return error if deletion fails
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "INSERT INTO product_barcodes (product_id, barcode) VALUES (?, ?)", productID, barcode); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE products SET updated_at = ? WHERE id = ?", time.Now().UTC(), productID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func insertBarcodes(ctx context.Context, tx *sql.Tx, productID int, barcodes *[]string) error {
+	if barcodes == nil {
+		return nil
+	}
+	for _, barcode := range *barcodes {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO product_barcodes (product_id, barcode) VALUES (?, ?)", productID, barcode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertBundleComponents(ctx context.Context, tx *sql.Tx, bundleID int, components *[]v1.BundleComponent) error {
+	if components == nil {
+		return nil
+	}
+	for _, component := range *components {
+		query := "INSERT INTO bundle_components (bundle_id, product_id, quantity) VALUES (?, ?, ?)"
+		if _, err := tx.ExecContext(ctx, query, bundleID, component.ProductId, component.Quantity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteProduct removes a product together with its variants and, for each
+// of them, its tax rates, price schedules and history, price list prices,
+// barcodes, images and bundle components, in a single transaction. It
+// refuses, with the error of ensureDeletable, when any of them is still
+// referred to. It returns the keys of the files of the deleted images, which
+// are left for the caller to remove once the products are gone.
+func (r *ProductRepository) DeleteProduct(ctx context.Context, id int) ([]string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	ids := []int{}
+	rows, err := tx.QueryContext(ctx, "SELECT id FROM products WHERE parent_id = ? ORDER BY id", id)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var variantID int
+		if err := rows.Scan(&variantID); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		ids = append(ids, variantID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	ids = append(ids, id)
+
+	for _, productID := range ids {
+		if err := ensureDeletable(ctx, tx, productID); err != nil {
+			return nil, err
+		}
+	}
+
+	var keys []string
+	for _, productID := range ids {
+		rows, err := tx.QueryContext(ctx, "SELECT image_key, thumbnail_key FROM product_images WHERE product_id = ?", productID)
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			var imageKey, thumbnailKey string
+			if err := rows.Scan(&imageKey, &thumbnailKey); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			keys = append(keys, imageKey, thumbnailKey)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+
+		for _, query := range []string{
+			"DELETE FROM product_price_changes WHERE product_id = ?",
+			"DELETE FROM price_schedules WHERE product_id = ?",
+			"DELETE FROM product_tax_rates WHERE product_id = ?",
+			"DELETE FROM price_list_prices WHERE product_id = ?",
+			"DELETE FROM product_barcodes WHERE product_id = ?",
+			"DELETE FROM product_images WHERE product_id = ?",
+			"DELETE FROM bundle_components WHERE bundle_id = ?",
+			"DELETE FROM products WHERE id = ?",
+		} {
+			if _, err := tx.ExecContext(ctx, query, productID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// ensureDeletable fails when the product is still referred to by records
+// that have to keep it: bundles it is a component of, sale and purchase
+// order lines, the stock ledger, stocktakes and transfers. It reads within
+// the caller's transaction, so that nothing starts referring to the product
+// before it is gone.
+func ensureDeletable(ctx context.Context, tx *sql.Tx, id int) error {
+	var bundleID sql.NullInt64
+	var sold, ordered, moved, counted, transferred int
+	query := `SELECT (SELECT MIN(bundle_id) FROM bundle_components WHERE product_id = ?),
+		(SELECT COUNT(*) FROM sale_items WHERE product_id = ?) + (SELECT COUNT(*) FROM sale_bundles WHERE product_id = ?),
+		(SELECT COUNT(*) FROM purchase_order_items WHERE product_id = ?),
+		(SELECT COUNT(*) FROM stock_movements WHERE product_id = ?),
+		(SELECT COUNT(*) FROM stocktake_items WHERE product_id = ?),
+		(SELECT COUNT(*) FROM transfer_items WHERE product_id = ?)`
+	if err := tx.QueryRowContext(ctx, query, id, id, id, id, id, id, id).Scan(&bundleID, &sold, &ordered, &moved, &counted,
+		&transferred); err != nil {
+		return err
+	}
+	switch {
+	case bundleID.Valid:
+		return fmt.Errorf("%w: product %d is a component of bundle %d and cannot be deleted", ErrProductInUse, id, bundleID.Int64)
+	case sold > 0:
+		return fmt.Errorf("%w: product %d is on %d sale lines, archive it instead", ErrProductSold, id, sold)
+	case ordered > 0:
+		return fmt.Errorf("%w: product %d is on %d purchase order lines, archive it instead", ErrProductPurchased, id, ordered)
+	case moved > 0:
+		return fmt.Errorf("%w: product %d has %d stock movements, archive it instead", ErrProductStocked, id, moved)
+	case counted > 0:
+		return fmt.Errorf("%w: product %d is on %d stocktake lines, archive it instead", ErrProductInUse, id, counted)
+	case transferred > 0:
+		return fmt.Errorf("%w: product %d is on %d transfer lines, archive it instead", ErrProductInUse, id, transferred)
 	}
-	return nil // Return nil if deletion is successful
+	return nil
 }
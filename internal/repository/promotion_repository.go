@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+)
+
+// PromotionRepositoryInterface defines the methods for the promotion repository.
+type PromotionRepositoryInterface interface {
+	GetAllPromotions(ctx context.Context) ([]v1.Promotion, error)
+	GetActivePromotions(ctx context.Context, at time.Time) ([]v1.Promotion, error)
+	GetPromotionByID(ctx context.Context, id int) (*v1.Promotion, error)
+	CreatePromotion(ctx context.Context, promotion v1.Promotion) (int, error)
+	UpdatePromotion(ctx context.Context, promotion v1.Promotion) error
+	DeletePromotion(ctx context.Context, id int) error
+	CountPromotionSales(ctx context.Context, id int) (int, error)
+}
+
+const selectPromotions = `SELECT id, name, description, active, priority, stackable, starts_at, ends_at, conditions,
+	action_type, action_value, buy_quantity, free_quantity, created_at FROM promotions`
+
+// promotionOrder is the order promotions are applied in.
+const promotionOrder = " ORDER BY priority DESC, id"
+
+type PromotionRepository struct {
+	db *sql.DB
+}
+
+func NewPromotionRepository(db *sql.DB) *PromotionRepository {
+	return &PromotionRepository{
+		db: db,
+	}
+}
+
+func scanPromotion(row interface{ Scan(dest ...any) error }) (v1.Promotion, error) {
+	var promotion v1.Promotion
+	var conditions string
+	err := row.Scan(&promotion.Id, &promotion.Name, &promotion.Description, &promotion.Active, &promotion.Priority, &promotion.Stackable,
+		&promotion.StartsAt, &promotion.EndsAt, &conditions, &promotion.Action.Type, &promotion.Action.Value, &promotion.Action.BuyQuantity,
+		&promotion.Action.FreeQuantity, &promotion.CreatedAt)
+	if err != nil {
+		return promotion, err
+	}
+	promotion.Conditions = &v1.PromotionConditions{}
+	if err := json.Unmarshal([]byte(conditions), promotion.Conditions); err != nil {
+		return promotion, err
+	}
+	return promotion, nil
+}
+
+func (r *PromotionRepository) GetAllPromotions(ctx context.Context) ([]v1.Promotion, error) {
+	return r.getPromotions(ctx, promotionOrder)
+}
+
+// GetActivePromotions returns the active promotions whose validity window
+// contains the given time, in the order they are applied. Days of the week
+// and times of day are left to the caller.
+func (r *PromotionRepository) GetActivePromotions(ctx context.Context, at time.Time) ([]v1.Promotion, error) {
+	at = at.UTC()
+	return r.getPromotions(ctx, ` WHERE active = 1 AND (starts_at IS NULL OR starts_at <= ?) AND (ends_at IS NULL OR ends_at > ?)`+promotionOrder,
+		at, at)
+}
+
+func (r *PromotionRepository) getPromotions(ctx context.Context, where string, args ...any) ([]v1.Promotion, error) {
+	promotions := []v1.Promotion{}
+
+	rows, err := r.db.QueryContext(ctx, selectPromotions+where, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		promotion, err := scanPromotion(rows)
+		if err != nil {
+			return nil, err
+		}
+		promotions = append(promotions, promotion)
+	}
+	return promotions, rows.Err()
+}
+
+func (r *PromotionRepository) GetPromotionByID(ctx context.Context, id int) (*v1.Promotion, error) {
+	promotion, err := scanPromotion(r.db.QueryRowContext(ctx, selectPromotions+" WHERE id = ?", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Promotion not found
+		}
+		return nil, err
+	}
+	return &promotion, nil
+}
+
+func (r *PromotionRepository) CreatePromotion(ctx context.Context, promotion v1.Promotion) (int, error) {
+	conditions, err := json.Marshal(valueOrEmpty(promotion.Conditions))
+	if err != nil {
+		return 0, err
+	}
+	query := `INSERT INTO promotions (name, description, active, priority, stackable, starts_at, ends_at, conditions,
+		action_type, action_value, buy_quantity, free_quantity, created_at) VALUES (?, ?, COALESCE(?, 1), COALESCE(?, 0), COALESCE(?, 1), ?, ?, ?, ?, ?, ?, ?, ?)`
+	result, err := r.db.ExecContext(ctx, query, promotion.Name, promotion.Description, promotion.Active, promotion.Priority, promotion.Stackable,
+		utcTime(promotion.StartsAt), utcTime(promotion.EndsAt), string(conditions), promotion.Action.Type, promotion.Action.Value,
+		promotion.Action.BuyQuantity, promotion.Action.FreeQuantity, time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return int(id), nil
+}
+
+func (r *PromotionRepository) UpdatePromotion(ctx context.Context, promotion v1.Promotion) error {
+	conditions, err := json.Marshal(valueOrEmpty(promotion.Conditions))
+	if err != nil {
+		return err
+	}
+	query := `UPDATE promotions SET name = ?, description = ?, active = COALESCE(?, 1), priority = COALESCE(?, 0), stackable = COALESCE(?, 1),
+		starts_at = ?, ends_at = ?, conditions = ?, action_type = ?, action_value = ?, buy_quantity = ?, free_quantity = ? WHERE id = ?`
+	_, err = r.db.ExecContext(ctx, query, promotion.Name, promotion.Description, promotion.Active, promotion.Priority, promotion.Stackable,
+		utcTime(promotion.StartsAt), utcTime(promotion.EndsAt), string(conditions), promotion.Action.Type, promotion.Action.Value,
+		promotion.Action.BuyQuantity, promotion.Action.FreeQuantity, promotion.Id)
+	return err
+}
+
+func (r *PromotionRepository) DeletePromotion(ctx context.Context, id int) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM promotions WHERE id = ?", id)
+	return err
+}
+
+// CountPromotionSales returns the number of sales the promotion was applied
+// to.
+func (r *PromotionRepository) CountPromotionSales(ctx context.Context, id int) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(DISTINCT sale_id) FROM sale_promotions WHERE promotion_id = ?", id).Scan(&count)
+	return count, err
+}
+
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+func valueOrEmpty(conditions *v1.PromotionConditions) v1.PromotionConditions {
+	if conditions == nil {
+		return v1.PromotionConditions{}
+	}
+	return *conditions
+}
@@ -0,0 +1,480 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/audit"
+)
+
+// PurchaseRepositoryInterface defines the methods for the purchase repository.
+type PurchaseRepositoryInterface interface {
+	GetPurchaseOrders(ctx context.Context, supplierID *int, statuses []v1.PurchaseOrderStatus) ([]v1.PurchaseOrder, error)
+	GetPurchaseOrderByID(ctx context.Context, id int) (*v1.PurchaseOrder, error)
+	CreatePurchaseOrder(ctx context.Context, order v1.PurchaseOrder) (int, error)
+	CreatePurchaseOrders(ctx context.Context, orders []v1.PurchaseOrder) ([]int, error)
+	UpdatePurchaseOrder(ctx context.Context, order v1.PurchaseOrder) error
+	PlacePurchaseOrder(ctx context.Context, id int) error
+	ClosePurchaseOrder(ctx context.Context, id int) error
+	GetGoodsReceipts(ctx context.Context, purchaseOrderID int) ([]v1.GoodsReceipt, error)
+	GetGoodsReceiptByID(ctx context.Context, id int) (*v1.GoodsReceipt, error)
+	CreateGoodsReceipt(ctx context.Context, receipt v1.GoodsReceipt, batches []*v1.ProductBatch) (int, error)
+}
+
+const selectPurchaseOrders = `SELECT o.id, o.supplier_id, s.legal_name, o.status, o.expected_on, o.note, o.ordered_at, o.created_by, o.closed_at
+	FROM purchase_orders o JOIN suppliers s ON s.id = o.supplier_id`
+
+// selectPurchaseOrderItems joins the product for the name, variant label and
+// unit of each line.
+const selectPurchaseOrderItems = `SELECT i.purchase_order_id, i.id, i.product_id, p.name, p.variant_label, p.unit, i.quantity, i.received_quantity,
+	i.unit_cost, i.cgst_rate, i.sgst_rate
+	FROM purchase_order_items i JOIN products p ON p.id = i.product_id`
+
+const selectGoodsReceipts = `SELECT id, purchase_order_id, supplier_id, supplier_invoice_no, supplier_invoice_date, note, interstate, subtotal,
+	cgst_total, sgst_total, igst_total, tax_total, total, received_at, created_by FROM goods_receipts`
+
+// selectGoodsReceiptItems joins the product and the batch the goods went
+// into, if any.
+const selectGoodsReceiptItems = `SELECT i.goods_receipt_id, i.id, i.purchase_order_item_id, i.product_id, p.name, p.variant_label, p.unit,
+	i.quantity, i.unit_cost, b.batch_no, b.expires_on, b.mrp, i.cgst_rate, i.sgst_rate, i.igst_rate, i.cgst_amount, i.sgst_amount,
+	i.igst_amount, i.subtotal, i.line_total
+	FROM goods_receipt_items i JOIN products p ON p.id = i.product_id LEFT JOIN product_batches b ON b.id = i.batch_id`
+
+type PurchaseRepository struct {
+	db *sql.DB
+}
+
+func NewPurchaseRepository(db *sql.DB) *PurchaseRepository {
+	return &PurchaseRepository{
+		db: db,
+	}
+}
+
+func scanPurchaseOrder(row interface{ Scan(dest ...any) error }) (v1.PurchaseOrder, error) {
+	var order v1.PurchaseOrder
+	var expectedOn sql.NullString
+	err := row.Scan(&order.Id, &order.SupplierId, &order.SupplierName, &order.Status, &expectedOn, &order.Note, &order.OrderedAt,
+		&order.CreatedBy, &order.ClosedAt)
+	if err != nil {
+		return order, err
+	}
+	if expectedOn.Valid {
+		order.ExpectedOn, err = parseDate(expectedOn.String)
+	}
+	order.Items = []v1.PurchaseOrderItem{}
+	return order, err
+}
+
+func scanGoodsReceipt(row interface{ Scan(dest ...any) error }) (v1.GoodsReceipt, error) {
+	var receipt v1.GoodsReceipt
+	var invoiceDate sql.NullString
+	err := row.Scan(&receipt.Id, &receipt.PurchaseOrderId, &receipt.SupplierId, &receipt.SupplierInvoiceNo, &invoiceDate, &receipt.Note,
+		&receipt.Interstate, &receipt.Subtotal, &receipt.CgstTotal, &receipt.SgstTotal, &receipt.IgstTotal, &receipt.TaxTotal, &receipt.Total,
+		&receipt.ReceivedAt, &receipt.CreatedBy)
+	if err != nil {
+		return receipt, err
+	}
+	if invoiceDate.Valid {
+		receipt.SupplierInvoiceDate, err = parseDate(invoiceDate.String)
+	}
+	receipt.Items = []v1.GoodsReceiptItem{}
+	return receipt, err
+}
+
+// GetPurchaseOrders returns the purchase orders, oldest first, optionally of
+// one supplier and in the given statuses.
+func (r *PurchaseRepository) GetPurchaseOrders(ctx context.Context, supplierID *int, statuses []v1.PurchaseOrderStatus) ([]v1.PurchaseOrder, error) {
+	var conditions []string
+	var args []any
+	if supplierID != nil {
+		conditions = append(conditions, "o.supplier_id = ?")
+		args = append(args, *supplierID)
+	}
+	if len(statuses) > 0 {
+		conditions = append(conditions, "o.status IN (?"+strings.Repeat(", ?", len(statuses)-1)+")")
+		for _, status := range statuses {
+			args = append(args, status)
+		}
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	orders := []v1.PurchaseOrder{}
+	rows, err := r.db.QueryContext(ctx, selectPurchaseOrders+where+" ORDER BY o.id", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		order, err := scanPurchaseOrder(rows)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	itemWhere := " WHERE i.purchase_order_id IN (SELECT o.id FROM purchase_orders o" + where + ")"
+	if err := r.attachPurchaseOrderItems(ctx, orders, itemWhere, args...); err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+func (r *PurchaseRepository) GetPurchaseOrderByID(ctx context.Context, id int) (*v1.PurchaseOrder, error) {
+	order, err := scanPurchaseOrder(r.db.QueryRowContext(ctx, selectPurchaseOrders+" WHERE o.id = ?", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Purchase order not found
+		}
+		return nil, err
+	}
+
+	orders := []v1.PurchaseOrder{order}
+	if err := r.attachPurchaseOrderItems(ctx, orders, " WHERE i.purchase_order_id = ?", id); err != nil {
+		return nil, err
+	}
+	return &orders[0], nil
+}
+
+// attachPurchaseOrderItems loads the lines matching the filter and appends
+// them to the orders they belong to.
+func (r *PurchaseRepository) attachPurchaseOrderItems(ctx context.Context, orders []v1.PurchaseOrder, where string, args ...any) error {
+	index := make(map[int]int, len(orders))
+	for i, order := range orders {
+		index[*order.Id] = i
+	}
+
+	rows, err := r.db.QueryContext(ctx, selectPurchaseOrderItems+where+" ORDER BY i.purchase_order_id, i.id", args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var orderID int
+		var item v1.PurchaseOrderItem
+		if err := rows.Scan(&orderID, &item.Id, &item.ProductId, &item.Name, &item.VariantLabel, &item.Unit, &item.Quantity,
+			&item.ReceivedQuantity, &item.UnitCost, &item.CgstRate, &item.SgstRate); err != nil {
+			return err
+		}
+		if i, ok := index[orderID]; ok {
+			orders[i].Items = append(orders[i].Items, item)
+		}
+	}
+	return rows.Err()
+}
+
+// CreatePurchaseOrder stores the order, open or as a draft, and its lines in
+// a single transaction and returns the new order ID.
+func (r *PurchaseRepository) CreatePurchaseOrder(ctx context.Context, order v1.PurchaseOrder) (int, error) {
+	ids, err := r.CreatePurchaseOrders(ctx, []v1.PurchaseOrder{order})
+	if err != nil {
+		return 0, err
+	}
+	return ids[0], nil
+}
+
+// CreatePurchaseOrders stores the orders and their lines in a single
+// transaction, so that either all of them are created or none is, and returns
+// the new order IDs in the same order.
+func (r *PurchaseRepository) CreatePurchaseOrders(ctx context.Context, orders []v1.PurchaseOrder) ([]int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	ids := make([]int, 0, len(orders))
+	for _, order := range orders {
+		id, err := insertPurchaseOrder(ctx, tx, order)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func insertPurchaseOrder(ctx context.Context, tx *sql.Tx, order v1.PurchaseOrder) (int, error) {
+	var expectedOn *string
+	if order.ExpectedOn != nil {
+		date := order.ExpectedOn.Format(time.DateOnly)
+		expectedOn = &date
+	}
+	query := "INSERT INTO purchase_orders (supplier_id, status, expected_on, note, ordered_at, created_by) VALUES (?, ?, ?, ?, ?, ?)"
+	result, err := tx.ExecContext(ctx, query, order.SupplierId, order.Status, expectedOn, order.Note, time.Now().UTC(), audit.User(ctx))
+	if err != nil {
+		return 0, err
+	}
+	orderID, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	if err := insertPurchaseOrderItems(ctx, tx, int(orderID), order.Items); err != nil {
+		return 0, err
+	}
+	return int(orderID), nil
+}
+
+// UpdatePurchaseOrder replaces the supplier, details and lines of a draft.
+// ErrStatusChanged is returned when the order is no longer a draft.
+func (r *PurchaseRepository) UpdatePurchaseOrder(ctx context.Context, order v1.PurchaseOrder) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var expectedOn *string
+	if order.ExpectedOn != nil {
+		date := order.ExpectedOn.Format(time.DateOnly)
+		expectedOn = &date
+	}
+	query := "UPDATE purchase_orders SET supplier_id = ?, expected_on = ?, note = ? WHERE id = ? AND status = ?"
+	result, err := tx.ExecContext(ctx, query, order.SupplierId, expectedOn, order.Note, order.Id, v1.Draft)
+	if err != nil {
+		return err
+	}
+	if err := expectOneRow(result, "purchase order", *order.Id); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM purchase_order_items WHERE purchase_order_id = ?", order.Id); err != nil {
+		return err
+	}
+	if err := insertPurchaseOrderItems(ctx, tx, *order.Id, order.Items); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func insertPurchaseOrderItems(ctx context.Context, tx *sql.Tx, orderID int, items []v1.PurchaseOrderItem) error {
+	query := `INSERT INTO purchase_order_items (purchase_order_id, product_id, quantity, unit_cost, cgst_rate, sgst_rate)
+		VALUES (?, ?, ?, ?, ?, ?)`
+	for _, item := range items {
+		_, err := tx.ExecContext(ctx, query, orderID, item.ProductId, item.Quantity, item.UnitCost, item.CgstRate, item.SgstRate)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PlacePurchaseOrder opens a draft, dating the order from now.
+// ErrStatusChanged is returned when the order is no longer a draft.
+func (r *PurchaseRepository) PlacePurchaseOrder(ctx context.Context, id int) error {
+	query := "UPDATE purchase_orders SET status = ?, ordered_at = ? WHERE id = ? AND status = ?"
+	result, err := r.db.ExecContext(ctx, query, v1.Open, time.Now().UTC(), id, v1.Draft)
+	if err != nil {
+		return err
+	}
+	return expectOneRow(result, "purchase order", id)
+}
+
+// ClosePurchaseOrder closes a draft or an outstanding order, leaving what was
+// received so far in place. ErrStatusChanged is returned when the order was
+// received in full or closed in the meantime.
+func (r *PurchaseRepository) ClosePurchaseOrder(ctx context.Context, id int) error {
+	query := "UPDATE purchase_orders SET status = ?, closed_at = ? WHERE id = ? AND status IN (?, ?, ?)"
+	result, err := r.db.ExecContext(ctx, query, v1.Closed, time.Now().UTC(), id, v1.Draft, v1.Open, v1.PartiallyReceived)
+	if err != nil {
+		return err
+	}
+	return expectOneRow(result, "purchase order", id)
+}
+
+// GetGoodsReceipts returns the goods receipt notes of the order, oldest
+// first.
+func (r *PurchaseRepository) GetGoodsReceipts(ctx context.Context, purchaseOrderID int) ([]v1.GoodsReceipt, error) {
+	receipts := []v1.GoodsReceipt{}
+
+	rows, err := r.db.QueryContext(ctx, selectGoodsReceipts+" WHERE purchase_order_id = ? ORDER BY id", purchaseOrderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		receipt, err := scanGoodsReceipt(rows)
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	where := " WHERE i.goods_receipt_id IN (SELECT id FROM goods_receipts WHERE purchase_order_id = ?)"
+	if err := r.attachGoodsReceiptItems(ctx, receipts, where, purchaseOrderID); err != nil {
+		return nil, err
+	}
+	return receipts, nil
+}
+
+func (r *PurchaseRepository) GetGoodsReceiptByID(ctx context.Context, id int) (*v1.GoodsReceipt, error) {
+	receipt, err := scanGoodsReceipt(r.db.QueryRowContext(ctx, selectGoodsReceipts+" WHERE id = ?", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Goods receipt not found
+		}
+		return nil, err
+	}
+
+	receipts := []v1.GoodsReceipt{receipt}
+	if err := r.attachGoodsReceiptItems(ctx, receipts, " WHERE i.goods_receipt_id = ?", id); err != nil {
+		return nil, err
+	}
+	return &receipts[0], nil
+}
+
+// attachGoodsReceiptItems loads the lines matching the filter and appends them
+// to the receipts they belong to.
+func (r *PurchaseRepository) attachGoodsReceiptItems(ctx context.Context, receipts []v1.GoodsReceipt, where string, args ...any) error {
+	index := make(map[int]int, len(receipts))
+	for i, receipt := range receipts {
+		index[*receipt.Id] = i
+	}
+
+	rows, err := r.db.QueryContext(ctx, selectGoodsReceiptItems+where+" ORDER BY i.goods_receipt_id, i.id", args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var receiptID int
+		var item v1.GoodsReceiptItem
+		var expiresOn sql.NullString
+		if err := rows.Scan(&receiptID, &item.Id, &item.PurchaseOrderItemId, &item.ProductId, &item.Name, &item.VariantLabel, &item.Unit,
+			&item.Quantity, &item.UnitCost, &item.BatchNo, &expiresOn, &item.Mrp, &item.CgstRate, &item.SgstRate, &item.IgstRate,
+			&item.CgstAmount, &item.SgstAmount, &item.IgstAmount, &item.Subtotal, &item.LineTotal); err != nil {
+			return err
+		}
+		if expiresOn.Valid {
+			if item.ExpiresOn, err = parseDate(expiresOn.String); err != nil {
+				return err
+			}
+		}
+		if i, ok := index[receiptID]; ok {
+			receipts[i].Items = append(receipts[i].Items, item)
+		}
+	}
+	return rows.Err()
+}
+
+// CreateGoodsReceipt stores the receipt note and, for each line, posts a
+// purchase stock movement, into the line's batch when it has one, adds the
+// quantity to the received quantity of the order line and records the unit
+// cost as the product's cost price. The order becomes received once nothing
+// is outstanding, otherwise partially received. It all happens in a single
+// transaction, and the new receipt ID is returned. ErrOverReceived is returned
+// when a line would be received beyond its ordered quantity, and
+// ErrStatusChanged when the order is no longer outstanding, both as read
+// within the transaction.
+func (r *PurchaseRepository) CreateGoodsReceipt(ctx context.Context, receipt v1.GoodsReceipt, batches []*v1.ProductBatch) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var invoiceDate *string
+	if receipt.SupplierInvoiceDate != nil {
+		date := receipt.SupplierInvoiceDate.Format(time.DateOnly)
+		invoiceDate = &date
+	}
+	now := time.Now().UTC()
+	query := `INSERT INTO goods_receipts (purchase_order_id, supplier_id, supplier_invoice_no, supplier_invoice_date, note, interstate, subtotal,
+		cgst_total, sgst_total, igst_total, tax_total, total, received_at, created_by) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	result, err := tx.ExecContext(ctx, query, receipt.PurchaseOrderId, receipt.SupplierId, receipt.SupplierInvoiceNo, invoiceDate, receipt.Note,
+		receipt.Interstate, receipt.Subtotal, receipt.CgstTotal, receipt.SgstTotal, receipt.IgstTotal, receipt.TaxTotal, receipt.Total, now,
+		audit.User(ctx))
+	if err != nil {
+		return 0, err
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	receiptID := int(lastID)
+
+	note := fmt.Sprintf("GRN %d against PO %d", receiptID, *receipt.PurchaseOrderId)
+	for i, item := range receipt.Items {
+		unitCost := float64(*item.UnitCost)
+		movement := v1.StockMovement{
+			ProductId: item.ProductId,
+			Quantity:  &item.Quantity,
+			Reason:    reasonPtr(v1.StockMovementReasonPurchase),
+			Note:      &note,
+			UnitCost:  &unitCost,
+		}
+		if batches[i] != nil {
+			batchID, err := upsertBatch(ctx, tx, *item.ProductId, *batches[i])
+			if err != nil {
+				return 0, err
+			}
+			movement.BatchId = &batchID
+		}
+		// Goods only come in, so the valuation method does not apply.
+		movementID, err := postStockMovement(ctx, tx, movement, nil, "")
+		if err != nil {
+			return 0, err
+		}
+
+		query := `INSERT INTO goods_receipt_items (goods_receipt_id, purchase_order_item_id, product_id, quantity, unit_cost, cgst_rate, sgst_rate,
+			igst_rate, cgst_amount, sgst_amount, igst_amount, subtotal, line_total, batch_id, stock_movement_id)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		_, err = tx.ExecContext(ctx, query, receiptID, item.PurchaseOrderItemId, item.ProductId, item.Quantity, item.UnitCost, item.CgstRate,
+			item.SgstRate, item.IgstRate, item.CgstAmount, item.SgstAmount, item.IgstAmount, item.Subtotal, item.LineTotal, movement.BatchId,
+			movementID)
+		if err != nil {
+			return 0, err
+		}
+
+		query = `UPDATE purchase_order_items SET received_quantity = ROUND(received_quantity + ?, 6)
+			WHERE id = ? AND ROUND(received_quantity + ?, 6) <= quantity`
+		result, err := tx.ExecContext(ctx, query, item.Quantity, item.PurchaseOrderItemId, item.Quantity)
+		if err != nil {
+			return 0, err
+		}
+		if n, err := result.RowsAffected(); err != nil {
+			return 0, err
+		} else if n != 1 {
+			return 0, fmt.Errorf("%w: line %d", ErrOverReceived, item.PurchaseOrderItemId)
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE products SET cost_price = ? WHERE id = ?", item.UnitCost, item.ProductId); err != nil {
+			return 0, err
+		}
+	}
+
+	query = `UPDATE purchase_orders SET status = CASE WHEN EXISTS (SELECT 1 FROM purchase_order_items i
+		WHERE i.purchase_order_id = purchase_orders.id AND i.received_quantity < i.quantity) THEN ? ELSE ? END WHERE id = ? AND status IN (?, ?)`
+	result, err = tx.ExecContext(ctx, query, v1.PartiallyReceived, v1.Received, receipt.PurchaseOrderId, v1.Open, v1.PartiallyReceived)
+	if err != nil {
+		return 0, err
+	}
+	if err := expectOneRow(result, "purchase order", *receipt.PurchaseOrderId); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return receiptID, nil
+}
@@ -0,0 +1,262 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+)
+
+// ReportRepositoryInterface defines the methods for the report repository.
+type ReportRepositoryInterface interface {
+	GetTaxSummary(ctx context.Context, from, to *time.Time) ([]v1.TaxReportRow, error)
+	GetB2BInvoices(ctx context.Context, from, to *time.Time) ([]v1.TaxReportInvoice, error)
+	GetCompositionTurnover(ctx context.Context, from, to time.Time) (float64, int, error)
+	GetExpiringBatches(ctx context.Context, before time.Time) ([]v1.ProductBatch, error)
+	GetInputTaxSummary(ctx context.Context, from, to *time.Time) ([]v1.InputTaxReportRow, error)
+	GetLowStock(ctx context.Context, since time.Time) ([]v1.LowStockItem, error)
+	GetStockValuation(ctx context.Context, before time.Time, categoryID *int) ([]v1.StockValuationItem, error)
+	GetInTransitValue(ctx context.Context, before time.Time, categoryID *int) (float64, error)
+}
+
+type ReportRepository struct {
+	db *sql.DB
+}
+
+func NewReportRepository(db *sql.DB) *ReportRepository {
+	return &ReportRepository{
+		db: db,
+	}
+}
+
+// soldBetween builds the sold_at filter for an optional reporting period.
+func soldBetween(column string, from, to *time.Time) (string, []any) {
+	var conditions []string
+	var args []any
+	if from != nil {
+		conditions = append(conditions, column+" >= ?")
+		args = append(args, from.UTC())
+	}
+	if to != nil {
+		conditions = append(conditions, column+" < ?")
+		args = append(args, to.UTC())
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+func (r *ReportRepository) GetTaxSummary(ctx context.Context, from, to *time.Time) ([]v1.TaxReportRow, error) {
+	var summary []v1.TaxReportRow
+
+	query := `SELECT s.supply_type, i.cgst_rate, i.sgst_rate, i.igst_rate, SUM(i.subtotal), SUM(i.cgst_amount), SUM(i.sgst_amount),
+		SUM(i.igst_amount), COUNT(DISTINCT s.id) FROM sale_items i JOIN sales s ON s.id = i.sale_id WHERE s.voided_at IS NULL`
+	where, args := soldBetween("s.sold_at", from, to)
+	if where != "" {
+		query += " AND " + where
+	}
+	query += ` GROUP BY s.supply_type, i.cgst_rate, i.sgst_rate, i.igst_rate
+		ORDER BY s.supply_type, i.cgst_rate + i.sgst_rate + i.igst_rate, i.igst_rate`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row v1.TaxReportRow
+		if err := rows.Scan(&row.SupplyType, &row.CgstRate, &row.SgstRate, &row.IgstRate, &row.TaxableValue, &row.CgstAmount, &row.SgstAmount,
+			&row.IgstAmount, &row.Invoices); err != nil {
+			return nil, err
+		}
+		summary = append(summary, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+func (r *ReportRepository) GetB2BInvoices(ctx context.Context, from, to *time.Time) ([]v1.TaxReportInvoice, error) {
+	var invoices []v1.TaxReportInvoice
+
+	query := `SELECT id, sold_at, buyer_gstin, buyer_name, buyer_state_code, subtotal, tax_total, grand_total
+		FROM sales WHERE supply_type = 'B2B' AND voided_at IS NULL`
+	where, args := soldBetween("sold_at", from, to)
+	if where != "" {
+		query += " AND " + where
+	}
+	query += " ORDER BY sold_at, id"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var invoice v1.TaxReportInvoice
+		if err := rows.Scan(&invoice.SaleId, &invoice.SoldAt, &invoice.Gstin, &invoice.LegalName, &invoice.StateCode,
+			&invoice.TaxableValue, &invoice.TaxTotal, &invoice.InvoiceValue); err != nil {
+			return nil, err
+		}
+		invoices = append(invoices, invoice)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return invoices, nil
+}
+
+// GetCompositionTurnover returns the value and number of bills of supply
+// issued in the period.
+func (r *ReportRepository) GetCompositionTurnover(ctx context.Context, from, to time.Time) (float64, int, error) {
+	var turnover float64
+	var bills int
+
+	where, args := soldBetween("sold_at", &from, &to)
+	query := "SELECT COALESCE(SUM(grand_total), 0), COUNT(*) FROM sales WHERE document_type = 'bill_of_supply' AND voided_at IS NULL AND " + where
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&turnover, &bills); err != nil {
+		return 0, 0, err
+	}
+	return turnover, bills, nil
+}
+
+// GetExpiringBatches returns the batches with stock on hand that expire
+// before the given date, including those already expired, first to expire
+// first.
+func (r *ReportRepository) GetExpiringBatches(ctx context.Context, before time.Time) ([]v1.ProductBatch, error) {
+	return queryBatches(ctx, r.db, " WHERE b.quantity > 0 AND b.expires_on < ? ORDER BY b.expires_on, p.name, b.id",
+		before.Format(time.DateOnly))
+}
+
+// GetInputTaxSummary totals the taxable value and input tax of the goods
+// received in the period by rate.
+func (r *ReportRepository) GetInputTaxSummary(ctx context.Context, from, to *time.Time) ([]v1.InputTaxReportRow, error) {
+	summary := []v1.InputTaxReportRow{}
+
+	query := `SELECT i.cgst_rate, i.sgst_rate, i.igst_rate, SUM(i.subtotal), SUM(i.cgst_amount), SUM(i.sgst_amount), SUM(i.igst_amount),
+		COUNT(DISTINCT g.id) FROM goods_receipt_items i JOIN goods_receipts g ON g.id = i.goods_receipt_id`
+	where, args := soldBetween("g.received_at", from, to)
+	if where != "" {
+		query += " WHERE " + where
+	}
+	query += " GROUP BY i.cgst_rate, i.sgst_rate, i.igst_rate ORDER BY i.cgst_rate + i.sgst_rate + i.igst_rate, i.igst_rate"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row v1.InputTaxReportRow
+		if err := rows.Scan(&row.CgstRate, &row.SgstRate, &row.IgstRate, &row.TaxableValue, &row.CgstAmount, &row.SgstAmount, &row.IgstAmount,
+			&row.Receipts); err != nil {
+			return nil, err
+		}
+		summary = append(summary, row)
+	}
+	return summary, rows.Err()
+}
+
+// GetLowStock returns the active products at or below their reorder level
+// with the quantity sold since the given instant, net of voids and returns,
+// and the quantity outstanding on draft and placed purchase orders. The
+// supplier is the preferred one, or the one the product was last ordered
+// from. Parents with variants hold no stock and are left out.
+func (r *ReportRepository) GetLowStock(ctx context.Context, since time.Time) ([]v1.LowStockItem, error) {
+	items := []v1.LowStockItem{}
+
+	query := `SELECT p.id, p.name, p.variant_label, p.unit, p.stock_on_hand, p.reorder_level, p.reorder_quantity, p.cost_price,
+		COALESCE((SELECT -SUM(m.quantity) FROM stock_movements m WHERE m.product_id = p.id AND m.reason IN ('sale', 'void', 'return')
+			AND m.created_at >= ?), 0),
+		COALESCE((SELECT SUM(MAX(i.quantity - i.received_quantity, 0)) FROM purchase_order_items i JOIN purchase_orders o ON o.id = i.purchase_order_id
+			WHERE i.product_id = p.id AND o.status IN (?, ?, ?)), 0),
+		s.id, s.legal_name
+		FROM products p
+		LEFT JOIN suppliers s ON s.id = COALESCE(p.supplier_id, (SELECT o.supplier_id FROM purchase_order_items i
+			JOIN purchase_orders o ON o.id = i.purchase_order_id WHERE i.product_id = p.id ORDER BY o.id DESC LIMIT 1))
+		WHERE p.active = 1 AND p.reorder_level IS NOT NULL AND p.stock_on_hand <= p.reorder_level
+			AND NOT EXISTS (SELECT 1 FROM products v WHERE v.parent_id = p.id)
+		ORDER BY p.name, p.id`
+
+	rows, err := r.db.QueryContext(ctx, query, since.UTC(), v1.Draft, v1.Open, v1.PartiallyReceived)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item v1.LowStockItem
+		if err := rows.Scan(&item.ProductId, &item.Name, &item.VariantLabel, &item.Unit, &item.StockOnHand, &item.ReorderLevel, &item.ReorderQuantity,
+			&item.CostPrice, &item.SoldQuantity, &item.OnOrderQuantity, &item.SupplierId, &item.SupplierName); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+// categoryTree selects the IDs of a category and all of its descendants.
+const categoryTree = `(
+	WITH RECURSIVE tree(id) AS (SELECT ? UNION ALL SELECT c.id FROM categories c JOIN tree ON c.parent_id = tree.id)
+	SELECT id FROM tree)`
+
+// GetStockValuation returns the quantity and cost value of each product's
+// stock as of the latest movement before the given instant, optionally only
+// for products in the category or any of its descendants. Products with
+// nothing on hand then are left out. Stock moved before costing was
+// introduced is valued at the product's latest cost price.
+func (r *ReportRepository) GetStockValuation(ctx context.Context, before time.Time, categoryID *int) ([]v1.StockValuationItem, error) {
+	items := []v1.StockValuationItem{}
+
+	query := `SELECT p.id, p.name, p.variant_label, p.category_id, p.unit, m.balance,
+		COALESCE(m.stock_value, ROUND(m.balance * COALESCE(p.cost_price, 0), 2))
+		FROM products p
+		JOIN stock_movements m ON m.id = (SELECT MAX(id) FROM stock_movements WHERE product_id = p.id AND created_at < ?)
+		WHERE m.balance != 0`
+	args := []any{before.UTC()}
+	if categoryID != nil {
+		query += " AND p.category_id IN " + categoryTree
+		args = append(args, *categoryID)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query+" ORDER BY p.name, p.id", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item v1.StockValuationItem
+		if err := rows.Scan(&item.ProductId, &item.Name, &item.VariantLabel, &item.CategoryId, &item.Unit, &item.Quantity, &item.Value); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+// GetInTransitValue returns the value at cost of the goods dispatched to other
+// stores before the given instant and not received by then, optionally only
+// of products in the category or any of its descendants.
+func (r *ReportRepository) GetInTransitValue(ctx context.Context, before time.Time, categoryID *int) (float64, error) {
+	query := `SELECT COALESCE(ROUND(SUM(i.quantity * i.unit_cost), 2), 0)
+		FROM transfer_items i JOIN transfers t ON t.id = i.transfer_id JOIN products p ON p.id = i.product_id
+		WHERE t.direction = ? AND t.dispatched_at < ? AND (t.received_at IS NULL OR t.received_at >= ?)`
+	args := []any{v1.Outbound, before.UTC(), before.UTC()}
+	if categoryID != nil {
+		query += " AND p.category_id IN " + categoryTree
+		args = append(args, *categoryID)
+	}
+
+	var value float64
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&value)
+	return value, err
+}
@@ -1,10 +1,481 @@
 package repository
 
-import "context"
+import (
+	"context"
+	"database/sql"
+	"math"
+	"time"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+)
+
+// SalesRepositoryInterface defines the methods for the sales repository.
+type SalesRepositoryInterface interface {
+	GetAllSales(ctx context.Context) ([]v1.Sale, error)
+	GetSaleByID(ctx context.Context, id int) (*v1.Sale, error)
+	CreateSale(ctx context.Context, sale v1.Sale, method v1.ValuationMethod, allowNegative bool) (int, error)
+	VoidSale(ctx context.Context, sale v1.Sale) error
+}
+
+const selectSales = `SELECT id, sold_at, customer_id, supply_type, document_type, buyer_name, buyer_address, buyer_state_code, buyer_gstin,
+	interstate, subtotal, discount_total, cgst_total, sgst_total, igst_total, tax_total, grand_total, voided_at, price_list_id,
+	(SELECT e.ewb_no FROM eway_bills e WHERE e.sale_id = sales.id) FROM sales`
+
+// selectSaleItems joins the product so that receipts keep showing the item
+// name and variant label, and the bundle line a component was sold on with
+// the bundle's name; prices, rates and HSN codes are the snapshots taken at
+// sale time.
+const selectSaleItems = `SELECT i.sale_id, i.product_id, p.name, p.variant_label, COALESCE(i.hsn_code, p.hsn_code), i.quantity, i.unit, i.unit_price, i.cgst_rate, i.sgst_rate,
+	i.igst_rate, i.cgst_amount, i.sgst_amount, i.igst_amount, i.discount, i.subtotal, i.line_total, i.cost_of_goods_sold, i.id, sb.id, sb.product_id, bp.name, sb.quantity, sb.unit, sb.unit_price
+	FROM sale_items i LEFT JOIN products p ON p.id = i.product_id
+	LEFT JOIN sale_bundles sb ON sb.id = i.sale_bundle_id LEFT JOIN products bp ON bp.id = sb.product_id`
+
+// setSaleItemCost records the cost of the goods that left stock for the sale
+// line bound first and last.
+const setSaleItemCost = `UPDATE sale_items SET cost_of_goods_sold = (SELECT ROUND(-SUM(quantity * unit_cost), 2) FROM stock_movements
+	WHERE sale_item_id = ? AND reason = ?) WHERE id = ?`
 
 type SalesRepository struct {
+	db *sql.DB
+}
+
+func NewSalesRepository(db *sql.DB) *SalesRepository {
+	return &SalesRepository{
+		db: db,
+	}
+}
+
+func scanSale(row interface{ Scan(dest ...any) error }) (v1.Sale, error) {
+	var sale v1.Sale
+	var buyerName, buyerAddress, buyerStateCode, buyerGstin sql.NullString
+	var voidedAt sql.NullTime
+	err := row.Scan(&sale.Id, &sale.SoldAt, &sale.CustomerId, &sale.SupplyType, &sale.DocumentType, &buyerName, &buyerAddress, &buyerStateCode, &buyerGstin,
+		&sale.Interstate, &sale.Subtotal, &sale.DiscountTotal, &sale.CgstTotal, &sale.SgstTotal, &sale.IgstTotal, &sale.TaxTotal, &sale.GrandTotal, &voidedAt, &sale.PriceListId, &sale.EwayBillNo)
+	if err != nil {
+		return sale, err
+	}
+	if voidedAt.Valid {
+		sale.VoidedAt = &voidedAt.Time
+	}
+	if buyerName.Valid {
+		sale.BilledTo = &v1.Customer{
+			Id:        sale.CustomerId,
+			LegalName: buyerName.String,
+			Address:   nullStringPtr(buyerAddress),
+			StateCode: nullStringPtr(buyerStateCode),
+			Gstin:     nullStringPtr(buyerGstin),
+		}
+	}
+	sale.Items = &[]v1.SaleItem{}
+	return sale, nil
+}
+
+func nullStringPtr(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
+
+func (r *SalesRepository) GetAllSales(ctx context.Context) ([]v1.Sale, error) {
+	var sales []v1.Sale
+
+	rows, err := r.db.QueryContext(ctx, selectSales+" ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		sale, err := scanSale(rows)
+		if err != nil {
+			return nil, err
+		}
+		sales = append(sales, sale)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	if err := r.attachItems(ctx, sales, ""); err != nil {
+		return nil, err
+	}
+	if err := r.attachPromotions(ctx, sales, ""); err != nil {
+		return nil, err
+	}
+	return sales, nil
+}
+
+func (r *SalesRepository) GetSaleByID(ctx context.Context, id int) (*v1.Sale, error) {
+	sale, err := scanSale(r.db.QueryRowContext(ctx, selectSales+" WHERE id = ?", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Sale not found
+		}
+		return nil, err
+	}
+
+	sales := []v1.Sale{sale}
+	if err := r.attachItems(ctx, sales, " WHERE i.sale_id = ?", id); err != nil {
+		return nil, err
+	}
+	if err := r.attachPromotions(ctx, sales, " WHERE sp.sale_id = ?", id); err != nil {
+		return nil, err
+	}
+	return &sales[0], nil
+}
+
+// attachItems loads the lines matching the filter, with the batches they were
+// sold from, and appends them to the sales they belong to.
+func (r *SalesRepository) attachItems(ctx context.Context, sales []v1.Sale, where string, args ...any) error {
+	batches, err := r.getItemBatches(ctx, where, args...)
+	if err != nil {
+		return err
+	}
+
+	index := make(map[int]int, len(sales))
+	for i, sale := range sales {
+		index[*sale.Id] = i
+	}
+
+	rows, err := r.db.QueryContext(ctx, selectSaleItems+where+" ORDER BY i.sale_id, i.id", args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	// The components of a bundle line share its description.
+	bundles := map[int]*v1.SaleItemBundle{}
+	for rows.Next() {
+		var saleID, itemID int
+		var item v1.SaleItem
+		var bundle v1.SaleItemBundle
+		if err := rows.Scan(&saleID, &item.ProductId, &item.Name, &item.VariantLabel, &item.HsnCode, &item.Quantity, &item.Unit, &item.UnitPrice, &item.CgstRate, &item.SgstRate,
+			&item.IgstRate, &item.CgstAmount, &item.SgstAmount, &item.IgstAmount, &item.Discount, &item.Subtotal, &item.LineTotal, &item.CostOfGoodsSold, &itemID, &bundle.Id, &bundle.ProductId, &bundle.Name, &bundle.Quantity,
+			&bundle.Unit, &bundle.UnitPrice); err != nil {
+			return err
+		}
+		if itemBatches, ok := batches[itemID]; ok {
+			item.Batches = &itemBatches
+		}
+		if bundle.Id != nil {
+			if _, ok := bundles[*bundle.Id]; !ok {
+				bundles[*bundle.Id] = &bundle
+			}
+			item.Bundle = bundles[*bundle.Id]
+		}
+		if i, ok := index[saleID]; ok {
+			*sales[i].Items = append(*sales[i].Items, item)
+		}
+	}
+
+	return rows.Err()
+}
+
+// attachPromotions loads the promotions applied to the sales matching the
+// filter and sets them on the sales they belong to.
+func (r *SalesRepository) attachPromotions(ctx context.Context, sales []v1.Sale, where string, args ...any) error {
+	index := make(map[int]int, len(sales))
+	for i, sale := range sales {
+		index[*sale.Id] = i
+	}
+
+	query := "SELECT sp.sale_id, sp.promotion_id, sp.name, sp.discount FROM sale_promotions sp" + where + " ORDER BY sp.sale_id, sp.id"
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var saleID int
+		var applied v1.AppliedPromotion
+		if err := rows.Scan(&saleID, &applied.PromotionId, &applied.Name, &applied.Discount); err != nil {
+			return err
+		}
+		i, ok := index[saleID]
+		if !ok {
+			continue
+		}
+		if sales[i].Promotions == nil {
+			sales[i].Promotions = &[]v1.AppliedPromotion{}
+		}
+		*sales[i].Promotions = append(*sales[i].Promotions, applied)
+	}
+	return rows.Err()
+}
+
+// getItemBatches returns the batches the lines matching the filter were sold
+// from, by sale item ID.
+func (r *SalesRepository) getItemBatches(ctx context.Context, where string, args ...any) (map[int][]v1.SaleItemBatch, error) {
+	query := `SELECT m.sale_item_id, m.batch_id, b.batch_no, b.expires_on, b.mrp, -m.quantity
+		FROM stock_movements m JOIN product_batches b ON b.id = m.batch_id
+		WHERE m.reason = 'sale' AND m.sale_item_id IN (SELECT i.id FROM sale_items i` + where + `) ORDER BY m.id`
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	batches := make(map[int][]v1.SaleItemBatch)
+	for rows.Next() {
+		var itemID int
+		var batch v1.SaleItemBatch
+		var expiresOn string
+		if err := rows.Scan(&itemID, &batch.BatchId, &batch.BatchNo, &expiresOn, &batch.Mrp, &batch.Quantity); err != nil {
+			return nil, err
+		}
+		if batch.ExpiresOn, err = parseDate(expiresOn); err != nil {
+			return nil, err
+		}
+		batches[itemID] = append(batches[itemID], batch)
+	}
+	return batches, rows.Err()
+}
+
+// CreateSale stores the sale header and its lines, and posts the sale stock
+// movements of each line, one per batch it was allocated and one for the
+// rest, in a single transaction. The goods are costed by the valuation method
+// and the cost is recorded on the line. Consecutive lines sharing a bundle
+// are the components of one bundle line, whose ID is set on the bundle. It
+// returns the new sale ID.
+//
+// Stock is checked again as it is taken out, so that a sale posted
+// concurrently cannot sell the same goods: ErrInsufficientStock is returned
+// when a batch has less left than was allocated from it or, unless negative
+// stock is allowed, when the product has less on hand than the line takes.
+func (r *SalesRepository) CreateSale(ctx context.Context, sale v1.Sale, method v1.ValuationMethod, allowNegative bool) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var buyerName, buyerAddress, buyerStateCode, buyerGstin *string
+	if sale.BilledTo != nil {
+		buyerName = &sale.BilledTo.LegalName
+		buyerAddress = sale.BilledTo.Address
+		buyerStateCode = sale.BilledTo.StateCode
+		buyerGstin = sale.BilledTo.Gstin
+	}
+
+	query := `INSERT INTO sales (sold_at, customer_id, supply_type, document_type, buyer_name, buyer_address, buyer_state_code, buyer_gstin,
+		interstate, subtotal, discount_total, cgst_total, sgst_total, igst_total, tax_total, grand_total, price_list_id)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, COALESCE(?, 0), ?, COALESCE(?, 0), ?, ?, COALESCE(?, 0), ?, ?, ?)`
+	result, err := tx.ExecContext(ctx, query, sale.SoldAt.UTC(), sale.CustomerId, sale.SupplyType, sale.DocumentType, buyerName, buyerAddress, buyerStateCode, buyerGstin,
+		sale.Interstate, sale.Subtotal, sale.DiscountTotal, sale.CgstTotal, sale.SgstTotal, sale.IgstTotal, sale.TaxTotal, sale.GrandTotal, sale.PriceListId)
+	if err != nil {
+		return 0, err
+	}
+	saleID, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	if sale.Promotions != nil {
+		for _, applied := range *sale.Promotions {
+			query := "INSERT INTO sale_promotions (sale_id, promotion_id, name, discount) VALUES (?, ?, ?, ?)"
+			if _, err := tx.ExecContext(ctx, query, saleID, applied.PromotionId, applied.Name, applied.Discount); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	query = `INSERT INTO sale_items (sale_id, product_id, hsn_code, quantity, unit, unit_price, cgst_rate, sgst_rate, igst_rate, cgst_amount, sgst_amount,
+		igst_amount, discount, subtotal, line_total, sale_bundle_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, COALESCE(?, 0), ?, ?, COALESCE(?, 0), COALESCE(?, 0), ?, ?, ?)`
+	id := int(saleID)
+	var bundle *v1.SaleItemBundle
+	for _, item := range *sale.Items {
+		if item.Bundle != nil && item.Bundle != bundle {
+			bundle = item.Bundle
+			result, err := tx.ExecContext(ctx, "INSERT INTO sale_bundles (sale_id, product_id, quantity, unit, unit_price) VALUES (?, ?, ?, ?, ?)",
+				saleID, bundle.ProductId, bundle.Quantity, bundle.Unit, bundle.UnitPrice)
+			if err != nil {
+				return 0, err
+			}
+			lastID, err := result.LastInsertId()
+			if err != nil {
+				return 0, err
+			}
+			bundleLine := int(lastID)
+			bundle.Id = &bundleLine
+		}
+		var bundleID *int
+		if item.Bundle != nil {
+			bundleID = item.Bundle.Id
+		}
+
+		result, err := tx.ExecContext(ctx, query, saleID, item.ProductId, item.HsnCode, item.Quantity, item.Unit, item.UnitPrice, item.CgstRate, item.SgstRate, item.IgstRate, item.CgstAmount,
+			item.SgstAmount, item.IgstAmount, item.Discount, item.Subtotal, item.LineTotal, bundleID)
+		if err != nil {
+			return 0, err
+		}
+		lastID, err := result.LastInsertId()
+		if err != nil {
+			return 0, err
+		}
+		itemID := int(lastID)
+
+		var batches []v1.SaleItemBatch
+		if item.Batches != nil {
+			batches = *item.Batches
+		}
+		rest := *item.Quantity
+		for _, batch := range batches {
+			movement := v1.StockMovement{
+				ProductId: item.ProductId,
+				Quantity:  negated(batch.Quantity),
+				Reason:    reasonPtr(v1.StockMovementReasonSale),
+				SaleId:    &id,
+				BatchId:   batch.BatchId,
+			}
+			if err := ensureInStock(ctx, tx, movement, allowNegative); err != nil {
+				return 0, err
+			}
+			if _, err := postStockMovement(ctx, tx, movement, &itemID, method); err != nil {
+				return 0, err
+			}
+			rest -= *batch.Quantity
+		}
+		if rest = math.Round(rest*1e6) / 1e6; rest > 0 || batches == nil {
+			movement := v1.StockMovement{
+				ProductId: item.ProductId,
+				Quantity:  negated(&rest),
+				Reason:    reasonPtr(v1.StockMovementReasonSale),
+				SaleId:    &id,
+			}
+			if err := ensureInStock(ctx, tx, movement, allowNegative); err != nil {
+				return 0, err
+			}
+			if _, err := postStockMovement(ctx, tx, movement, &itemID, method); err != nil {
+				return 0, err
+			}
+		}
+
+		if _, err := tx.ExecContext(ctx, setSaleItemCost, itemID, v1.StockMovementReasonSale, itemID); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// VoidSale marks the sale as voided and reverses its sale stock movements
+// with void movements, at the cost the goods went out at, in a single
+// transaction. Goods already returned against the sale are back in stock, so
+// only what is still out is reversed. Sales recorded before stock was
+// tracked have no movements to reverse. ErrStatusChanged is returned when
+// the sale was voided by another request first.
+func (r *SalesRepository) VoidSale(ctx context.Context, sale v1.Sale) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, "UPDATE sales SET voided_at = ? WHERE id = ? AND voided_at IS NULL", time.Now().UTC(), sale.Id)
+	if err != nil {
+		return err
+	}
+	if err := expectOneRow(result, "sale", *sale.Id); err != nil {
+		return err
+	}
+
+	var reversals []v1.StockMovement
+	var itemIDs []*int
+	query := "SELECT product_id, quantity, batch_id, sale_item_id, unit_cost FROM stock_movements WHERE sale_id = ? AND reason = ? ORDER BY id"
+	rows, err := tx.QueryContext(ctx, query, sale.Id, v1.StockMovementReasonSale)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var productID int
+		var quantity float64
+		var batchID, itemID *int
+		var unitCost *float64
+		if err := rows.Scan(&productID, &quantity, &batchID, &itemID, &unitCost); err != nil {
+			rows.Close()
+			return err
+		}
+		reversals = append(reversals, v1.StockMovement{
+			ProductId: &productID,
+			Quantity:  negated(&quantity),
+			Reason:    reasonPtr(v1.StockMovementReasonVoid),
+			SaleId:    sale.Id,
+			BatchId:   batchID,
+			UnitCost:  unitCost,
+		})
+		itemIDs = append(itemIDs, itemID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if err := deductReturns(ctx, tx, *sale.Id, reversals); err != nil {
+		return err
+	}
+	for i, movement := range reversals {
+		if *movement.Quantity <= 0 {
+			continue
+		}
+		// Goods only come back in, so the valuation method does not apply.
+		if _, err := postStockMovement(ctx, tx, movement, itemIDs[i], ""); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// deductReturns takes the returns posted against the sale off the quantities
+// of its reversals, from the reversal of the returned batch first and then
+// from the other reversals of the product.
+func deductReturns(ctx context.Context, tx *sql.Tx, saleID int, reversals []v1.StockMovement) error {
+	query := "SELECT product_id, quantity, batch_id FROM stock_movements WHERE sale_id = ? AND reason = ? ORDER BY id"
+	rows, err := tx.QueryContext(ctx, query, saleID, v1.StockMovementReasonReturn)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var productID int
+		var returned float64
+		var batchID *int
+		if err := rows.Scan(&productID, &returned, &batchID); err != nil {
+			return err
+		}
+		for _, sameBatch := range []bool{true, false} {
+			for i := range reversals {
+				movement := &reversals[i]
+				if returned <= 0 || *movement.ProductId != productID {
+					continue
+				}
+				if sameBatch && (batchID == nil || movement.BatchId == nil || *batchID != *movement.BatchId) {
+					continue
+				}
+				deducted := min(returned, *movement.Quantity)
+				remaining := math.Round((*movement.Quantity-deducted)*1e6) / 1e6
+				movement.Quantity = &remaining
+				returned = math.Round((returned-deducted)*1e6) / 1e6
+			}
+		}
+	}
+	return rows.Err()
+}
+
+func negated(quantity *float64) *float64 {
+	n := 0.0
+	if quantity != nil {
+		n = -*quantity
+	}
+	return &n
 }
 
-func NewSalesRepository(ctx context.Context) *SalesRepository {
-	return &SalesRepository{}
+func reasonPtr(reason v1.StockMovementReason) *v1.StockMovementReason {
+	return &reason
 }
@@ -1,10 +1,73 @@
 package repository
 
-import "context"
+import (
+	"context"
+	"database/sql"
+)
+
+// SettingsRepositoryInterface defines the methods for the settings repository.
+type SettingsRepositoryInterface interface {
+	GetSettings(ctx context.Context) (map[string]string, error)
+	SaveSettings(ctx context.Context, values map[string]*string) error
+}
 
 type SettingsRepository struct {
+	db *sql.DB
+}
+
+func NewSettingsRepository(db *sql.DB) *SettingsRepository {
+	return &SettingsRepository{
+		db: db,
+	}
+}
+
+func (r *SettingsRepository) GetSettings(ctx context.Context) (map[string]string, error) {
+	values := map[string]string{}
+
+	rows, err := r.db.QueryContext(ctx, "SELECT key, value FROM settings")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key string
+		var value sql.NullString
+		if err := rows.Scan(&key, &value); err != nil {
+			return nil, err
+		}
+		if value.Valid {
+			values[key] = value.String
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return values, nil
 }
 
-func NewSettingsRepository(ctx context.Context) *SettingsRepository {
-	return &SettingsRepository{}
+// SaveSettings upserts every key with a value and removes keys set to nil.
+func (r *SettingsRepository) SaveSettings(ctx context.Context, values map[string]*string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for key, value := range values {
+		if value == nil {
+			if _, err := tx.ExecContext(ctx, "DELETE FROM settings WHERE key = ?", key); err != nil {
+				return err
+			}
+			continue
+		}
+		query := "INSERT INTO settings (key, value) VALUES (?, ?) ON CONFLICT(key) DO UPDATE SET value = excluded.value"
+		if _, err := tx.ExecContext(ctx, query, key, *value); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
 }
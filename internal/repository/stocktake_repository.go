@@ -0,0 +1,333 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/audit"
+)
+
+// StocktakeRepositoryInterface defines the methods for the stocktake repository.
+type StocktakeRepositoryInterface interface {
+	GetStocktakes(ctx context.Context, status *v1.StocktakeStatus) ([]v1.Stocktake, error)
+	GetStocktakeByID(ctx context.Context, id int) (*v1.Stocktake, error)
+	CreateStocktake(ctx context.Context, stocktake v1.Stocktake, productIDs []int) (int, error)
+	GetStocktakeCounts(ctx context.Context, stocktakeID int) ([]v1.StocktakeCount, error)
+	AddStocktakeCounts(ctx context.Context, stocktakeID int, device *string, counts []v1.StocktakeCount) error
+	ApproveStocktake(ctx context.Context, id int, reason string, method v1.ValuationMethod) error
+	CancelStocktake(ctx context.Context, id int) error
+}
+
+const selectStocktakes = `SELECT id, name, category_id, note, status, reason, started_at, started_by, closed_at, closed_by FROM stocktakes`
+
+// selectStocktakeItems joins the product for the name, variant label and unit
+// of each line, and sums the movements posted while the stocktake was
+// counting.
+const selectStocktakeItems = `SELECT i.stocktake_id, i.product_id, p.name, p.variant_label, p.unit, i.expected_quantity, i.counted_quantity,
+	i.unit_cost, (SELECT COALESCE(ROUND(SUM(m.quantity), 6), 0) FROM stock_movements m WHERE m.product_id = i.product_id
+		AND m.id > t.start_movement_id AND (t.end_movement_id IS NULL OR m.id <= t.end_movement_id)), i.stock_movement_id
+	FROM stocktake_items i JOIN stocktakes t ON t.id = i.stocktake_id JOIN products p ON p.id = i.product_id`
+
+type StocktakeRepository struct {
+	db *sql.DB
+}
+
+func NewStocktakeRepository(db *sql.DB) *StocktakeRepository {
+	return &StocktakeRepository{
+		db: db,
+	}
+}
+
+func scanStocktake(row interface{ Scan(dest ...any) error }) (v1.Stocktake, error) {
+	var stocktake v1.Stocktake
+	err := row.Scan(&stocktake.Id, &stocktake.Name, &stocktake.CategoryId, &stocktake.Note, &stocktake.Status, &stocktake.Reason,
+		&stocktake.StartedAt, &stocktake.StartedBy, &stocktake.ClosedAt, &stocktake.ClosedBy)
+	stocktake.Items = &[]v1.StocktakeItem{}
+	return stocktake, err
+}
+
+// GetStocktakes returns the stocktakes, oldest first, optionally only those
+// in the given status.
+func (r *StocktakeRepository) GetStocktakes(ctx context.Context, status *v1.StocktakeStatus) ([]v1.Stocktake, error) {
+	where := ""
+	var args []any
+	if status != nil {
+		where = " WHERE status = ?"
+		args = append(args, *status)
+	}
+
+	stocktakes := []v1.Stocktake{}
+	rows, err := r.db.QueryContext(ctx, selectStocktakes+where+" ORDER BY id", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		stocktake, err := scanStocktake(rows)
+		if err != nil {
+			return nil, err
+		}
+		stocktakes = append(stocktakes, stocktake)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	itemWhere := " WHERE i.stocktake_id IN (SELECT id FROM stocktakes" + where + ")"
+	if err := r.attachStocktakeItems(ctx, stocktakes, itemWhere, args...); err != nil {
+		return nil, err
+	}
+	return stocktakes, nil
+}
+
+func (r *StocktakeRepository) GetStocktakeByID(ctx context.Context, id int) (*v1.Stocktake, error) {
+	stocktake, err := scanStocktake(r.db.QueryRowContext(ctx, selectStocktakes+" WHERE id = ?", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Stocktake not found
+		}
+		return nil, err
+	}
+
+	stocktakes := []v1.Stocktake{stocktake}
+	if err := r.attachStocktakeItems(ctx, stocktakes, " WHERE i.stocktake_id = ?", id); err != nil {
+		return nil, err
+	}
+	return &stocktakes[0], nil
+}
+
+// attachStocktakeItems loads the lines matching the filter and appends them
+// to the stocktakes they belong to.
+func (r *StocktakeRepository) attachStocktakeItems(ctx context.Context, stocktakes []v1.Stocktake, where string, args ...any) error {
+	index := make(map[int]int, len(stocktakes))
+	for i, stocktake := range stocktakes {
+		index[*stocktake.Id] = i
+	}
+
+	rows, err := r.db.QueryContext(ctx, selectStocktakeItems+where+" ORDER BY i.stocktake_id, p.name, p.id", args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var stocktakeID int
+		var item v1.StocktakeItem
+		if err := rows.Scan(&stocktakeID, &item.ProductId, &item.Name, &item.VariantLabel, &item.Unit, &item.ExpectedQuantity,
+			&item.CountedQuantity, &item.UnitCost, &item.MovedSinceStart, &item.StockMovementId); err != nil {
+			return err
+		}
+		if i, ok := index[stocktakeID]; ok {
+			*stocktakes[i].Items = append(*stocktakes[i].Items, item)
+		}
+	}
+	return rows.Err()
+}
+
+// CreateStocktake starts a stocktake of the products, freezing their stock on
+// hand and unit cost together with the position in the stock ledger, in a
+// single transaction, and returns the new stocktake ID. Only one stocktake
+// can be counting at a time, which is checked again within the transaction
+// for stocktakes started at the same time.
+func (r *StocktakeRepository) CreateStocktake(ctx context.Context, stocktake v1.Stocktake, productIDs []int) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var countingID int
+	err = tx.QueryRowContext(ctx, "SELECT id FROM stocktakes WHERE status = ? LIMIT 1", v1.Counting).Scan(&countingID)
+	switch {
+	case err == nil:
+		return 0, fmt.Errorf("%w: stocktake %d is still counting", ErrStocktakeInProgress, countingID)
+	case err != sql.ErrNoRows:
+		return 0, err
+	}
+
+	var startMovementID int
+	if err := tx.QueryRowContext(ctx, "SELECT COALESCE(MAX(id), 0) FROM stock_movements").Scan(&startMovementID); err != nil {
+		return 0, err
+	}
+	query := `INSERT INTO stocktakes (name, category_id, note, status, start_movement_id, started_at, started_by)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`
+	result, err := tx.ExecContext(ctx, query, stocktake.Name, stocktake.CategoryId, stocktake.Note, v1.Counting, startMovementID,
+		time.Now().UTC(), audit.User(ctx))
+	if err != nil {
+		return 0, err
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	stocktakeID := int(lastID)
+
+	query = `INSERT INTO stocktake_items (stocktake_id, product_id, expected_quantity, unit_cost)
+		SELECT ?, p.id, p.stock_on_hand, ` + unitCostOf + ` FROM products p WHERE p.id = ?`
+	for _, productID := range productIDs {
+		if _, err := tx.ExecContext(ctx, query, stocktakeID, productID); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return stocktakeID, nil
+}
+
+// GetStocktakeCounts returns the counts recorded for the stocktake in the
+// order they came in.
+func (r *StocktakeRepository) GetStocktakeCounts(ctx context.Context, stocktakeID int) ([]v1.StocktakeCount, error) {
+	counts := []v1.StocktakeCount{}
+
+	query := "SELECT id, product_id, quantity, device, counted_at, counted_by FROM stocktake_counts WHERE stocktake_id = ? ORDER BY id"
+	rows, err := r.db.QueryContext(ctx, query, stocktakeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var count v1.StocktakeCount
+		if err := rows.Scan(&count.Id, &count.ProductId, &count.Quantity, &count.Device, &count.CountedAt, &count.CountedBy); err != nil {
+			return nil, err
+		}
+		counts = append(counts, count)
+	}
+	return counts, rows.Err()
+}
+
+// AddStocktakeCounts records the counts from a device and adds them to the
+// counted quantities of the products, in a single transaction. It fails with
+// ErrStatusChanged when the stocktake is no longer counting, and with
+// ErrNegativeCount when a count would take a product below zero, as read
+// within the transaction for counts coming in at the same time.
+func (r *StocktakeRepository) AddStocktakeCounts(ctx context.Context, stocktakeID int, device *string, counts []v1.StocktakeCount) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var status v1.StocktakeStatus
+	if err := tx.QueryRowContext(ctx, "SELECT status FROM stocktakes WHERE id = ?", stocktakeID).Scan(&status); err != nil {
+		return err
+	}
+	if status != v1.Counting {
+		return fmt.Errorf("%w: stocktake %d", ErrStatusChanged, stocktakeID)
+	}
+
+	now, user := time.Now().UTC(), audit.User(ctx)
+	for _, count := range counts {
+		query := `INSERT INTO stocktake_counts (stocktake_id, product_id, quantity, device, counted_at, counted_by)
+			VALUES (?, ?, ?, ?, ?, ?)`
+		if _, err := tx.ExecContext(ctx, query, stocktakeID, count.ProductId, count.Quantity, device, now, user); err != nil {
+			return err
+		}
+		query = `UPDATE stocktake_items SET counted_quantity = ROUND(COALESCE(counted_quantity, 0) + ?, 6)
+			WHERE stocktake_id = ? AND product_id = ? AND ROUND(COALESCE(counted_quantity, 0) + ?, 6) >= 0`
+		result, err := tx.ExecContext(ctx, query, count.Quantity, stocktakeID, count.ProductId, count.Quantity)
+		if err != nil {
+			return err
+		}
+		if n, err := result.RowsAffected(); err != nil {
+			return err
+		} else if n != 1 {
+			return fmt.Errorf("%w: product %d", ErrNegativeCount, *count.ProductId)
+		}
+	}
+	return tx.Commit()
+}
+
+// ApproveStocktake posts an adjustment of the variance, counted less
+// expected, for each counted product whose count differs from the frozen
+// quantity, and closes the stocktake, in a single transaction. Movements
+// posted since the stocktake started are left as they are, so stock on hand
+// ends up at the counted quantity plus what moved during the count. Goods
+// found come in at the frozen unit cost; goods missing are costed by the
+// valuation method.
+func (r *StocktakeRepository) ApproveStocktake(ctx context.Context, id int, reason string, method v1.ValuationMethod) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := closeStocktake(ctx, tx, id, v1.Approved, &reason); err != nil {
+		return err
+	}
+
+	type variance struct {
+		itemID, productID  int
+		quantity, unitCost float64
+	}
+	var variances []variance
+	query := `SELECT id, product_id, ROUND(counted_quantity - expected_quantity, 6), unit_cost FROM stocktake_items
+		WHERE stocktake_id = ? AND counted_quantity IS NOT NULL AND ROUND(counted_quantity - expected_quantity, 6) != 0 ORDER BY id`
+	rows, err := tx.QueryContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var v variance
+		if err := rows.Scan(&v.itemID, &v.productID, &v.quantity, &v.unitCost); err != nil {
+			return err
+		}
+		variances = append(variances, v)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	note := fmt.Sprintf("Stocktake %d: %s", id, reason)
+	for _, v := range variances {
+		movementID, err := postStockMovement(ctx, tx, v1.StockMovement{
+			ProductId: &v.productID,
+			Quantity:  &v.quantity,
+			Reason:    reasonPtr(v1.StockMovementReasonAdjustment),
+			Note:      &note,
+			UnitCost:  &v.unitCost,
+		}, nil, method)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE stocktake_items SET stock_movement_id = ? WHERE id = ?", movementID, v.itemID); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// CancelStocktake closes the stocktake without adjusting stock.
+func (r *StocktakeRepository) CancelStocktake(ctx context.Context, id int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := closeStocktake(ctx, tx, id, v1.Cancelled, nil); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// closeStocktake moves a counting stocktake to the given status, marking the
+// position in the stock ledger where the count ends.
+func closeStocktake(ctx context.Context, tx *sql.Tx, id int, status v1.StocktakeStatus, reason *string) error {
+	query := `UPDATE stocktakes SET status = ?, reason = ?, end_movement_id = (SELECT COALESCE(MAX(id), 0) FROM stock_movements),
+		closed_at = ?, closed_by = ? WHERE id = ? AND status = ?`
+	result, err := tx.ExecContext(ctx, query, status, reason, time.Now().UTC(), audit.User(ctx), id, v1.Counting)
+	if err != nil {
+		return err
+	}
+	return expectOneRow(result, "stocktake", id)
+}
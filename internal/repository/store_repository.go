@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+)
+
+// StoreRepositoryInterface defines the methods for the store repository.
+type StoreRepositoryInterface interface {
+	GetAllStores(ctx context.Context) ([]v1.Store, error)
+	GetStoreByID(ctx context.Context, id int) (*v1.Store, error)
+	CreateStore(ctx context.Context, store v1.Store) (int, error)
+	UpdateStore(ctx context.Context, store v1.Store) error
+}
+
+const selectStores = "SELECT id, name, address, city, pincode, state_code, gstin, phone FROM stores"
+
+type StoreRepository struct {
+	db *sql.DB
+}
+
+func NewStoreRepository(db *sql.DB) *StoreRepository {
+	return &StoreRepository{
+		db: db,
+	}
+}
+
+func scanStore(row interface{ Scan(dest ...any) error }) (v1.Store, error) {
+	var store v1.Store
+	err := row.Scan(&store.Id, &store.Name, &store.Address, &store.City, &store.Pincode, &store.StateCode, &store.Gstin, &store.Phone)
+	return store, err
+}
+
+func (r *StoreRepository) GetAllStores(ctx context.Context) ([]v1.Store, error) {
+	stores := []v1.Store{}
+
+	rows, err := r.db.QueryContext(ctx, selectStores+" ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		store, err := scanStore(rows)
+		if err != nil {
+			return nil, err
+		}
+		stores = append(stores, store)
+	}
+	return stores, rows.Err()
+}
+
+func (r *StoreRepository) GetStoreByID(ctx context.Context, id int) (*v1.Store, error) {
+	store, err := scanStore(r.db.QueryRowContext(ctx, selectStores+" WHERE id = ?", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Store not found
+		}
+		return nil, err
+	}
+	return &store, nil
+}
+
+func (r *StoreRepository) CreateStore(ctx context.Context, store v1.Store) (int, error) {
+	query := "INSERT INTO stores (name, address, city, pincode, state_code, gstin, phone) VALUES (?, ?, ?, ?, ?, ?, ?)"
+	result, err := r.db.ExecContext(ctx, query, store.Name, store.Address, store.City, store.Pincode, store.StateCode, store.Gstin, store.Phone)
+	if err != nil {
+		return 0, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return int(id), nil
+}
+
+func (r *StoreRepository) UpdateStore(ctx context.Context, store v1.Store) error {
+	query := "UPDATE stores SET name = ?, address = ?, city = ?, pincode = ?, state_code = ?, gstin = ?, phone = ? WHERE id = ?"
+	_, err := r.db.ExecContext(ctx, query, store.Name, store.Address, store.City, store.Pincode, store.StateCode, store.Gstin, store.Phone,
+		store.Id)
+	return err
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+)
+
+// SupplierRepositoryInterface defines the methods for the supplier repository.
+type SupplierRepositoryInterface interface {
+	GetAllSuppliers(ctx context.Context) ([]v1.Supplier, error)
+	GetSupplierByID(ctx context.Context, id int) (*v1.Supplier, error)
+	GetSupplierByGSTIN(ctx context.Context, gstin string) (*v1.Supplier, error)
+	CreateSupplier(ctx context.Context, supplier v1.Supplier) (int, error)
+	UpdateSupplier(ctx context.Context, supplier v1.Supplier) error
+}
+
+const selectSuppliers = "SELECT id, legal_name, address, state_code, gstin, phone, email FROM suppliers"
+
+type SupplierRepository struct {
+	db *sql.DB
+}
+
+func NewSupplierRepository(db *sql.DB) *SupplierRepository {
+	return &SupplierRepository{
+		db: db,
+	}
+}
+
+func scanSupplier(row interface{ Scan(dest ...any) error }) (v1.Supplier, error) {
+	var supplier v1.Supplier
+	err := row.Scan(&supplier.Id, &supplier.LegalName, &supplier.Address, &supplier.StateCode, &supplier.Gstin, &supplier.Phone, &supplier.Email)
+	return supplier, err
+}
+
+func (r *SupplierRepository) GetAllSuppliers(ctx context.Context) ([]v1.Supplier, error) {
+	suppliers := []v1.Supplier{}
+
+	rows, err := r.db.QueryContext(ctx, selectSuppliers+" ORDER BY legal_name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		supplier, err := scanSupplier(rows)
+		if err != nil {
+			return nil, err
+		}
+		suppliers = append(suppliers, supplier)
+	}
+	return suppliers, rows.Err()
+}
+
+func (r *SupplierRepository) GetSupplierByID(ctx context.Context, id int) (*v1.Supplier, error) {
+	supplier, err := scanSupplier(r.db.QueryRowContext(ctx, selectSuppliers+" WHERE id = ?", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Supplier not found
+		}
+		return nil, err
+	}
+	return &supplier, nil
+}
+
+func (r *SupplierRepository) GetSupplierByGSTIN(ctx context.Context, gstin string) (*v1.Supplier, error) {
+	supplier, err := scanSupplier(r.db.QueryRowContext(ctx, selectSuppliers+" WHERE gstin = ?", gstin))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Supplier not found
+		}
+		return nil, err
+	}
+	return &supplier, nil
+}
+
+func (r *SupplierRepository) CreateSupplier(ctx context.Context, supplier v1.Supplier) (int, error) {
+	query := "INSERT INTO suppliers (legal_name, address, state_code, gstin, phone, email) VALUES (?, ?, ?, ?, ?, ?)"
+	result, err := r.db.ExecContext(ctx, query, supplier.LegalName, supplier.Address, supplier.StateCode, supplier.Gstin, supplier.Phone,
+		supplier.Email)
+	if err != nil {
+		return 0, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return int(id), nil
+}
+
+func (r *SupplierRepository) UpdateSupplier(ctx context.Context, supplier v1.Supplier) error {
+	query := "UPDATE suppliers SET legal_name = ?, address = ?, state_code = ?, gstin = ?, phone = ?, email = ? WHERE id = ?"
+	_, err := r.db.ExecContext(ctx, query, supplier.LegalName, supplier.Address, supplier.StateCode, supplier.Gstin, supplier.Phone,
+		supplier.Email, supplier.Id)
+	return err
+}
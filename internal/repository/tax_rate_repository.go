@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+)
+
+// TaxRateRepositoryInterface defines the methods for the tax rate repository.
+type TaxRateRepositoryInterface interface {
+	GetTaxRates(ctx context.Context, productID int) ([]v1.TaxRate, error)
+	ScheduleTaxRate(ctx context.Context, rate v1.TaxRate, before v1.Product) (v1.TaxRate, error)
+	GetTaxRateChanges(ctx context.Context) ([]v1.TaxRateChange, error)
+	GetTaxRateChangeByID(ctx context.Context, id int) (*v1.TaxRateChange, error)
+	CreateTaxRateChange(ctx context.Context, change v1.TaxRateChange, products []v1.Product) (v1.TaxRateChange, error)
+	DeleteTaxRateChange(ctx context.Context, id int) error
+}
+
+const selectTaxRateChanges = `SELECT c.id, NULLIF(c.hsn_code, ''), c.category_id, c.cgst_rate, c.sgst_rate, c.effective_from, c.created_at,
+	(SELECT COUNT(*) FROM product_tax_rates r WHERE r.rate_change_id = c.id)
+	FROM tax_rate_changes c`
+
+type TaxRateRepository struct {
+	db *sql.DB
+}
+
+func NewTaxRateRepository(db *sql.DB) *TaxRateRepository {
+	return &TaxRateRepository{
+		db: db,
+	}
+}
+
+func (r *TaxRateRepository) GetTaxRates(ctx context.Context, productID int) ([]v1.TaxRate, error) {
+	var rates []v1.TaxRate
+
+	query := "SELECT id, product_id, cgst_rate, sgst_rate, effective_from, rate_change_id FROM product_tax_rates WHERE product_id = ? ORDER BY effective_from, id"
+	rows, err := r.db.QueryContext(ctx, query, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rate v1.TaxRate
+		if err := rows.Scan(&rate.Id, &rate.ProductId, &rate.CgstRate, &rate.SgstRate, &rate.EffectiveFrom, &rate.RateChangeId); err != nil {
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+// ScheduleTaxRate stores a rate and records the change from the rates the
+// product had at its effective date in one transaction.
+func (r *TaxRateRepository) ScheduleTaxRate(ctx context.Context, rate v1.TaxRate, before v1.Product) (v1.TaxRate, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return v1.TaxRate{}, err
+	}
+	defer tx.Rollback()
+
+	rate, err = insertTaxRate(ctx, tx, rate)
+	if err != nil {
+		return v1.TaxRate{}, err
+	}
+	if err := insertRateChanges(ctx, tx, before, rate.CgstRate, rate.SgstRate, rate.EffectiveFrom, *rate.Id); err != nil {
+		return v1.TaxRate{}, err
+	}
+	return rate, tx.Commit()
+}
+
+func insertTaxRate(ctx context.Context, tx *sql.Tx, rate v1.TaxRate) (v1.TaxRate, error) {
+	query := "INSERT INTO product_tax_rates (product_id, cgst_rate, sgst_rate, effective_from) VALUES (?, ?, ?, ?)"
+	rate.EffectiveFrom = rate.EffectiveFrom.UTC()
+	result, err := tx.ExecContext(ctx, query, rate.ProductId, rate.CgstRate, rate.SgstRate, rate.EffectiveFrom)
+	if err != nil {
+		return v1.TaxRate{}, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return v1.TaxRate{}, err
+	}
+	rateID := int(id)
+	rate.Id = &rateID
+	rate.RateChangeId = nil
+	return rate, nil
+}
+
+// insertRateChanges records the change from the rates of the product to the
+// scheduled ones.
+func insertRateChanges(ctx context.Context, tx *sql.Tx, before v1.Product, cgstRate, sgstRate float32, effectiveFrom time.Time, taxRateID int) error {
+	after := v1.Product{CgstRate: &cgstRate, SgstRate: &sgstRate}
+	changes := PriceChanges(before, after, v1.PriceChangeSourceTaxRate, effectiveFrom)
+	return insertPriceChanges(ctx, tx, changes, &taxRateID, nil)
+}
+
+func (r *TaxRateRepository) GetTaxRateChanges(ctx context.Context) ([]v1.TaxRateChange, error) {
+	var changes []v1.TaxRateChange
+
+	rows, err := r.db.QueryContext(ctx, selectTaxRateChanges+" ORDER BY c.effective_from, c.id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		change, err := scanTaxRateChange(rows)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+func (r *TaxRateRepository) GetTaxRateChangeByID(ctx context.Context, id int) (*v1.TaxRateChange, error) {
+	change, err := scanTaxRateChange(r.db.QueryRowContext(ctx, selectTaxRateChanges+" WHERE c.id = ?", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Tax rate change not found
+		}
+		return nil, err
+	}
+	return &change, nil
+}
+
+// CreateTaxRateChange stores the change and schedules its rates for every
+// given product in a single transaction, recording the change from the rates
+// the products have at its effective date.
+func (r *TaxRateRepository) CreateTaxRateChange(ctx context.Context, change v1.TaxRateChange, products []v1.Product) (v1.TaxRateChange, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return v1.TaxRateChange{}, err
+	}
+	defer tx.Rollback()
+
+	change.EffectiveFrom = change.EffectiveFrom.UTC()
+	// A change by category alone is stored with an empty HSN code.
+	query := "INSERT INTO tax_rate_changes (hsn_code, category_id, cgst_rate, sgst_rate, effective_from) VALUES (COALESCE(?, ''), ?, ?, ?, ?)"
+	result, err := tx.ExecContext(ctx, query, change.HsnCode, change.CategoryId, change.CgstRate, change.SgstRate, change.EffectiveFrom)
+	if err != nil {
+		return v1.TaxRateChange{}, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return v1.TaxRateChange{}, err
+	}
+
+	query = "INSERT INTO product_tax_rates (product_id, cgst_rate, sgst_rate, effective_from, rate_change_id) VALUES (?, ?, ?, ?, ?)"
+	for _, product := range products {
+		result, err := tx.ExecContext(ctx, query, product.Id, change.CgstRate, change.SgstRate, change.EffectiveFrom, id)
+		if err != nil {
+			return v1.TaxRateChange{}, err
+		}
+		rateID, err := result.LastInsertId()
+		if err != nil {
+			return v1.TaxRateChange{}, err
+		}
+		if err := insertRateChanges(ctx, tx, product, change.CgstRate, change.SgstRate, change.EffectiveFrom, int(rateID)); err != nil {
+			return v1.TaxRateChange{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return v1.TaxRateChange{}, err
+	}
+
+	created, err := r.GetTaxRateChangeByID(ctx, int(id))
+	if err != nil {
+		return v1.TaxRateChange{}, err
+	}
+	return *created, nil
+}
+
+// DeleteTaxRateChange removes the change together with the product rates it
+// scheduled and their price history.
+func (r *TaxRateRepository) DeleteTaxRateChange(ctx context.Context, id int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := "DELETE FROM product_price_changes WHERE tax_rate_id IN (SELECT id FROM product_tax_rates WHERE rate_change_id = ?)"
+	if _, err := tx.ExecContext(ctx, query, id); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM product_tax_rates WHERE rate_change_id = ?", id); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM tax_rate_changes WHERE id = ?", id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func scanTaxRateChange(row interface{ Scan(dest ...any) error }) (v1.TaxRateChange, error) {
+	var change v1.TaxRateChange
+	err := row.Scan(&change.Id, &change.HsnCode, &change.CategoryId, &change.CgstRate, &change.SgstRate, &change.EffectiveFrom, &change.CreatedAt, &change.AffectedProducts)
+	return change, err
+}
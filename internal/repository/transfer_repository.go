@@ -0,0 +1,484 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/audit"
+)
+
+// TransferRepositoryInterface defines the methods for the transfer repository.
+type TransferRepositoryInterface interface {
+	GetTransfers(ctx context.Context, params v1.GetTransfersParams) ([]v1.Transfer, error)
+	GetTransferByID(ctx context.Context, id int) (*v1.Transfer, error)
+	CreateTransfer(ctx context.Context, transfer v1.Transfer) (int, error)
+	DispatchTransfer(ctx context.Context, id int, batches map[int][]v1.TransferItemBatch, method v1.ValuationMethod, allowNegative bool) error
+	ReceiveTransfer(ctx context.Context, transfer v1.Transfer, note *string) error
+	CancelTransfer(ctx context.Context, id int, status v1.TransferStatus) error
+}
+
+const selectTransfers = `SELECT t.id, t.direction, t.store_id, s.name, t.status, t.reference, t.note, t.receipt_note, t.created_at, t.created_by,
+	t.dispatched_at, t.dispatched_by, t.received_at, t.received_by
+	FROM transfers t JOIN stores s ON s.id = t.store_id`
+
+const selectTransferItems = `SELECT i.transfer_id, i.product_id, p.name, p.variant_label, p.hsn_code, p.unit, i.quantity, ROUND(i.unit_cost, 4),
+	i.received_quantity, i.stock_movement_id
+	FROM transfer_items i JOIN products p ON p.id = i.product_id`
+
+const selectTransferItemBatches = `SELECT i.transfer_id, i.product_id, b.batch_id, b.batch_no, b.expires_on, b.mrp, b.quantity
+	FROM transfer_item_batches b JOIN transfer_items i ON i.id = b.transfer_item_id`
+
+type TransferRepository struct {
+	db *sql.DB
+}
+
+func NewTransferRepository(db *sql.DB) *TransferRepository {
+	return &TransferRepository{
+		db: db,
+	}
+}
+
+func scanTransfer(row interface{ Scan(dest ...any) error }) (v1.Transfer, error) {
+	var transfer v1.Transfer
+	err := row.Scan(&transfer.Id, &transfer.Direction, &transfer.StoreId, &transfer.StoreName, &transfer.Status, &transfer.Reference,
+		&transfer.Note, &transfer.ReceiptNote, &transfer.CreatedAt, &transfer.CreatedBy, &transfer.DispatchedAt, &transfer.DispatchedBy,
+		&transfer.ReceivedAt, &transfer.ReceivedBy)
+	transfer.Items = []v1.TransferItem{}
+	return transfer, err
+}
+
+// GetTransfers returns the transfers matching the filters, oldest first.
+func (r *TransferRepository) GetTransfers(ctx context.Context, params v1.GetTransfersParams) ([]v1.Transfer, error) {
+	var conditions []string
+	var args []any
+	if params.Status != nil {
+		conditions = append(conditions, "t.status = ?")
+		args = append(args, *params.Status)
+	}
+	if params.Direction != nil {
+		conditions = append(conditions, "t.direction = ?")
+		args = append(args, *params.Direction)
+	}
+	if params.StoreId != nil {
+		conditions = append(conditions, "t.store_id = ?")
+		args = append(args, *params.StoreId)
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	transfers := []v1.Transfer{}
+	rows, err := r.db.QueryContext(ctx, selectTransfers+where+" ORDER BY t.id", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		transfer, err := scanTransfer(rows)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, transfer)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	itemWhere := " WHERE i.transfer_id IN (SELECT t.id FROM transfers t" + where + ")"
+	if err := r.attachTransferItems(ctx, transfers, itemWhere, args...); err != nil {
+		return nil, err
+	}
+	return transfers, nil
+}
+
+func (r *TransferRepository) GetTransferByID(ctx context.Context, id int) (*v1.Transfer, error) {
+	transfer, err := scanTransfer(r.db.QueryRowContext(ctx, selectTransfers+" WHERE t.id = ?", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil // Transfer not found
+		}
+		return nil, err
+	}
+
+	transfers := []v1.Transfer{transfer}
+	if err := r.attachTransferItems(ctx, transfers, " WHERE i.transfer_id = ?", id); err != nil {
+		return nil, err
+	}
+	return &transfers[0], nil
+}
+
+// attachTransferItems loads the lines matching the filter, with their
+// batches, and appends them to the transfers they belong to.
+func (r *TransferRepository) attachTransferItems(ctx context.Context, transfers []v1.Transfer, where string, args ...any) error {
+	index := make(map[int]int, len(transfers))
+	for i, transfer := range transfers {
+		index[*transfer.Id] = i
+	}
+
+	rows, err := r.db.QueryContext(ctx, selectTransferItems+where+" ORDER BY i.transfer_id, i.id", args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var transferID int
+		var item v1.TransferItem
+		if err := rows.Scan(&transferID, &item.ProductId, &item.Name, &item.VariantLabel, &item.HsnCode, &item.Unit, &item.Quantity,
+			&item.UnitCost, &item.ReceivedQuantity, &item.StockMovementId); err != nil {
+			return err
+		}
+		if i, ok := index[transferID]; ok {
+			transfers[i].Items = append(transfers[i].Items, item)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	rows, err = r.db.QueryContext(ctx, selectTransferItemBatches+where+" ORDER BY b.id", args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var transferID, productID int
+		var expiresOn string
+		var batch v1.TransferItemBatch
+		if err := rows.Scan(&transferID, &productID, &batch.BatchId, &batch.BatchNo, &expiresOn, &batch.Mrp, &batch.Quantity); err != nil {
+			return err
+		}
+		date, err := parseDate(expiresOn)
+		if err != nil {
+			return err
+		}
+		batch.ExpiresOn = *date
+		i, ok := index[transferID]
+		if !ok {
+			continue
+		}
+		for j := range transfers[i].Items {
+			if item := &transfers[i].Items[j]; item.ProductId == productID {
+				var batches []v1.TransferItemBatch
+				if item.Batches != nil {
+					batches = *item.Batches
+				}
+				batches = append(batches, batch)
+				item.Batches = &batches
+			}
+		}
+	}
+	return rows.Err()
+}
+
+// CreateTransfer records the transfer, its lines and the batches listed on
+// them in a single transaction and returns the new transfer ID. A transfer created in transit, as inbound
+// transfers are, is taken to have been dispatched when it was entered.
+func (r *TransferRepository) CreateTransfer(ctx context.Context, transfer v1.Transfer) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	now, user := time.Now().UTC(), audit.User(ctx)
+	var dispatchedAt *time.Time
+	var dispatchedBy *string
+	if *transfer.Status == v1.TransferInTransit {
+		dispatchedAt, dispatchedBy = &now, user
+	}
+	query := `INSERT INTO transfers (direction, store_id, status, reference, note, created_at, created_by, dispatched_at, dispatched_by)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	result, err := tx.ExecContext(ctx, query, transfer.Direction, transfer.StoreId, transfer.Status, transfer.Reference, transfer.Note, now, user,
+		dispatchedAt, dispatchedBy)
+	if err != nil {
+		return 0, err
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	transferID := int(lastID)
+
+	for _, item := range transfer.Items {
+		query = "INSERT INTO transfer_items (transfer_id, product_id, quantity, unit_cost) VALUES (?, ?, ?, ?)"
+		result, err := tx.ExecContext(ctx, query, transferID, item.ProductId, item.Quantity, item.UnitCost)
+		if err != nil {
+			return 0, err
+		}
+		itemID, err := result.LastInsertId()
+		if err != nil {
+			return 0, err
+		}
+		if item.Batches == nil {
+			continue
+		}
+		for _, batch := range *item.Batches {
+			if err := insertTransferItemBatch(ctx, tx, int(itemID), batch); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return transferID, nil
+}
+
+// DispatchTransfer takes the goods of a draft outbound transfer out of stock
+// and puts the transfer in transit, in a single transaction. Each line is
+// posted as transfer movements costed by the valuation method, one for each
+// batch allocated to it by product ID and one for the rest, and keeps the
+// unit cost it went out at so that the goods stay valued at cost in transit.
+// Stock is checked again as it is taken out, as ensureInStock does for
+// sales, for goods moved out at the same time.
+func (r *TransferRepository) DispatchTransfer(ctx context.Context, id int, batches map[int][]v1.TransferItemBatch, method v1.ValuationMethod,
+	allowNegative bool) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE transfers SET status = ?, dispatched_at = ?, dispatched_by = ? WHERE id = ? AND status = ? AND direction = ?`
+	result, err := tx.ExecContext(ctx, query, v1.TransferInTransit, time.Now().UTC(), audit.User(ctx), id, v1.TransferDraft, v1.Outbound)
+	if err != nil {
+		return err
+	}
+	if err := expectOneRow(result, "transfer", id); err != nil {
+		return err
+	}
+
+	var storeName string
+	query = "SELECT s.name FROM transfers t JOIN stores s ON s.id = t.store_id WHERE t.id = ?"
+	if err := tx.QueryRowContext(ctx, query, id).Scan(&storeName); err != nil {
+		return err
+	}
+	lines, err := transferLines(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	note := fmt.Sprintf("Transfer %d to %s", id, storeName)
+	post := func(line transferLine, quantity float64, batchID *int) (int, error) {
+		quantity = -quantity
+		movement := v1.StockMovement{
+			ProductId:  &line.productID,
+			BatchId:    batchID,
+			Quantity:   &quantity,
+			Reason:     reasonPtr(v1.StockMovementReasonTransfer),
+			TransferId: &id,
+			Note:       &note,
+		}
+		if err := ensureInStock(ctx, tx, movement, allowNegative); err != nil {
+			return 0, err
+		}
+		return postStockMovement(ctx, tx, movement, nil, method)
+	}
+	for _, line := range lines {
+		var movementIDs []int
+		rest := line.quantity
+		for _, batch := range batches[line.productID] {
+			movementID, err := post(line, batch.Quantity, batch.BatchId)
+			if err != nil {
+				return err
+			}
+			movementIDs = append(movementIDs, movementID)
+			if err := insertTransferItemBatch(ctx, tx, line.itemID, batch); err != nil {
+				return err
+			}
+			rest = math.Round((rest-batch.Quantity)*1e6) / 1e6
+		}
+		if rest > 0 || len(movementIDs) == 0 {
+			movementID, err := post(line, rest, nil)
+			if err != nil {
+				return err
+			}
+			movementIDs = append(movementIDs, movementID)
+		}
+
+		query = `UPDATE transfer_items SET stock_movement_id = ?,
+			unit_cost = (SELECT SUM(quantity * unit_cost) / SUM(quantity) FROM stock_movements WHERE transfer_id = ? AND product_id = ?)
+			WHERE id = ?`
+		if _, err := tx.ExecContext(ctx, query, movementIDs[0], id, line.productID, line.itemID); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// ReceiveTransfer records the received quantity of each line of a transfer in
+// transit and closes it, in a single transaction. The goods of an inbound
+// transfer are brought into stock as transfer movements at the unit cost they
+// were dispatched at. Goods of a line with batches go into those batches in
+// turn, up to the quantity dispatched in each, the last taking any excess.
+func (r *TransferRepository) ReceiveTransfer(ctx context.Context, transfer v1.Transfer, note *string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	id := *transfer.Id
+	query := `UPDATE transfers SET status = ?, receipt_note = ?, received_at = ?, received_by = ? WHERE id = ? AND status = ?`
+	result, err := tx.ExecContext(ctx, query, v1.TransferReceived, note, time.Now().UTC(), audit.User(ctx), id, v1.TransferInTransit)
+	if err != nil {
+		return err
+	}
+	if err := expectOneRow(result, "transfer", id); err != nil {
+		return err
+	}
+
+	movementNote := fmt.Sprintf("Transfer %d from %s", id, *transfer.StoreName)
+	for _, item := range transfer.Items {
+		received := *item.ReceivedQuantity
+		query = "UPDATE transfer_items SET received_quantity = ? WHERE transfer_id = ? AND product_id = ?"
+		if _, err := tx.ExecContext(ctx, query, received, id, item.ProductId); err != nil {
+			return err
+		}
+		if transfer.Direction != v1.Inbound || received == 0 {
+			continue
+		}
+
+		// Goods only come in, so the valuation method does not apply.
+		post := func(quantity float64, batchID *int) (int, error) {
+			return postStockMovement(ctx, tx, v1.StockMovement{
+				ProductId:  &item.ProductId,
+				BatchId:    batchID,
+				Quantity:   &quantity,
+				Reason:     reasonPtr(v1.StockMovementReasonTransfer),
+				TransferId: &id,
+				Note:       &movementNote,
+				UnitCost:   item.UnitCost,
+			}, nil, "")
+		}
+		batches, err := transferItemBatches(ctx, tx, id, item.ProductId)
+		if err != nil {
+			return err
+		}
+		var movementIDs []int
+		if len(batches) == 0 {
+			movementID, err := post(received, nil)
+			if err != nil {
+				return err
+			}
+			movementIDs = append(movementIDs, movementID)
+		}
+		rest := received
+		for i, batch := range batches {
+			quantity := math.Min(rest, batch.quantity)
+			if i == len(batches)-1 {
+				quantity = rest
+			}
+			if quantity <= 0 {
+				break
+			}
+			rest = math.Round((rest-quantity)*1e6) / 1e6
+			batchID, err := upsertBatch(ctx, tx, item.ProductId, batch.batch)
+			if err != nil {
+				return err
+			}
+			movementID, err := post(quantity, &batchID)
+			if err != nil {
+				return err
+			}
+			movementIDs = append(movementIDs, movementID)
+			if _, err := tx.ExecContext(ctx, "UPDATE transfer_item_batches SET batch_id = ? WHERE id = ?", batchID, batch.id); err != nil {
+				return err
+			}
+		}
+
+		query = "UPDATE transfer_items SET stock_movement_id = ? WHERE transfer_id = ? AND product_id = ?"
+		if _, err := tx.ExecContext(ctx, query, movementIDs[0], id, item.ProductId); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// CancelTransfer cancels a transfer that is still in the given status.
+func (r *TransferRepository) CancelTransfer(ctx context.Context, id int, status v1.TransferStatus) error {
+	result, err := r.db.ExecContext(ctx, "UPDATE transfers SET status = ? WHERE id = ? AND status = ?", v1.TransferCancelled, id, status)
+	if err != nil {
+		return err
+	}
+	return expectOneRow(result, "transfer", id)
+}
+
+type transferLine struct {
+	itemID, productID int
+	quantity          float64
+}
+
+// transferLines reads the lines of a transfer within the caller's
+// transaction.
+func transferLines(ctx context.Context, tx *sql.Tx, id int) ([]transferLine, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT id, product_id, quantity FROM transfer_items WHERE transfer_id = ? ORDER BY id", id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var lines []transferLine
+	for rows.Next() {
+		var line transferLine
+		if err := rows.Scan(&line.itemID, &line.productID, &line.quantity); err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+	return lines, rows.Err()
+}
+
+// insertTransferItemBatch records a batch of a transfer line within the
+// caller's transaction.
+func insertTransferItemBatch(ctx context.Context, tx *sql.Tx, itemID int, batch v1.TransferItemBatch) error {
+	query := `INSERT INTO transfer_item_batches (transfer_item_id, batch_id, batch_no, expires_on, mrp, quantity)
+		VALUES (?, ?, ?, ?, ?, ?)`
+	_, err := tx.ExecContext(ctx, query, itemID, batch.BatchId, batch.BatchNo, batch.ExpiresOn.Format(time.DateOnly), batch.Mrp, batch.Quantity)
+	return err
+}
+
+type transferBatch struct {
+	id       int
+	batch    v1.ProductBatch
+	quantity float64
+}
+
+// transferItemBatches reads the batches of a transfer line within the
+// caller's transaction, in the order they were listed.
+func transferItemBatches(ctx context.Context, tx *sql.Tx, transferID, productID int) ([]transferBatch, error) {
+	query := `SELECT b.id, b.batch_no, b.expires_on, b.mrp, b.quantity
+		FROM transfer_item_batches b JOIN transfer_items i ON i.id = b.transfer_item_id
+		WHERE i.transfer_id = ? AND i.product_id = ? ORDER BY b.id`
+	rows, err := tx.QueryContext(ctx, query, transferID, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var batches []transferBatch
+	for rows.Next() {
+		var batch transferBatch
+		var expiresOn string
+		if err := rows.Scan(&batch.id, &batch.batch.BatchNo, &expiresOn, &batch.batch.Mrp, &batch.quantity); err != nil {
+			return nil, err
+		}
+		if batch.batch.ExpiresOn, err = parseDate(expiresOn); err != nil {
+			return nil, err
+		}
+		batches = append(batches, batch)
+	}
+	return batches, rows.Err()
+}
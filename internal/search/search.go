@@ -0,0 +1,190 @@
+// Package search turns product names and cashier queries into the terms and
+// phonetic keys the product search index matches on.
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Terms splits text into lower-case words of letters and digits.
+func Terms(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.Is(unicode.Mn, r) && !unicode.Is(unicode.Mc, r)
+	})
+}
+
+// Key returns the phonetic keys of the words in text, separated by spaces.
+// Devanagari is romanised first, so that चावल, chawal and chaawal all share
+// the key caval.
+func Key(text string) string {
+	terms := Terms(text)
+	keys := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if key := WordKey(term); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return strings.Join(keys, " ")
+}
+
+// romanFolds collapse the common spelling variants of romanised Indian
+// names. They are applied in order.
+var romanFolds = strings.NewReplacer(
+	"ee", "i",
+	"oo", "u",
+	"ph", "f",
+	"sh", "s",
+	"w", "v",
+	"z", "j",
+	"q", "k",
+)
+
+// WordKey returns the phonetic key of a single lower-case word: romanised,
+// with spelling variants folded, aspiration dropped and repeated letters
+// collapsed.
+func WordKey(word string) string {
+	word = romanFolds.Replace(Romanize(word))
+
+	var b strings.Builder
+	var prev rune
+	for i, r := range word {
+		switch {
+		case r == prev && unicode.IsLetter(r):
+			continue
+		case r == 'h' && i > 0 && !isVowel(prev):
+			// kh, gh, ch, th, dh and bh fold to the unaspirated consonant.
+			continue
+		}
+		b.WriteRune(r)
+		prev = r
+	}
+	return b.String()
+}
+
+func isVowel(r rune) bool {
+	return strings.ContainsRune("aeiouy", r)
+}
+
+var (
+	devanagariVowels = map[rune]string{
+		'अ': "a", 'आ': "aa", 'इ': "i", 'ई': "ii", 'उ': "u", 'ऊ': "uu", 'ऋ': "ri",
+		'ए': "e", 'ऐ': "ai", 'ओ': "o", 'औ': "au", 'ऑ': "o",
+	}
+	devanagariSigns = map[rune]string{
+		'ा': "aa", 'ि': "i", 'ी': "ii", 'ु': "u", 'ू': "uu", 'ृ': "ri",
+		'े': "e", 'ै': "ai", 'ो': "o", 'ौ': "au", 'ॉ': "o",
+	}
+	devanagariConsonants = map[rune]string{
+		'क': "k", 'ख': "kh", 'ग': "g", 'घ': "gh", 'ङ': "n",
+		'च': "ch", 'छ': "chh", 'ज': "j", 'झ': "jh", 'ञ': "n",
+		'ट': "t", 'ठ': "th", 'ड': "d", 'ढ': "dh", 'ण': "n",
+		'त': "t", 'थ': "th", 'द': "d", 'ध': "dh", 'न': "n",
+		'प': "p", 'फ': "ph", 'ब': "b", 'भ': "bh", 'म': "m",
+		'य': "y", 'र': "r", 'ल': "l", 'ळ': "l", 'व': "v",
+		'श': "sh", 'ष': "sh", 'स': "s", 'ह': "h",
+	}
+)
+
+const (
+	virama     = '्'
+	nukta      = '़'
+	anusvara   = 'ं'
+	chandrabin = 'ँ'
+	visarga    = 'ः'
+)
+
+// Romanize transliterates Devanagari in text to Latin letters, leaving other
+// scripts as they are. Consonants carry an inherent a unless followed by a
+// vowel sign or virama, and the inherent a at the end of a word is dropped as
+// it is in Hindi.
+func Romanize(text string) string {
+	runes := []rune(text)
+	var b strings.Builder
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if consonant, ok := devanagariConsonants[r]; ok {
+			b.WriteString(consonant)
+			next := i + 1
+			if next < len(runes) && runes[next] == nukta {
+				next++
+			}
+			switch {
+			case next < len(runes) && runes[next] == virama:
+				i = next
+			case next < len(runes) && devanagariSigns[runes[next]] != "":
+				b.WriteString(devanagariSigns[runes[next]])
+				i = next
+			case next < len(runes) && isDevanagariLetter(runes[next]):
+				b.WriteString("a")
+				i = next - 1
+			default:
+				i = next - 1
+			}
+			continue
+		}
+
+		switch {
+		case devanagariVowels[r] != "":
+			b.WriteString(devanagariVowels[r])
+		case r == anusvara || r == chandrabin:
+			b.WriteString("n")
+		case r == visarga:
+			b.WriteString("h")
+		case r == nukta || r == virama:
+		case r >= '०' && r <= '९':
+			b.WriteRune('0' + r - '०')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func isDevanagariLetter(r rune) bool {
+	_, consonant := devanagariConsonants[r]
+	_, vowel := devanagariVowels[r]
+	return consonant || vowel || r == anusvara || r == chandrabin || r == visarga
+}
+
+// MaxDistance is the number of typos tolerated in a search term: none in
+// short terms, one from four letters and two from eight.
+func MaxDistance(term string) int {
+	switch n := len([]rune(term)); {
+	case n >= 8:
+		return 2
+	case n >= 4:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Distance returns the optimal string alignment distance between a and b:
+// the number of insertions, deletions, substitutions and transpositions of
+// adjacent letters needed to turn one into the other.
+func Distance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	d := make([][]int, len(s)+1)
+	for i := range d {
+		d[i] = make([]int, len(t)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(s); i++ {
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && s[i-1] == t[j-2] && s[i-2] == t[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(s)][len(t)]
+}
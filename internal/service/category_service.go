@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/repository"
+	"go.uber.org/zap"
+)
+
+// categoryPathSeparator joins the names of a category and its ancestors.
+const categoryPathSeparator = " > "
+
+type CategoryServiceInterface interface {
+	GetCategories(ctx context.Context) ([]v1.Category, error)
+	GetCategory(ctx context.Context, id int) (v1.Category, error)
+	PostCategory(ctx context.Context, category v1.Category) (v1.Category, error)
+	PutCategory(ctx context.Context, category v1.Category) (v1.Category, error)
+	DeleteCategory(ctx context.Context, id int) error
+}
+
+type CategoryService struct {
+	categoryRepo *repository.CategoryRepository
+	logger       *zap.SugaredLogger
+}
+
+func NewCategoryService(categoryRepository *repository.CategoryRepository, logger *zap.SugaredLogger) *CategoryService {
+	return &CategoryService{
+		categoryRepo: categoryRepository,
+		logger:       logger,
+	}
+}
+
+// GetCategories returns the whole tree ordered by path, so that every
+// category follows its parent.
+func (s *CategoryService) GetCategories(ctx context.Context) ([]v1.Category, error) {
+	categories, err := s.categoryRepo.GetAllCategories(ctx)
+	if err != nil {
+		s.logger.Debugw("Failed to get categories", "error", err)
+		return nil, err
+	}
+
+	byID := categoriesByID(categories)
+	for i := range categories {
+		path := categoryPath(byID, *categories[i].Id)
+		categories[i].Path = &path
+	}
+	sort.SliceStable(categories, func(i, j int) bool {
+		return *categories[i].Path < *categories[j].Path
+	})
+	return categories, nil
+}
+
+func (s *CategoryService) GetCategory(ctx context.Context, id int) (v1.Category, error) {
+	categories, err := s.GetCategories(ctx)
+	if err != nil {
+		return v1.Category{}, err
+	}
+	for _, category := range categories {
+		if *category.Id == id {
+			return category, nil
+		}
+	}
+	return v1.Category{}, ErrCategoryNotFound
+}
+
+func (s *CategoryService) PostCategory(ctx context.Context, category v1.Category) (v1.Category, error) {
+	if err := s.validateCategory(ctx, &category); err != nil {
+		return v1.Category{}, err
+	}
+
+	id, err := s.categoryRepo.CreateCategory(ctx, category)
+	if err != nil {
+		s.logger.Debugw("Failed to create category", "error", err, "category", category)
+		return v1.Category{}, err
+	}
+
+	s.logger.Infow("Category created", "category_id", id, "name", category.Name)
+	return s.GetCategory(ctx, id)
+}
+
+func (s *CategoryService) PutCategory(ctx context.Context, category v1.Category) (v1.Category, error) {
+	existing, err := s.categoryRepo.GetCategoryByID(ctx, *category.Id)
+	if err != nil {
+		s.logger.Debugw("Failed to get category by ID", "error", err, "category_id", *category.Id)
+		return v1.Category{}, err
+	}
+	if existing == nil {
+		return v1.Category{}, ErrCategoryNotFound
+	}
+	if err := s.validateCategory(ctx, &category); err != nil {
+		return v1.Category{}, err
+	}
+
+	if err := s.categoryRepo.UpdateCategory(ctx, category); err != nil {
+		s.logger.Debugw("Failed to update category", "error", err, "category", category)
+		return v1.Category{}, err
+	}
+	return s.GetCategory(ctx, *category.Id)
+}
+
+// DeleteCategory removes a category that has neither subcategories nor
+// products, so that nothing is left pointing at it.
+func (s *CategoryService) DeleteCategory(ctx context.Context, id int) error {
+	existing, err := s.categoryRepo.GetCategoryByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get category by ID", "error", err, "category_id", id)
+		return err
+	}
+	if existing == nil {
+		return ErrCategoryNotFound
+	}
+
+	children, products, err := s.categoryRepo.CountCategoryUsage(ctx, id)
+	if err != nil {
+		return err
+	}
+	if children > 0 || products > 0 {
+		return fmt.Errorf("%w: category %d has %d subcategories and %d products", ErrCategoryInUse, id, children, products)
+	}
+
+	if err := s.categoryRepo.DeleteCategory(ctx, id); err != nil {
+		s.logger.Debugw("Failed to delete category", "error", err, "category_id", id)
+		return err
+	}
+	return nil
+}
+
+// validateCategory trims the name and checks that the parent exists, is not
+// the category itself or one of its descendants, and has no other child of
+// the same name.
+func (s *CategoryService) validateCategory(ctx context.Context, category *v1.Category) error {
+	category.Name = strings.TrimSpace(category.Name)
+	if category.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidCategory)
+	}
+	if category.HsnCode != nil && *category.HsnCode == "" {
+		category.HsnCode = nil
+	}
+
+	if category.ParentId != nil {
+		categories, err := s.categoryRepo.GetAllCategories(ctx)
+		if err != nil {
+			return err
+		}
+		byID := categoriesByID(categories)
+		if _, ok := byID[*category.ParentId]; !ok {
+			return fmt.Errorf("%w: parent category %d not found", ErrInvalidCategory, *category.ParentId)
+		}
+		if category.Id != nil {
+			for id := category.ParentId; id != nil; id = byID[*id].ParentId {
+				if *id == *category.Id {
+					return fmt.Errorf("%w: category %d cannot be moved under itself", ErrInvalidCategory, *category.Id)
+				}
+			}
+		}
+	}
+
+	existing, err := s.categoryRepo.GetCategoryByName(ctx, category.ParentId, category.Name)
+	if err != nil {
+		s.logger.Debugw("Failed to get category by name", "error", err, "name", category.Name)
+		return err
+	}
+	if existing != nil && (category.Id == nil || *existing.Id != *category.Id) {
+		return fmt.Errorf("%w: %q already exists as category %d", ErrInvalidCategory, category.Name, *existing.Id)
+	}
+	return nil
+}
+
+func categoriesByID(categories []v1.Category) map[int]v1.Category {
+	byID := make(map[int]v1.Category, len(categories))
+	for _, category := range categories {
+		byID[*category.Id] = category
+	}
+	return byID
+}
+
+// categoryAncestors returns the category and its parents, nearest first. The
+// walk stops at a missing parent so a damaged tree cannot loop forever.
+func categoryAncestors(byID map[int]v1.Category, id *int) []int {
+	var ids []int
+	seen := map[int]bool{}
+	for ; id != nil && !seen[*id]; id = byID[*id].ParentId {
+		seen[*id] = true
+		ids = append(ids, *id)
+	}
+	return ids
+}
+
+// categoryPath joins the names from the root down to the category. The walk
+// stops at a missing parent so a damaged tree cannot loop forever.
+func categoryPath(byID map[int]v1.Category, id int) string {
+	var names []string
+	seen := map[int]bool{}
+	for category, ok := byID[id]; ok && !seen[*category.Id]; category, ok = byID[valueOrZero(category.ParentId)] {
+		seen[*category.Id] = true
+		names = append([]string{category.Name}, names...)
+	}
+	return strings.Join(names, categoryPathSeparator)
+}
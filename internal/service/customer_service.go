@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/gst"
+	"github.com/nitinjangam/pos-receipt-system/internal/repository"
+	"go.uber.org/zap"
+)
+
+type CustomerServiceInterface interface {
+	GetCustomers(ctx context.Context) ([]v1.Customer, error)
+	GetCustomer(ctx context.Context, id int) (v1.Customer, error)
+	PostCustomer(ctx context.Context, customer v1.Customer) (v1.Customer, error)
+	PutCustomer(ctx context.Context, customer v1.Customer) (v1.Customer, error)
+	GetCustomerGroups(ctx context.Context) ([]v1.CustomerGroup, error)
+	PostCustomerGroup(ctx context.Context, group v1.CustomerGroup) (v1.CustomerGroup, error)
+	PutCustomerGroup(ctx context.Context, group v1.CustomerGroup) (v1.CustomerGroup, error)
+	DeleteCustomerGroup(ctx context.Context, id int) error
+}
+
+type CustomerService struct {
+	customerRepo  *repository.CustomerRepository
+	priceListRepo *repository.PriceListRepository
+	logger        *zap.SugaredLogger
+}
+
+func NewCustomerService(customerRepository *repository.CustomerRepository, priceListRepository *repository.PriceListRepository, logger *zap.SugaredLogger) *CustomerService {
+	return &CustomerService{
+		customerRepo:  customerRepository,
+		priceListRepo: priceListRepository,
+		logger:        logger,
+	}
+}
+
+func (s *CustomerService) GetCustomers(ctx context.Context) ([]v1.Customer, error) {
+	customers, err := s.customerRepo.GetAllCustomers(ctx)
+	if err != nil {
+		s.logger.Debugw("Failed to get customers", "error", err)
+		return nil, err
+	}
+	for i := range customers {
+		withStateName(&customers[i])
+	}
+	return customers, nil
+}
+
+func (s *CustomerService) GetCustomer(ctx context.Context, id int) (v1.Customer, error) {
+	customer, err := s.customerRepo.GetCustomerByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get customer by ID", "error", err, "customer_id", id)
+		return v1.Customer{}, err
+	}
+	if customer == nil {
+		return v1.Customer{}, ErrCustomerNotFound
+	}
+	withStateName(customer)
+	return *customer, nil
+}
+
+func (s *CustomerService) PostCustomer(ctx context.Context, customer v1.Customer) (v1.Customer, error) {
+	if err := s.validateCustomer(ctx, &customer); err != nil {
+		return v1.Customer{}, err
+	}
+
+	id, err := s.customerRepo.CreateCustomer(ctx, customer)
+	if err != nil {
+		s.logger.Debugw("Failed to create customer", "error", err, "customer", customer)
+		return v1.Customer{}, err
+	}
+	customer.Id = &id
+	withStateName(&customer)
+	return customer, nil
+}
+
+func (s *CustomerService) PutCustomer(ctx context.Context, customer v1.Customer) (v1.Customer, error) {
+	existing, err := s.customerRepo.GetCustomerByID(ctx, *customer.Id)
+	if err != nil {
+		s.logger.Debugw("Failed to get customer by ID", "error", err, "customer_id", *customer.Id)
+		return v1.Customer{}, err
+	}
+	if existing == nil {
+		return v1.Customer{}, ErrCustomerNotFound
+	}
+	if err := s.validateCustomer(ctx, &customer); err != nil {
+		return v1.Customer{}, err
+	}
+
+	if err := s.customerRepo.UpdateCustomer(ctx, customer); err != nil {
+		s.logger.Debugw("Failed to update customer", "error", err, "customer", customer)
+		return v1.Customer{}, err
+	}
+	withStateName(&customer)
+	return customer, nil
+}
+
+// validateCustomer normalizes the customer in place and checks the GSTIN
+// format, state-code prefix and check character, and that the group and price
+// list exist. The state code is taken from the GSTIN when not given.
+func (s *CustomerService) validateCustomer(ctx context.Context, customer *v1.Customer) error {
+	customer.LegalName = strings.TrimSpace(customer.LegalName)
+	if customer.LegalName == "" {
+		return fmt.Errorf("%w: legal name is required", ErrInvalidCustomer)
+	}
+	gstin, stateCode, err := normalizeGSTINAndState(customer.Gstin, customer.StateCode)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidCustomer, err)
+	}
+	customer.Gstin, customer.StateCode = gstin, stateCode
+
+	if gstin != nil {
+		existing, err := s.customerRepo.GetCustomerByGSTIN(ctx, *gstin)
+		if err != nil {
+			s.logger.Debugw("Failed to get customer by GSTIN", "error", err, "gstin", *gstin)
+			return err
+		}
+		if existing != nil && (customer.Id == nil || *existing.Id != *customer.Id) {
+			return fmt.Errorf("%w: GSTIN %s is already registered to customer %d", ErrInvalidCustomer, *gstin, *existing.Id)
+		}
+	}
+
+	if customer.GroupId != nil {
+		group, err := s.customerRepo.GetCustomerGroupByID(ctx, *customer.GroupId)
+		if err != nil {
+			s.logger.Debugw("Failed to get customer group by ID", "error", err, "group_id", *customer.GroupId)
+			return err
+		}
+		if group == nil {
+			return fmt.Errorf("%w: customer group %d not found", ErrInvalidCustomer, *customer.GroupId)
+		}
+	}
+	return s.checkPriceList(ctx, customer.PriceListId, ErrInvalidCustomer)
+}
+
+func (s *CustomerService) GetCustomerGroups(ctx context.Context) ([]v1.CustomerGroup, error) {
+	groups, err := s.customerRepo.GetAllCustomerGroups(ctx)
+	if err != nil {
+		s.logger.Debugw("Failed to get customer groups", "error", err)
+		return nil, err
+	}
+	return groups, nil
+}
+
+func (s *CustomerService) PostCustomerGroup(ctx context.Context, group v1.CustomerGroup) (v1.CustomerGroup, error) {
+	if err := s.validateCustomerGroup(ctx, &group); err != nil {
+		return v1.CustomerGroup{}, err
+	}
+
+	id, err := s.customerRepo.CreateCustomerGroup(ctx, group)
+	if err != nil {
+		s.logger.Debugw("Failed to create customer group", "error", err, "group", group)
+		return v1.CustomerGroup{}, err
+	}
+	group.Id = &id
+	return group, nil
+}
+
+func (s *CustomerService) PutCustomerGroup(ctx context.Context, group v1.CustomerGroup) (v1.CustomerGroup, error) {
+	existing, err := s.customerRepo.GetCustomerGroupByID(ctx, *group.Id)
+	if err != nil {
+		s.logger.Debugw("Failed to get customer group by ID", "error", err, "group_id", *group.Id)
+		return v1.CustomerGroup{}, err
+	}
+	if existing == nil {
+		return v1.CustomerGroup{}, ErrCustomerGroupNotFound
+	}
+	if err := s.validateCustomerGroup(ctx, &group); err != nil {
+		return v1.CustomerGroup{}, err
+	}
+
+	if err := s.customerRepo.UpdateCustomerGroup(ctx, group); err != nil {
+		s.logger.Debugw("Failed to update customer group", "error", err, "group", group)
+		return v1.CustomerGroup{}, err
+	}
+	return group, nil
+}
+
+// DeleteCustomerGroup removes a group that no customer belongs to.
+func (s *CustomerService) DeleteCustomerGroup(ctx context.Context, id int) error {
+	existing, err := s.customerRepo.GetCustomerGroupByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get customer group by ID", "error", err, "group_id", id)
+		return err
+	}
+	if existing == nil {
+		return ErrCustomerGroupNotFound
+	}
+
+	customers, err := s.customerRepo.CountGroupCustomers(ctx, id)
+	if err != nil {
+		return err
+	}
+	if customers > 0 {
+		return fmt.Errorf("%w: customer group %d has %d customers", ErrCustomerGroupInUse, id, customers)
+	}
+
+	if err := s.customerRepo.DeleteCustomerGroup(ctx, id); err != nil {
+		s.logger.Debugw("Failed to delete customer group", "error", err, "group_id", id)
+		return err
+	}
+	return nil
+}
+
+// validateCustomerGroup trims the name and checks that it is unique and that
+// the price list exists.
+func (s *CustomerService) validateCustomerGroup(ctx context.Context, group *v1.CustomerGroup) error {
+	group.Name = strings.TrimSpace(group.Name)
+	if group.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidCustomerGroup)
+	}
+
+	existing, err := s.customerRepo.GetCustomerGroupByName(ctx, group.Name)
+	if err != nil {
+		s.logger.Debugw("Failed to get customer group by name", "error", err, "name", group.Name)
+		return err
+	}
+	if existing != nil && (group.Id == nil || *existing.Id != *group.Id) {
+		return fmt.Errorf("%w: %q already exists as customer group %d", ErrInvalidCustomerGroup, group.Name, *existing.Id)
+	}
+	return s.checkPriceList(ctx, group.PriceListId, ErrInvalidCustomerGroup)
+}
+
+// checkPriceList reports an unknown price list as invalid.
+func (s *CustomerService) checkPriceList(ctx context.Context, id *int, invalid error) error {
+	if id == nil {
+		return nil
+	}
+	priceList, err := s.priceListRepo.GetPriceListByID(ctx, *id)
+	if err != nil {
+		s.logger.Debugw("Failed to get price list by ID", "error", err, "price_list_id", *id)
+		return err
+	}
+	if priceList == nil {
+		return fmt.Errorf("%w: price list %d not found", invalid, *id)
+	}
+	return nil
+}
+
+// normalizeGSTINAndState validates an optional GSTIN and state code. Blank
+// values are treated as absent, and the state code is taken from the GSTIN
+// when it is not given.
+func normalizeGSTINAndState(gstin, stateCode *string) (*string, *string, error) {
+	if stateCode != nil && *stateCode == "" {
+		stateCode = nil
+	}
+	if stateCode != nil {
+		if _, ok := gst.StateName(*stateCode); !ok {
+			return nil, nil, fmt.Errorf("unknown state code %s", *stateCode)
+		}
+	}
+	if gstin == nil {
+		return nil, stateCode, nil
+	}
+
+	normalized := gst.NormalizeGSTIN(*gstin)
+	if normalized == "" {
+		return nil, stateCode, nil
+	}
+	if err := gst.ValidateGSTIN(normalized); err != nil {
+		return nil, nil, err
+	}
+	gstinState := normalized[:2]
+	if stateCode == nil {
+		stateCode = &gstinState
+	} else if *stateCode != gstinState {
+		return nil, nil, fmt.Errorf("state code %s does not match GSTIN state code %s", *stateCode, gstinState)
+	}
+	return &normalized, stateCode, nil
+}
+
+func withStateName(customer *v1.Customer) {
+	if customer.StateCode == nil {
+		return
+	}
+	if name, ok := gst.StateName(*customer.StateCode); ok {
+		customer.State = &name
+	}
+}
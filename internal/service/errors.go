@@ -0,0 +1,72 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/nitinjangam/pos-receipt-system/internal/repository"
+)
+
+var (
+	ErrProductNotFound       = errors.New("product not found")
+	ErrInvalidProduct        = errors.New("invalid product")
+	ErrProductConflict       = errors.New("product conflict")
+	ErrProductSold           = repository.ErrProductSold
+	ErrProductPurchased      = repository.ErrProductPurchased
+	ErrProductStocked        = repository.ErrProductStocked
+	ErrInvalidBarcode        = errors.New("invalid barcode")
+	ErrInvalidImport         = errors.New("invalid import")
+	ErrInvalidBulkUpdate     = errors.New("invalid bulk update")
+	ErrImageNotFound         = errors.New("image not found")
+	ErrInvalidImage          = errors.New("invalid image")
+	ErrImageTooLarge         = errors.New("image too large")
+	ErrCategoryNotFound      = errors.New("category not found")
+	ErrInvalidCategory       = errors.New("invalid category")
+	ErrCategoryInUse         = errors.New("category is in use")
+	ErrPriceScheduleNotFound = errors.New("price schedule not found")
+	ErrInvalidPriceSchedule  = errors.New("invalid price schedule")
+	ErrPriceScheduleConflict = errors.New("price schedule overlaps another")
+	ErrPriceScheduleEnded    = errors.New("price schedule has ended")
+	ErrTaxRateChangeNotFound = errors.New("tax rate change not found")
+	ErrTaxRateChangeInEffect = errors.New("tax rate change is already in effect")
+	ErrInvalidTaxRateChange  = errors.New("invalid tax rate change")
+	ErrSaleNotFound          = errors.New("sale not found")
+	ErrSaleVoided            = errors.New("sale is voided")
+	// Also returned by the repository when stock runs out as a sale is posted.
+	ErrInsufficientStock     = repository.ErrInsufficientStock
+	ErrBatchExpired          = errors.New("batch expired")
+	ErrInvalidQuantity       = errors.New("invalid quantity")
+	ErrInvalidStockMovement  = errors.New("invalid stock movement")
+	ErrCustomerNotFound      = errors.New("customer not found")
+	ErrInvalidCustomer       = errors.New("invalid customer")
+	ErrCustomerGroupNotFound = errors.New("customer group not found")
+	ErrInvalidCustomerGroup  = errors.New("invalid customer group")
+	ErrCustomerGroupInUse    = errors.New("customer group is in use")
+	ErrPriceListNotFound     = errors.New("price list not found")
+	ErrInvalidPriceList      = errors.New("invalid price list")
+	ErrPriceListInUse        = errors.New("price list is in use")
+	ErrPriceNotFound         = errors.New("product has no price on the price list")
+	ErrPromotionNotFound     = errors.New("promotion not found")
+	ErrInvalidPromotion      = errors.New("invalid promotion")
+	ErrPromotionInUse        = errors.New("promotion has been applied to sales")
+	ErrSupplierNotFound      = errors.New("supplier not found")
+	ErrInvalidSupplier       = errors.New("invalid supplier")
+	ErrPurchaseOrderNotFound = errors.New("purchase order not found")
+	ErrInvalidPurchaseOrder  = errors.New("invalid purchase order")
+	ErrPurchaseOrderClosed   = errors.New("purchase order is not outstanding")
+	ErrPurchaseOrderPlaced   = errors.New("purchase order has already been placed")
+	ErrGoodsReceiptNotFound  = errors.New("goods receipt note not found")
+	ErrInvalidGoodsReceipt   = errors.New("invalid goods receipt")
+	ErrStocktakeNotFound     = errors.New("stocktake not found")
+	ErrInvalidStocktake      = errors.New("invalid stocktake")
+	ErrStocktakeInProgress   = repository.ErrStocktakeInProgress
+	ErrStocktakeClosed       = errors.New("stocktake is no longer counting")
+	ErrStoreNotFound         = errors.New("store not found")
+	ErrInvalidStore          = errors.New("invalid store")
+	ErrTransferNotFound      = errors.New("transfer not found")
+	ErrInvalidTransfer       = errors.New("invalid transfer")
+	ErrTransferConflict      = errors.New("transfer conflict")
+	ErrInvalidSettings       = errors.New("invalid settings")
+	ErrEWayBillNotFound      = errors.New("e-way bill not found")
+	ErrInvalidEWayBill       = errors.New("invalid e-way bill")
+	ErrEWayBillIssued        = errors.New("e-way bill number already recorded")
+)
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/ewaybill"
+	"github.com/nitinjangam/pos-receipt-system/internal/repository"
+	"github.com/nitinjangam/pos-receipt-system/internal/uom"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// EWayBillServiceInterface defines the methods for the e-way bill service.
+type EWayBillServiceInterface interface {
+	GetEWayBill(ctx context.Context, saleID int) (v1.EWayBill, error)
+	GenerateEWayBill(ctx context.Context, saleID int, request v1.EWayBillRequest) (v1.EWayBill, error)
+	RecordEWayBillNumber(ctx context.Context, saleID int, number v1.EWayBillNumber) (v1.EWayBill, error)
+}
+
+type EWayBillService struct {
+	logger          *zap.SugaredLogger
+	tracer          trace.Tracer
+	ewayBillRepo    *repository.EWayBillRepository
+	salesRepository *repository.SalesRepository
+	settingsService SettingsServiceInterface
+}
+
+func NewEWayBillService(tracer trace.Tracer, logger *zap.SugaredLogger, ewayBillRepository *repository.EWayBillRepository,
+	salesRepository *repository.SalesRepository, settingsService SettingsServiceInterface) *EWayBillService {
+	return &EWayBillService{
+		logger:          logger,
+		tracer:          tracer,
+		ewayBillRepo:    ewayBillRepository,
+		salesRepository: salesRepository,
+		settingsService: settingsService,
+	}
+}
+
+var transModes = map[v1.EWayBillRequestTransMode]string{
+	v1.Road: ewaybill.TransModeRoad,
+	v1.Rail: ewaybill.TransModeRail,
+	v1.Air:  ewaybill.TransModeAir,
+	v1.Ship: ewaybill.TransModeShip,
+}
+
+var vehicleTypes = map[v1.EWayBillRequestVehicleType]string{
+	v1.Regular:         ewaybill.VehicleTypeRegular,
+	v1.OverDimensional: ewaybill.VehicleTypeOverDimensional,
+}
+
+func (s *EWayBillService) GetEWayBill(ctx context.Context, saleID int) (v1.EWayBill, error) {
+	ctx, span := s.tracer.Start(ctx, "EWayBillService.GetEWayBill")
+	defer span.End()
+
+	bill, err := s.ewayBillRepo.GetEWayBill(ctx, saleID)
+	if err != nil {
+		s.logger.Debugw("Failed to get e-way bill", "error", err, "sale_id", saleID)
+		return v1.EWayBill{}, err
+	}
+	if bill == nil {
+		return v1.EWayBill{}, fmt.Errorf("%w: sale %d", ErrEWayBillNotFound, saleID)
+	}
+	return *bill, nil
+}
+
+// GenerateEWayBill builds the bulk-upload JSON for the sale from its lines,
+// the business settings and the transport details, validates it and stores
+// it against the sale.
+func (s *EWayBillService) GenerateEWayBill(ctx context.Context, saleID int, request v1.EWayBillRequest) (v1.EWayBill, error) {
+	ctx, span := s.tracer.Start(ctx, "EWayBillService.GenerateEWayBill")
+	defer span.End()
+
+	sale, err := s.salesRepository.GetSaleByID(ctx, saleID)
+	if err != nil {
+		s.logger.Debugw("Failed to get sale by ID", "error", err, "sale_id", saleID)
+		return v1.EWayBill{}, err
+	}
+	if sale == nil {
+		return v1.EWayBill{}, ErrSaleNotFound
+	}
+	if sale.VoidedAt != nil {
+		return v1.EWayBill{}, fmt.Errorf("%w: sale %d", ErrSaleVoided, saleID)
+	}
+	if sale.EwayBillNo != nil {
+		return v1.EWayBill{}, fmt.Errorf("%w: %s", ErrEWayBillIssued, *sale.EwayBillNo)
+	}
+
+	settings, err := s.settingsService.GetSettings(ctx)
+	if err != nil {
+		return v1.EWayBill{}, err
+	}
+	if threshold := valueOrZero(settings.EwayBillThreshold); valueOrZero(sale.GrandTotal) <= threshold {
+		return v1.EWayBill{}, fmt.Errorf("%w: consignment value %.2f does not exceed the threshold of %.2f",
+			ErrInvalidEWayBill, valueOrZero(sale.GrandTotal), threshold)
+	}
+	if sale.BilledTo == nil {
+		return v1.EWayBill{}, fmt.Errorf("%w: sale has no billed-to customer", ErrInvalidEWayBill)
+	}
+
+	bill := buildEWayBill(*sale, settings, request)
+	if err := bill.Validate(); err != nil {
+		return v1.EWayBill{}, fmt.Errorf("%w: %v", ErrInvalidEWayBill, err)
+	}
+
+	payload, err := json.Marshal(ewaybill.Payload{Version: ewaybill.Version, BillLists: []ewaybill.Bill{bill}})
+	if err != nil {
+		return v1.EWayBill{}, err
+	}
+	if err := s.ewayBillRepo.SaveEWayBillPayload(ctx, saleID, payload); err != nil {
+		s.logger.Debugw("Failed to save e-way bill", "error", err, "sale_id", saleID)
+		return v1.EWayBill{}, err
+	}
+
+	s.logger.Infow("E-way bill generated", "sale_id", saleID)
+	return s.GetEWayBill(ctx, saleID)
+}
+
+// RecordEWayBillNumber stores the number issued by the portal for a payload
+// generated earlier.
+func (s *EWayBillService) RecordEWayBillNumber(ctx context.Context, saleID int, number v1.EWayBillNumber) (v1.EWayBill, error) {
+	ctx, span := s.tracer.Start(ctx, "EWayBillService.RecordEWayBillNumber")
+	defer span.End()
+
+	if _, err := s.GetEWayBill(ctx, saleID); err != nil {
+		return v1.EWayBill{}, err
+	}
+	if err := s.ewayBillRepo.UpdateEWayBillNumber(ctx, saleID, number); err != nil {
+		s.logger.Debugw("Failed to record e-way bill number", "error", err, "sale_id", saleID)
+		return v1.EWayBill{}, err
+	}
+
+	s.logger.Infow("E-way bill number recorded", "sale_id", saleID, "ewb_no", number.EwbNo)
+	return s.GetEWayBill(ctx, saleID)
+}
+
+// buildEWayBill maps the sale onto an outward supply e-way bill. The place of
+// supply is the buyer's state, falling back to the seller's state for buyers
+// without one.
+func buildEWayBill(sale v1.Sale, settings v1.Settings, request v1.EWayBillRequest) ewaybill.Bill {
+	buyer := sale.BilledTo
+	fromStateCode := atoiOrZero(valueOrZero(settings.StateCode))
+	toStateCode := fromStateCode
+	if buyer.StateCode != nil {
+		toStateCode = atoiOrZero(*buyer.StateCode)
+	}
+
+	toGstin := ewaybill.UnregisteredGSTIN
+	if buyer.Gstin != nil {
+		toGstin = *buyer.Gstin
+	}
+	toAddress := valueOrZero(buyer.Address)
+	if request.ToAddress != nil {
+		toAddress = *request.ToAddress
+	}
+
+	bill := ewaybill.Bill{
+		UserGstin:           valueOrZero(settings.Gstin),
+		SupplyType:          ewaybill.SupplyTypeOutward,
+		SubSupplyType:       ewaybill.SubSupplyTypeSupply,
+		DocType:             ewaybill.DocTypeInvoice,
+		DocNo:               strconv.Itoa(valueOrZero(sale.Id)),
+		DocDate:             valueOrZero(sale.SoldAt).Local().Format(ewaybill.DateLayout),
+		TransType:           ewaybill.TransTypeRegular,
+		FromGstin:           valueOrZero(settings.Gstin),
+		FromTrdName:         valueOrZero(settings.BusinessName),
+		FromAddr1:           valueOrZero(settings.Address),
+		FromPlace:           valueOrZero(settings.City),
+		FromPincode:         atoiOrZero(valueOrZero(settings.Pincode)),
+		FromStateCode:       fromStateCode,
+		ActualFromStateCode: fromStateCode,
+		ToGstin:             toGstin,
+		ToTrdName:           buyer.LegalName,
+		ToAddr1:             toAddress,
+		ToPlace:             request.ToPlace,
+		ToPincode:           atoiOrZero(request.ToPincode),
+		ToStateCode:         toStateCode,
+		ActualToStateCode:   toStateCode,
+		TotalValue:          float64(valueOrZero(sale.Subtotal)),
+		CgstValue:           float64(valueOrZero(sale.CgstTotal)),
+		SgstValue:           float64(valueOrZero(sale.SgstTotal)),
+		IgstValue:           float64(valueOrZero(sale.IgstTotal)),
+		TotInvValue:         float64(valueOrZero(sale.GrandTotal)),
+		TransMode:           transModes[request.TransMode],
+		TransDistance:       strconv.Itoa(request.TransDistance),
+		TransporterName:     valueOrZero(request.TransporterName),
+		TransporterID:       valueOrZero(request.TransporterId),
+		TransDocNo:          valueOrZero(request.TransDocNo),
+		VehicleNo:           ewaybill.NormalizeVehicleNo(valueOrZero(request.VehicleNo)),
+	}
+	if valueOrZero(sale.DocumentType) == v1.BillOfSupply {
+		bill.DocType = ewaybill.DocTypeBillOfSupply
+	}
+	if request.TransDocDate != nil {
+		bill.TransDocDate = request.TransDocDate.Format(ewaybill.DateLayout)
+	}
+	if bill.VehicleNo != "" {
+		bill.VehicleType = ewaybill.VehicleTypeRegular
+		if request.VehicleType != nil {
+			bill.VehicleType = vehicleTypes[*request.VehicleType]
+		}
+	}
+
+	// The main HSN code is that of the line with the highest taxable value.
+	var mainValue float64
+	for i, item := range *sale.Items {
+		taxable := float64(valueOrZero(item.Subtotal))
+		hsnCode := atoiOrZero(valueOrZero(item.HsnCode))
+		bill.ItemList = append(bill.ItemList, ewaybill.Item{
+			ItemNo:        i + 1,
+			ProductName:   valueOrZero(item.Name),
+			ProductDesc:   valueOrZero(item.Name),
+			HsnCode:       hsnCode,
+			Quantity:      valueOrZero(item.Quantity),
+			QtyUnit:       uom.UQC(string(valueOrZero(item.Unit))),
+			TaxableAmount: taxable,
+			CgstRate:      float64(valueOrZero(item.CgstRate)),
+			SgstRate:      float64(valueOrZero(item.SgstRate)),
+			IgstRate:      float64(valueOrZero(item.IgstRate)),
+		})
+		if taxable > mainValue {
+			mainValue = taxable
+			bill.MainHsnCode = hsnCode
+		}
+	}
+	return bill
+}
+
+// atoiOrZero parses a numeric code, returning zero when it is not a number
+// so that validation reports it.
+func atoiOrZero(s string) int {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0
+	}
+	return n
+}
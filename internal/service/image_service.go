@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/media"
+	"github.com/nitinjangam/pos-receipt-system/internal/repository"
+	"github.com/nitinjangam/pos-receipt-system/internal/storage"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxProductImages caps the images of one product.
+	maxProductImages = 10
+	// thumbnailSize is the edge of the square thumbnails fit in, in pixels.
+	thumbnailSize = 256
+)
+
+type ImageServiceInterface interface {
+	GetProductImages(ctx context.Context, productID int) ([]v1.ProductImage, error)
+	PostProductImage(ctx context.Context, productID int, file io.Reader) (v1.ProductImage, error)
+	DeleteProductImage(ctx context.Context, productID, imageID int) error
+	DeleteImageFiles(ctx context.Context, keys []string)
+	GetImageFile(ctx context.Context, key string) ([]byte, string, error)
+}
+
+type ImageService struct {
+	imageRepo   *repository.ImageRepository
+	productRepo *repository.ProductRepository
+	store       storage.Store
+	maxSize     int64
+	logger      *zap.SugaredLogger
+}
+
+func NewImageService(imageRepository *repository.ImageRepository, productRepository *repository.ProductRepository, store storage.Store,
+	maxSize int64, logger *zap.SugaredLogger) *ImageService {
+	return &ImageService{
+		imageRepo:   imageRepository,
+		productRepo: productRepository,
+		store:       store,
+		maxSize:     maxSize,
+		logger:      logger,
+	}
+}
+
+func (s *ImageService) GetProductImages(ctx context.Context, productID int) ([]v1.ProductImage, error) {
+	if err := s.checkProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	images, err := s.imageRepo.GetProductImages(ctx, productID)
+	if err != nil {
+		s.logger.Debugw("Failed to get product images", "error", err, "product_id", productID)
+		return nil, err
+	}
+	return images, nil
+}
+
+// PostProductImage stores an uploaded image of the product along with a
+// thumbnail. The image type is sniffed from the content, and files over the
+// configured size are refused before they are decoded.
+func (s *ImageService) PostProductImage(ctx context.Context, productID int, file io.Reader) (v1.ProductImage, error) {
+	if err := s.checkProduct(ctx, productID); err != nil {
+		return v1.ProductImage{}, err
+	}
+	count, err := s.imageRepo.CountProductImages(ctx, productID)
+	if err != nil {
+		s.logger.Debugw("Failed to count product images", "error", err, "product_id", productID)
+		return v1.ProductImage{}, err
+	}
+	if count >= maxProductImages {
+		return v1.ProductImage{}, fmt.Errorf("%w: product %d already has %d images", ErrInvalidImage, productID, maxProductImages)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file, s.maxSize+1))
+	if err != nil {
+		return v1.ProductImage{}, err
+	}
+	if int64(len(data)) > s.maxSize {
+		return v1.ProductImage{}, fmt.Errorf("%w: the limit is %d bytes", ErrImageTooLarge, s.maxSize)
+	}
+	processed, err := media.Process(data, thumbnailSize)
+	if err != nil {
+		if errors.Is(err, media.ErrUnsupported) || errors.Is(err, media.ErrInvalid) {
+			return v1.ProductImage{}, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+		}
+		return v1.ProductImage{}, err
+	}
+
+	token, err := randomToken()
+	if err != nil {
+		return v1.ProductImage{}, err
+	}
+	imageKey := fmt.Sprintf("product-%d-%s%s", productID, token, processed.Extension)
+	thumbnailKey := fmt.Sprintf("product-%d-%s-thumb%s", productID, token, processed.ThumbnailExtension)
+	if err := s.store.Put(ctx, imageKey, processed.ContentType, data); err != nil {
+		s.logger.Debugw("Failed to store image", "error", err, "key", imageKey)
+		return v1.ProductImage{}, err
+	}
+	if err := s.store.Put(ctx, thumbnailKey, processed.ThumbnailContentType, processed.Thumbnail); err != nil {
+		s.logger.Debugw("Failed to store thumbnail", "error", err, "key", thumbnailKey)
+		s.deleteFiles(ctx, imageKey)
+		return v1.ProductImage{}, err
+	}
+
+	size := int64(len(data))
+	url, thumbnailURL := s.store.URL(imageKey), s.store.URL(thumbnailKey)
+	image := v1.ProductImage{
+		ProductId:    &productID,
+		Url:          &url,
+		ThumbnailUrl: &thumbnailURL,
+		ContentType:  &processed.ContentType,
+		Size:         &size,
+		Width:        &processed.Width,
+		Height:       &processed.Height,
+	}
+	id, err := s.imageRepo.CreateProductImage(ctx, image, imageKey, thumbnailKey)
+	if err != nil {
+		s.logger.Debugw("Failed to create product image", "error", err, "product_id", productID)
+		s.deleteFiles(ctx, imageKey, thumbnailKey)
+		return v1.ProductImage{}, err
+	}
+
+	s.logger.Infow("Product image stored", "product_id", productID, "image_id", id, "size", size)
+	images, err := s.imageRepo.GetProductImages(ctx, productID)
+	if err != nil {
+		return v1.ProductImage{}, err
+	}
+	for _, stored := range images {
+		if *stored.Id == id {
+			return stored, nil
+		}
+	}
+	return v1.ProductImage{}, ErrImageNotFound
+}
+
+func (s *ImageService) DeleteProductImage(ctx context.Context, productID, imageID int) error {
+	keys, err := s.imageRepo.DeleteProductImage(ctx, productID, imageID)
+	if err != nil {
+		s.logger.Debugw("Failed to delete product image", "error", err, "product_id", productID, "image_id", imageID)
+		return err
+	}
+	if len(keys) == 0 {
+		return ErrImageNotFound
+	}
+	s.deleteFiles(ctx, keys...)
+	return nil
+}
+
+// DeleteImageFiles deletes the stored files of images whose records are
+// gone, as when their product has been deleted.
+func (s *ImageService) DeleteImageFiles(ctx context.Context, keys []string) {
+	s.deleteFiles(ctx, keys...)
+}
+
+// GetImageFile returns a stored image or thumbnail and its content type.
+func (s *ImageService) GetImageFile(ctx context.Context, key string) ([]byte, string, error) {
+	data, contentType, err := s.store.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, "", ErrImageNotFound
+		}
+		s.logger.Debugw("Failed to read image", "error", err, "key", key)
+		return nil, "", err
+	}
+	return data, contentType, nil
+}
+
+func (s *ImageService) checkProduct(ctx context.Context, productID int) error {
+	product, err := s.productRepo.GetProductByID(ctx, productID)
+	if err != nil {
+		s.logger.Debugw("Failed to get product by ID", "error", err, "product_id", productID)
+		return err
+	}
+	if product == nil {
+		return ErrProductNotFound
+	}
+	return nil
+}
+
+// deleteFiles removes files from the store once their records are gone. A
+// file that cannot be removed is only logged: it is no longer referenced.
+func (s *ImageService) deleteFiles(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if err := s.store.Delete(ctx, key); err != nil {
+			s.logger.Warnw("Failed to delete image file", "error", err, "key", key)
+		}
+	}
+}
+
+// randomToken returns a random hex string that makes image keys unguessable
+// and unique.
+func randomToken() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
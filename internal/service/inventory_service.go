@@ -0,0 +1,368 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/repository"
+	"github.com/nitinjangam/pos-receipt-system/internal/uom"
+	"go.uber.org/zap"
+)
+
+type InventoryServiceInterface interface {
+	GetStockLevel(ctx context.Context, productID int) (v1.StockLevel, error)
+	GetStockMovements(ctx context.Context, productID int) ([]v1.StockMovement, error)
+	PostStockMovement(ctx context.Context, productID int, request v1.StockMovementRequest) (v1.StockMovement, error)
+	GetBatches(ctx context.Context, productID int, params v1.GetProductsIdBatchesParams) ([]v1.ProductBatch, error)
+}
+
+type InventoryService struct {
+	inventoryRepo   *repository.InventoryRepository
+	salesRepository *repository.SalesRepository
+	productRepo     *repository.ProductRepository
+	settingsService SettingsServiceInterface
+	logger          *zap.SugaredLogger
+}
+
+func NewInventoryService(inventoryRepository *repository.InventoryRepository, salesRepository *repository.SalesRepository,
+	productRepository *repository.ProductRepository, settingsService SettingsServiceInterface, logger *zap.SugaredLogger) *InventoryService {
+	return &InventoryService{
+		inventoryRepo:   inventoryRepository,
+		salesRepository: salesRepository,
+		productRepo:     productRepository,
+		settingsService: settingsService,
+		logger:          logger,
+	}
+}
+
+func (s *InventoryService) GetStockLevel(ctx context.Context, productID int) (v1.StockLevel, error) {
+	level, err := s.inventoryRepo.GetStockLevel(ctx, productID)
+	if err != nil {
+		s.logger.Debugw("Failed to get stock level", "error", err, "product_id", productID)
+		return v1.StockLevel{}, err
+	}
+	if level == nil {
+		return v1.StockLevel{}, ErrProductNotFound
+	}
+	return *level, nil
+}
+
+func (s *InventoryService) GetStockMovements(ctx context.Context, productID int) ([]v1.StockMovement, error) {
+	if _, err := s.GetStockLevel(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	movements, err := s.inventoryRepo.GetStockMovements(ctx, productID)
+	if err != nil {
+		s.logger.Debugw("Failed to get stock movements", "error", err, "product_id", productID)
+		return nil, err
+	}
+	return movements, nil
+}
+
+// GetBatches returns the batches of the product, first to expire first, with
+// the days left until each expires.
+func (s *InventoryService) GetBatches(ctx context.Context, productID int, params v1.GetProductsIdBatchesParams) ([]v1.ProductBatch, error) {
+	if _, err := s.GetStockLevel(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	batches, err := s.inventoryRepo.GetBatches(ctx, productID, valueOrZero(params.IncludeEmpty))
+	if err != nil {
+		s.logger.Debugw("Failed to get batches", "error", err, "product_id", productID)
+		return nil, err
+	}
+	setDaysToExpiry(batches, today())
+	return batches, nil
+}
+
+// PostStockMovement records a purchase, customer return or manual adjustment.
+// Purchases and returns must bring stock in; adjustments may go either way
+// but are subject to the negative stock policy. A quantity counted in
+// purchase units is converted to the product's unit first, along with its
+// unit cost. Purchases of a batch-tracked product go into a batch; returns
+// and adjustments may name one. Goods brought in are costed at the unit cost
+// given, a return against a sale at what the goods cost on the sale, and
+// anything else at the current unit cost; goods going out are costed by the
+// valuation method.
+func (s *InventoryService) PostStockMovement(ctx context.Context, productID int, request v1.StockMovementRequest) (v1.StockMovement, error) {
+	level, err := s.GetStockLevel(ctx, productID)
+	if err != nil {
+		return v1.StockMovement{}, err
+	}
+	unit := string(valueOrZero(level.Unit))
+
+	product, err := s.productRepo.GetProductByID(ctx, productID)
+	if err != nil {
+		return v1.StockMovement{}, err
+	}
+	if product == nil {
+		return v1.StockMovement{}, ErrProductNotFound
+	}
+	if isBundle(*product) {
+		return v1.StockMovement{}, fmt.Errorf("%w: product %d is a bundle, move the stock of its components", ErrInvalidStockMovement, productID)
+	}
+
+	if valueOrZero(request.InPurchaseUnits) {
+		if product.PurchaseUnitFactor == nil {
+			return v1.StockMovement{}, fmt.Errorf("%w: product %d has no purchase unit", ErrInvalidStockMovement, productID)
+		}
+		request.Quantity *= *product.PurchaseUnitFactor
+		if request.UnitCost != nil {
+			unitCost := *request.UnitCost / *product.PurchaseUnitFactor
+			request.UnitCost = &unitCost
+		}
+	}
+	if err := uom.Check(request.Quantity, unit); err != nil {
+		return v1.StockMovement{}, fmt.Errorf("%w: %v", ErrInvalidStockMovement, err)
+	}
+	request.Quantity = uom.Round(request.Quantity, unit)
+
+	switch {
+	case request.Quantity == 0:
+		return v1.StockMovement{}, fmt.Errorf("%w: quantity must not be zero", ErrInvalidStockMovement)
+	case request.Reason != v1.StockMovementRequestReasonAdjustment && request.Quantity < 0:
+		return v1.StockMovement{}, fmt.Errorf("%w: %s quantity must be positive", ErrInvalidStockMovement, request.Reason)
+	case request.SaleId != nil && request.Reason != v1.StockMovementRequestReasonReturn:
+		return v1.StockMovement{}, fmt.Errorf("%w: only returns can reference a sale", ErrInvalidStockMovement)
+	case request.UnitCost != nil && request.Quantity < 0:
+		return v1.StockMovement{}, fmt.Errorf("%w: unitCost applies to goods brought in", ErrInvalidStockMovement)
+	case request.UnitCost != nil && *request.UnitCost < 0:
+		return v1.StockMovement{}, fmt.Errorf("%w: unitCost cannot be negative", ErrInvalidStockMovement)
+	}
+
+	if request.SaleId != nil {
+		saleCost, err := s.checkReturn(ctx, productID, *request.SaleId, request.Quantity)
+		if err != nil {
+			return v1.StockMovement{}, err
+		}
+		if request.UnitCost == nil {
+			request.UnitCost = saleCost
+		}
+	}
+
+	movement := v1.StockMovement{
+		ProductId: &productID,
+		Quantity:  &request.Quantity,
+		SaleId:    request.SaleId,
+		Note:      request.Note,
+		UnitCost:  request.UnitCost,
+	}
+	batch, err := movementBatch(ctx, s.inventoryRepo, *product, request, &movement)
+	if err != nil {
+		return v1.StockMovement{}, err
+	}
+
+	var method v1.ValuationMethod
+	var allowNegative bool
+	if request.Quantity < 0 {
+		settings, err := s.settingsService.GetSettings(ctx)
+		if err != nil {
+			return v1.StockMovement{}, err
+		}
+		method = valueOrZero(settings.ValuationMethod)
+		allowNegative = valueOrZero(settings.AllowNegativeStock)
+		if onHand := valueOrZero(level.OnHand); !allowNegative && uom.Round(onHand+request.Quantity, unit) < 0 {
+			return v1.StockMovement{}, fmt.Errorf("%w: product %d has %s on hand, %s requested",
+				ErrInsufficientStock, productID, uom.Format(onHand, unit), uom.Format(-request.Quantity, unit))
+		}
+	}
+
+	reason := v1.StockMovementReason(request.Reason)
+	movement.Reason = &reason
+	// The checks above are repeated within the transaction, for movements
+	// posted at the same time.
+	movement, err = s.inventoryRepo.CreateStockMovement(ctx, movement, batch, method, allowNegative)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrStatusChanged):
+			return v1.StockMovement{}, fmt.Errorf("%w: sale %d is voided", ErrInvalidStockMovement, *request.SaleId)
+		case errors.Is(err, repository.ErrOverReturned):
+			return v1.StockMovement{}, fmt.Errorf("%w: %v", ErrInvalidStockMovement, err)
+		}
+		s.logger.Debugw("Failed to post stock movement", "error", err, "product_id", productID)
+		return v1.StockMovement{}, err
+	}
+
+	s.logger.Infow("Stock movement posted", "product_id", productID, "reason", reason, "quantity", request.Quantity)
+	return movement, nil
+}
+
+// movementBatch resolves the batch named by the request. A purchase of a
+// batch-tracked product must name one, and a batch it does not know yet is
+// returned for the repository to create. Any other movement must name an
+// existing batch, which it may not take below zero; its ID is set on the
+// movement.
+func movementBatch(ctx context.Context, inventoryRepo *repository.InventoryRepository, product v1.Product, request v1.StockMovementRequest,
+	movement *v1.StockMovement) (*v1.ProductBatch, error) {
+	purchase := request.Reason == v1.StockMovementRequestReasonPurchase
+	switch {
+	case !valueOrZero(product.BatchTracked):
+		if request.BatchNo != nil || request.ExpiresOn != nil || request.Mrp != nil {
+			return nil, fmt.Errorf("%w: product %d is not batch tracked", ErrInvalidStockMovement, *product.Id)
+		}
+		return nil, nil
+	case !purchase && (request.ExpiresOn != nil || request.Mrp != nil):
+		return nil, fmt.Errorf("%w: expiresOn and mrp apply to purchases only", ErrInvalidStockMovement)
+	case request.BatchNo == nil || *request.BatchNo == "":
+		if purchase {
+			return nil, fmt.Errorf("%w: product %d is batch tracked, batchNo is required", ErrInvalidStockMovement, *product.Id)
+		}
+		return nil, nil
+	}
+
+	existing, err := inventoryRepo.GetBatchByNumber(ctx, *product.Id, *request.BatchNo)
+	if err != nil {
+		return nil, err
+	}
+	if purchase {
+		if existing == nil {
+			if request.ExpiresOn == nil {
+				return nil, fmt.Errorf("%w: expiresOn is required for new batch %s", ErrInvalidStockMovement, *request.BatchNo)
+			}
+			return &v1.ProductBatch{BatchNo: request.BatchNo, ExpiresOn: request.ExpiresOn, Mrp: request.Mrp}, nil
+		}
+		if request.ExpiresOn != nil && !request.ExpiresOn.Time.Equal(existing.ExpiresOn.Time) {
+			return nil, fmt.Errorf("%w: batch %s expires on %s", ErrInvalidStockMovement, *request.BatchNo, existing.ExpiresOn)
+		}
+		return &v1.ProductBatch{BatchNo: request.BatchNo, ExpiresOn: existing.ExpiresOn, Mrp: request.Mrp}, nil
+	}
+
+	if existing == nil {
+		return nil, fmt.Errorf("%w: batch %s not found", ErrInvalidStockMovement, *request.BatchNo)
+	}
+	unit := string(valueOrZero(product.Unit))
+	if onHand := valueOrZero(existing.Quantity); uom.Round(onHand+request.Quantity, unit) < 0 {
+		return nil, fmt.Errorf("%w: batch %s has %s on hand, %s requested",
+			ErrInsufficientStock, *request.BatchNo, uom.Format(onHand, unit), uom.Format(-request.Quantity, unit))
+	}
+	movement.BatchId = existing.Id
+	return nil, nil
+}
+
+// batchAllocator takes the stock of batch-tracked products from their
+// unexpired batches, first to expire first, then from stock on hand that is
+// in no batch, keeping track across lines of what it has taken.
+type batchAllocator struct {
+	inventoryRepo *repository.InventoryRepository
+	expiresFrom   time.Time
+	batches       map[int][]v1.ProductBatch
+	loose         map[int]float64
+}
+
+func newBatchAllocator(inventoryRepo *repository.InventoryRepository) *batchAllocator {
+	return &batchAllocator{
+		inventoryRepo: inventoryRepo,
+		expiresFrom:   today(),
+		batches:       map[int][]v1.ProductBatch{},
+		loose:         map[int]float64{},
+	}
+}
+
+// allocate takes the quantity of the product, which has the given stock on
+// hand, and returns the batches it was taken from, each with the quantity
+// taken. A quantity that would need expired stock is refused; any quantity
+// left beyond the stock on hand is in no batch.
+func (a *batchAllocator) allocate(ctx context.Context, productID int, need, onHand float64, unit string) ([]v1.ProductBatch, error) {
+	if _, ok := a.batches[productID]; !ok {
+		productBatches, err := a.inventoryRepo.GetBatches(ctx, productID, false)
+		if err != nil {
+			return nil, err
+		}
+		a.batches[productID] = productBatches
+		a.loose[productID] = onHand
+		for _, batch := range productBatches {
+			a.loose[productID] = uom.Round(a.loose[productID]-*batch.Quantity, unit)
+		}
+	}
+
+	allocated := []v1.ProductBatch{}
+	expired := 0.0
+	for j := range a.batches[productID] {
+		batch := &a.batches[productID][j]
+		if batch.ExpiresOn.Time.Before(a.expiresFrom) {
+			expired += *batch.Quantity
+			continue
+		}
+		if need <= 0 || *batch.Quantity <= 0 {
+			continue
+		}
+		quantity := math.Min(need, *batch.Quantity)
+		*batch.Quantity = uom.Round(*batch.Quantity-quantity, unit)
+		need = uom.Round(need-quantity, unit)
+		taken := *batch
+		taken.Quantity = &quantity
+		allocated = append(allocated, taken)
+	}
+	if need > 0 && a.loose[productID] > 0 {
+		quantity := math.Min(need, a.loose[productID])
+		a.loose[productID] = uom.Round(a.loose[productID]-quantity, unit)
+		need = uom.Round(need-quantity, unit)
+	}
+	if need > 0 && expired > 0 {
+		return nil, fmt.Errorf("%w: product %d is %s short of unexpired stock, %s on hand is in expired batches", ErrBatchExpired, productID,
+			uom.Format(need, unit), uom.Format(expired, unit))
+	}
+	return allocated, nil
+}
+
+// checkReturn makes sure the returned product was sold on the referenced,
+// non-voided sale in at least the returned quantity, net of what has been
+// returned against the sale before, and returns the cost
+// per unit of the goods sold, if the sale recorded it.
+func (s *InventoryService) checkReturn(ctx context.Context, productID, saleID int, quantity float64) (*float64, error) {
+	sale, err := s.salesRepository.GetSaleByID(ctx, saleID)
+	if err != nil {
+		return nil, err
+	}
+	if sale == nil {
+		return nil, fmt.Errorf("%w: sale %d not found", ErrInvalidStockMovement, saleID)
+	}
+	if sale.VoidedAt != nil {
+		return nil, fmt.Errorf("%w: sale %d is voided", ErrInvalidStockMovement, saleID)
+	}
+
+	sold, cost := 0.0, 0.0
+	costed := false
+	for _, item := range valueOrZero(sale.Items) {
+		if valueOrZero(item.ProductId) == productID {
+			sold += valueOrZero(item.Quantity)
+			cost += float64(valueOrZero(item.CostOfGoodsSold))
+			costed = costed || item.CostOfGoodsSold != nil
+		}
+	}
+	returned, err := s.inventoryRepo.GetReturnedQuantity(ctx, saleID, productID)
+	if err != nil {
+		return nil, err
+	}
+	if quantity > math.Round((sold-returned)*1e6)/1e6 {
+		return nil, fmt.Errorf("%w: sale %d sold %g of product %d, of which %g has been returned", ErrInvalidStockMovement,
+			saleID, sold, productID, returned)
+	}
+	if !costed {
+		return nil, nil
+	}
+	unitCost := cost / sold
+	return &unitCost, nil
+}
+
+// today returns the local date as stored for batch expiry, at midnight UTC.
+func today() time.Time {
+	y, m, d := time.Now().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// setDaysToExpiry fills in the days from the given date until each batch
+// expires; an expired batch has a negative count.
+func setDaysToExpiry(batches []v1.ProductBatch, from time.Time) {
+	for i := range batches {
+		if batches[i].ExpiresOn != nil {
+			days := int(batches[i].ExpiresOn.Time.Sub(from).Hours() / 24)
+			batches[i].DaysToExpiry = &days
+		}
+	}
+}
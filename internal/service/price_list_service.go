@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/repository"
+	"github.com/nitinjangam/pos-receipt-system/internal/uom"
+	"go.uber.org/zap"
+)
+
+type PriceListServiceInterface interface {
+	GetPriceLists(ctx context.Context) ([]v1.PriceList, error)
+	GetPriceList(ctx context.Context, id int) (v1.PriceList, error)
+	PostPriceList(ctx context.Context, priceList v1.PriceList) (v1.PriceList, error)
+	PutPriceList(ctx context.Context, priceList v1.PriceList) (v1.PriceList, error)
+	DeletePriceList(ctx context.Context, id int) error
+	GetPrices(ctx context.Context, priceListID int) ([]v1.PriceListPrice, error)
+	PutPrice(ctx context.Context, priceListID, productID int, price v1.PriceListPrice) (v1.PriceListPrice, error)
+	DeletePrice(ctx context.Context, priceListID, productID int) error
+}
+
+type PriceListService struct {
+	priceListRepo *repository.PriceListRepository
+	productRepo   *repository.ProductRepository
+	logger        *zap.SugaredLogger
+}
+
+func NewPriceListService(priceListRepository *repository.PriceListRepository, productRepository *repository.ProductRepository, logger *zap.SugaredLogger) *PriceListService {
+	return &PriceListService{
+		priceListRepo: priceListRepository,
+		productRepo:   productRepository,
+		logger:        logger,
+	}
+}
+
+func (s *PriceListService) GetPriceLists(ctx context.Context) ([]v1.PriceList, error) {
+	priceLists, err := s.priceListRepo.GetAllPriceLists(ctx)
+	if err != nil {
+		s.logger.Debugw("Failed to get price lists", "error", err)
+		return nil, err
+	}
+	return priceLists, nil
+}
+
+func (s *PriceListService) GetPriceList(ctx context.Context, id int) (v1.PriceList, error) {
+	priceList, err := s.priceListRepo.GetPriceListByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get price list by ID", "error", err, "price_list_id", id)
+		return v1.PriceList{}, err
+	}
+	if priceList == nil {
+		return v1.PriceList{}, ErrPriceListNotFound
+	}
+	return *priceList, nil
+}
+
+func (s *PriceListService) PostPriceList(ctx context.Context, priceList v1.PriceList) (v1.PriceList, error) {
+	if err := s.validatePriceList(ctx, &priceList); err != nil {
+		return v1.PriceList{}, err
+	}
+
+	id, err := s.priceListRepo.CreatePriceList(ctx, priceList)
+	if err != nil {
+		s.logger.Debugw("Failed to create price list", "error", err, "price_list", priceList)
+		return v1.PriceList{}, err
+	}
+
+	s.logger.Infow("Price list created", "price_list_id", id, "name", priceList.Name)
+	return s.GetPriceList(ctx, id)
+}
+
+func (s *PriceListService) PutPriceList(ctx context.Context, priceList v1.PriceList) (v1.PriceList, error) {
+	if _, err := s.GetPriceList(ctx, *priceList.Id); err != nil {
+		return v1.PriceList{}, err
+	}
+	if err := s.validatePriceList(ctx, &priceList); err != nil {
+		return v1.PriceList{}, err
+	}
+
+	if err := s.priceListRepo.UpdatePriceList(ctx, priceList); err != nil {
+		s.logger.Debugw("Failed to update price list", "error", err, "price_list", priceList)
+		return v1.PriceList{}, err
+	}
+	return s.GetPriceList(ctx, *priceList.Id)
+}
+
+// DeletePriceList removes a price list, with its prices, that is assigned to
+// no customer or group and has priced no sale, so that the price of every
+// recorded sale can still be traced to its list.
+func (s *PriceListService) DeletePriceList(ctx context.Context, id int) error {
+	if _, err := s.GetPriceList(ctx, id); err != nil {
+		return err
+	}
+
+	customers, groups, sales, err := s.priceListRepo.CountPriceListUsage(ctx, id)
+	if err != nil {
+		return err
+	}
+	if customers > 0 || groups > 0 || sales > 0 {
+		return fmt.Errorf("%w: price list %d is assigned to %d customers and %d customer groups and has priced %d sales",
+			ErrPriceListInUse, id, customers, groups, sales)
+	}
+
+	if err := s.priceListRepo.DeletePriceList(ctx, id); err != nil {
+		s.logger.Debugw("Failed to delete price list", "error", err, "price_list_id", id)
+		return err
+	}
+	return nil
+}
+
+// validatePriceList trims the name and description and checks that the name
+// is unique.
+func (s *PriceListService) validatePriceList(ctx context.Context, priceList *v1.PriceList) error {
+	priceList.Name = strings.TrimSpace(priceList.Name)
+	if priceList.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidPriceList)
+	}
+	if priceList.Description != nil {
+		if description := strings.TrimSpace(*priceList.Description); description == "" {
+			priceList.Description = nil
+		} else {
+			priceList.Description = &description
+		}
+	}
+
+	existing, err := s.priceListRepo.GetPriceListByName(ctx, priceList.Name)
+	if err != nil {
+		s.logger.Debugw("Failed to get price list by name", "error", err, "name", priceList.Name)
+		return err
+	}
+	if existing != nil && (priceList.Id == nil || *existing.Id != *priceList.Id) {
+		return fmt.Errorf("%w: %q already exists as price list %d", ErrInvalidPriceList, priceList.Name, *existing.Id)
+	}
+	return nil
+}
+
+func (s *PriceListService) GetPrices(ctx context.Context, priceListID int) ([]v1.PriceListPrice, error) {
+	if _, err := s.GetPriceList(ctx, priceListID); err != nil {
+		return nil, err
+	}
+	prices, err := s.priceListRepo.GetPrices(ctx, priceListID, nil)
+	if err != nil {
+		s.logger.Debugw("Failed to get price list prices", "error", err, "price_list_id", priceListID)
+		return nil, err
+	}
+	return prices, nil
+}
+
+// PutPrice replaces the quantity-break tiers of a product on the list. Each
+// tier applies from its minimum quantity, counted in the unit of the product,
+// up to the next one.
+func (s *PriceListService) PutPrice(ctx context.Context, priceListID, productID int, price v1.PriceListPrice) (v1.PriceListPrice, error) {
+	if _, err := s.GetPriceList(ctx, priceListID); err != nil {
+		return v1.PriceListPrice{}, err
+	}
+	product, err := s.productRepo.GetProductByID(ctx, productID)
+	if err != nil {
+		s.logger.Debugw("Failed to get product by ID", "error", err, "product_id", productID)
+		return v1.PriceListPrice{}, err
+	}
+	if product == nil {
+		return v1.PriceListPrice{}, ErrProductNotFound
+	}
+	if hasVariants(*product) {
+		return v1.PriceListPrice{}, fmt.Errorf("%w: product %d is sold through its variants, price them instead", ErrInvalidPriceList, productID)
+	}
+
+	if len(price.Tiers) == 0 {
+		return v1.PriceListPrice{}, fmt.Errorf("%w: at least one tier is required", ErrInvalidPriceList)
+	}
+	unit := string(valueOrZero(product.Unit))
+	seen := map[float64]bool{}
+	for _, tier := range price.Tiers {
+		if tier.MinQuantity <= 0 {
+			return v1.PriceListPrice{}, fmt.Errorf("%w: minimum quantity must be greater than zero", ErrInvalidPriceList)
+		}
+		if err := uom.Check(tier.MinQuantity, unit); err != nil {
+			return v1.PriceListPrice{}, fmt.Errorf("%w: minimum quantity: %v", ErrInvalidPriceList, err)
+		}
+		if seen[tier.MinQuantity] {
+			return v1.PriceListPrice{}, fmt.Errorf("%w: more than one tier starts at %s", ErrInvalidPriceList, uom.Format(tier.MinQuantity, unit))
+		}
+		seen[tier.MinQuantity] = true
+		if tier.Price < 0 {
+			return v1.PriceListPrice{}, fmt.Errorf("%w: price cannot be negative", ErrInvalidPriceList)
+		}
+	}
+
+	if err := s.priceListRepo.SetPrices(ctx, priceListID, productID, price.Tiers); err != nil {
+		s.logger.Debugw("Failed to set price list prices", "error", err, "price_list_id", priceListID, "product_id", productID)
+		return v1.PriceListPrice{}, err
+	}
+	prices, err := s.priceListRepo.GetPrices(ctx, priceListID, &productID)
+	if err != nil {
+		return v1.PriceListPrice{}, err
+	}
+	return prices[0], nil
+}
+
+func (s *PriceListService) DeletePrice(ctx context.Context, priceListID, productID int) error {
+	if _, err := s.GetPriceList(ctx, priceListID); err != nil {
+		return err
+	}
+	n, err := s.priceListRepo.DeletePrices(ctx, priceListID, productID)
+	if err != nil {
+		s.logger.Debugw("Failed to delete price list prices", "error", err, "price_list_id", priceListID, "product_id", productID)
+		return err
+	}
+	if n == 0 {
+		return ErrPriceNotFound
+	}
+	return nil
+}
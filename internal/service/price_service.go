@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/repository"
+	"go.uber.org/zap"
+)
+
+type PriceServiceInterface interface {
+	GetPriceHistory(ctx context.Context, productID int) ([]v1.PriceChange, error)
+	GetPriceSchedules(ctx context.Context, productID int) ([]v1.PriceSchedule, error)
+	PostPriceSchedule(ctx context.Context, productID int, schedule v1.PriceSchedule) (v1.PriceSchedule, error)
+	DeletePriceSchedule(ctx context.Context, productID, scheduleID int) error
+}
+
+type PriceService struct {
+	priceRepo   *repository.PriceRepository
+	productRepo *repository.ProductRepository
+	logger      *zap.SugaredLogger
+}
+
+func NewPriceService(priceRepository *repository.PriceRepository, productRepository *repository.ProductRepository, logger *zap.SugaredLogger) *PriceService {
+	return &PriceService{
+		priceRepo:   priceRepository,
+		productRepo: productRepository,
+		logger:      logger,
+	}
+}
+
+// GetPriceHistory returns the changes to the price and tax rates of the
+// product, scheduled ones included, the latest to take effect first.
+func (s *PriceService) GetPriceHistory(ctx context.Context, productID int) ([]v1.PriceChange, error) {
+	if _, err := s.getProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	changes, err := s.priceRepo.GetPriceChanges(ctx, productID)
+	if err != nil {
+		s.logger.Debugw("Failed to get price changes", "error", err, "product_id", productID)
+		return nil, err
+	}
+	return changes, nil
+}
+
+func (s *PriceService) GetPriceSchedules(ctx context.Context, productID int) ([]v1.PriceSchedule, error) {
+	if _, err := s.getProduct(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	schedules, err := s.priceRepo.GetPriceSchedules(ctx, productID)
+	if err != nil {
+		s.logger.Debugw("Failed to get price schedules", "error", err, "product_id", productID)
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// PostPriceSchedule schedules a price for the product. A start in the past
+// starts the price now, as sales already made keep the prices they were
+// billed at. Schedules of a product cannot overlap.
+func (s *PriceService) PostPriceSchedule(ctx context.Context, productID int, schedule v1.PriceSchedule) (v1.PriceSchedule, error) {
+	product, err := s.getProduct(ctx, productID)
+	if err != nil {
+		return v1.PriceSchedule{}, err
+	}
+	if hasVariants(*product) {
+		return v1.PriceSchedule{}, fmt.Errorf("%w: product %d is sold through its variants, schedule their prices instead", ErrInvalidPriceSchedule, productID)
+	}
+
+	now := time.Now()
+	if schedule.StartsAt.Before(now) {
+		schedule.StartsAt = now
+	}
+	switch {
+	case schedule.Price < 0:
+		return v1.PriceSchedule{}, fmt.Errorf("%w: price cannot be negative", ErrInvalidPriceSchedule)
+	case schedule.EndsAt != nil && !schedule.EndsAt.After(schedule.StartsAt):
+		return v1.PriceSchedule{}, fmt.Errorf("%w: endsAt must be after startsAt and in the future", ErrInvalidPriceSchedule)
+	}
+
+	schedules, err := s.priceRepo.GetPriceSchedules(ctx, productID)
+	if err != nil {
+		s.logger.Debugw("Failed to get price schedules", "error", err, "product_id", productID)
+		return v1.PriceSchedule{}, err
+	}
+	for _, other := range schedules {
+		if overlaps(schedule, other) {
+			return v1.PriceSchedule{}, fmt.Errorf("%w: price schedule %d", ErrPriceScheduleConflict, *other.Id)
+		}
+	}
+
+	// The history records the change from the price the product would
+	// otherwise sell at when the schedule starts, and the change back to the
+	// regular price when it ends.
+	before, err := s.productRepo.GetProductAt(ctx, productID, schedule.StartsAt)
+	if err != nil {
+		s.logger.Debugw("Failed to get product by ID", "error", err, "product_id", productID)
+		return v1.PriceSchedule{}, err
+	}
+	scheduled := v1.Product{Id: &productID, Price: &schedule.Price}
+	changes := repository.PriceChanges(*before, scheduled, v1.PriceChangeSourcePriceSchedule, schedule.StartsAt)
+	if schedule.EndsAt != nil {
+		regular := v1.Product{Price: product.RegularPrice}
+		changes = append(changes, repository.PriceChanges(scheduled, regular, v1.PriceChangeSourcePriceSchedule, *schedule.EndsAt)...)
+	}
+
+	schedule.ProductId = &productID
+	created, err := s.priceRepo.CreatePriceSchedule(ctx, schedule, changes)
+	if err != nil {
+		s.logger.Debugw("Failed to create price schedule", "error", err, "product_id", productID)
+		return v1.PriceSchedule{}, err
+	}
+	s.logger.Infow("Price scheduled", "id", created.Id, "product_id", productID, "price", created.Price,
+		"starts_at", created.StartsAt, "ends_at", created.EndsAt)
+	return created, nil
+}
+
+// DeletePriceSchedule cancels a schedule that has not started, and ends one
+// that is in effect now. Ended schedules stay, as sales were billed at them.
+func (s *PriceService) DeletePriceSchedule(ctx context.Context, productID, scheduleID int) error {
+	schedule, err := s.priceRepo.GetPriceScheduleByID(ctx, scheduleID)
+	if err != nil {
+		s.logger.Debugw("Failed to get price schedule", "error", err, "id", scheduleID)
+		return err
+	}
+	if schedule == nil || valueOrZero(schedule.ProductId) != productID {
+		return ErrPriceScheduleNotFound
+	}
+
+	now := time.Now()
+	switch {
+	case schedule.StartsAt.After(now):
+		err = s.priceRepo.DeletePriceSchedule(ctx, scheduleID)
+	case schedule.EndsAt != nil && !schedule.EndsAt.After(now):
+		return ErrPriceScheduleEnded
+	default:
+		var product *v1.Product
+		if product, err = s.getProduct(ctx, productID); err != nil {
+			return err
+		}
+		scheduled := v1.Product{Id: &productID, Price: &schedule.Price}
+		regular := v1.Product{Price: product.RegularPrice}
+		changes := repository.PriceChanges(scheduled, regular, v1.PriceChangeSourcePriceSchedule, now)
+		err = s.priceRepo.EndPriceSchedule(ctx, scheduleID, now, changes)
+	}
+	if err != nil {
+		s.logger.Debugw("Failed to cancel price schedule", "error", err, "id", scheduleID)
+		return err
+	}
+	return nil
+}
+
+func (s *PriceService) getProduct(ctx context.Context, productID int) (*v1.Product, error) {
+	product, err := s.productRepo.GetProductByID(ctx, productID)
+	if err != nil {
+		s.logger.Debugw("Failed to get product by ID", "error", err, "product_id", productID)
+		return nil, err
+	}
+	if product == nil {
+		return nil, ErrProductNotFound
+	}
+	return product, nil
+}
+
+// overlaps reports whether the periods of two schedules share an instant. A
+// schedule without an end runs on indefinitely.
+func overlaps(a, b v1.PriceSchedule) bool {
+	return (b.EndsAt == nil || a.StartsAt.Before(*b.EndsAt)) && (a.EndsAt == nil || b.StartsAt.Before(*a.EndsAt))
+}
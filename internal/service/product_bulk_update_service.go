@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+)
+
+// BulkUpdateProducts changes the price, tax rates or category of the products
+// named by ID, matched by the filter, or both, in which case only products
+// named and matched are changed. Filters leave out archived products. A
+// product with variants takes its variants along; a variant on its own can
+// have its price changed but not the rates or category it shares with its
+// parent. The price change applies to the regular price and ends any
+// scheduled price in effect. In a dry run nothing is written and the products
+// that would change are returned; otherwise the changes are made in one
+// transaction and recorded as a single bulk update. Nothing is recorded when
+// no product would change.
+func (s *ProductService) BulkUpdateProducts(ctx context.Context, update v1.ProductBulkUpdate, dryRun bool) (v1.ProductBulkUpdate, error) {
+	if err := s.validateBulkChanges(ctx, update.Changes); err != nil {
+		return v1.ProductBulkUpdate{}, err
+	}
+	if update.Note != nil {
+		if note := strings.TrimSpace(*update.Note); note == "" {
+			update.Note = nil
+		} else {
+			update.Note = &note
+		}
+	}
+
+	selected, err := s.bulkUpdateProducts(ctx, &update)
+	if err != nil {
+		return v1.ProductBulkUpdate{}, err
+	}
+
+	changes := update.Changes
+	items := []v1.ProductBulkUpdateItem{}
+	var products []v1.Product
+	var rates []v1.TaxRate
+	for _, product := range selected {
+		if product.ParentId != nil && !slices.ContainsFunc(selected, func(p v1.Product) bool { return *p.Id == *product.ParentId }) &&
+			(changes.CgstRate != nil || changes.SgstRate != nil || changes.CategoryId != nil) {
+			return v1.ProductBulkUpdate{}, fmt.Errorf("%w: product %d is a variant, its rates and category follow product %d",
+				ErrInvalidBulkUpdate, *product.Id, *product.ParentId)
+		}
+
+		updated := product
+		updated.Price = product.RegularPrice
+		if changes.Price != nil {
+			price := adjustedPrice(float64(valueOrZero(product.RegularPrice)), *changes.Price)
+			if price < 0 {
+				return v1.ProductBulkUpdate{}, fmt.Errorf("%w: product %d would be priced at %.2f", ErrInvalidBulkUpdate, *product.Id, price)
+			}
+			updated.Price = float32Ptr(price)
+		}
+		if changes.CgstRate != nil {
+			updated.CgstRate = changes.CgstRate
+		}
+		if changes.SgstRate != nil {
+			updated.SgstRate = changes.SgstRate
+		}
+		if changes.CategoryId != nil {
+			updated.CategoryId = changes.CategoryId
+		}
+
+		priceChanged := valueOrZero(updated.Price) != valueOrZero(product.RegularPrice)
+		categoryChanged := valueOrZero(updated.CategoryId) != valueOrZero(product.CategoryId)
+		if !priceChanged && !categoryChanged && !taxRatesChanged(product, updated) {
+			continue
+		}
+
+		item := v1.ProductBulkUpdateItem{
+			ProductId:    product.Id,
+			Name:         product.Name,
+			VariantLabel: product.VariantLabel,
+		}
+		if changes.Price != nil {
+			item.OldPrice, item.NewPrice = product.RegularPrice, updated.Price
+		}
+		if changes.CgstRate != nil {
+			item.OldCgstRate, item.NewCgstRate = product.CgstRate, updated.CgstRate
+		}
+		if changes.SgstRate != nil {
+			item.OldSgstRate, item.NewSgstRate = product.SgstRate, updated.SgstRate
+		}
+		if changes.CategoryId != nil {
+			item.OldCategoryId, item.NewCategoryId = product.CategoryId, updated.CategoryId
+		}
+		items = append(items, item)
+		products = append(products, updated)
+		if taxRatesChanged(product, updated) {
+			rates = append(rates, manualRateChange(updated))
+		}
+	}
+
+	count := len(items)
+	update.Products, update.Updated = &items, &count
+	update.Id, update.ChangedAt, update.ChangedBy = nil, nil, nil
+	update.DryRun = &dryRun
+	if dryRun || count == 0 {
+		return update, nil
+	}
+
+	id, err := s.productRepo.BulkUpdateProducts(ctx, update, products, rates)
+	if err != nil {
+		s.logger.Debugw("Failed to bulk update products", "error", err)
+		return v1.ProductBulkUpdate{}, err
+	}
+	s.logger.Infow("Products bulk updated", "bulk_update_id", id, "products", count)
+
+	recorded, err := s.productRepo.GetBulkUpdateByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get bulk update by ID", "error", err, "bulk_update_id", id)
+		return v1.ProductBulkUpdate{}, err
+	}
+	if recorded == nil {
+		return v1.ProductBulkUpdate{}, fmt.Errorf("bulk update %d not found after recording it", id)
+	}
+	recorded.DryRun = &dryRun
+	return *recorded, nil
+}
+
+// GetBulkUpdates lists the bulk updates made, oldest first.
+func (s *ProductService) GetBulkUpdates(ctx context.Context) ([]v1.ProductBulkUpdate, error) {
+	updates, err := s.productRepo.GetBulkUpdates(ctx)
+	if err != nil {
+		s.logger.Debugw("Failed to get bulk updates", "error", err)
+		return nil, err
+	}
+	return updates, nil
+}
+
+// validateBulkChanges checks that there is something to change and that the
+// new values are valid.
+func (s *ProductService) validateBulkChanges(ctx context.Context, changes v1.ProductBulkChanges) error {
+	if changes.Price == nil && changes.CgstRate == nil && changes.SgstRate == nil && changes.CategoryId == nil {
+		return fmt.Errorf("%w: there are no changes", ErrInvalidBulkUpdate)
+	}
+	if changes.Price != nil {
+		adjustment := *changes.Price
+		given := 0
+		for _, v := range []*float32{adjustment.Set, adjustment.Percent, adjustment.Amount} {
+			if v != nil {
+				given++
+			}
+		}
+		if given != 1 {
+			return fmt.Errorf("%w: a price change needs exactly one of set, percent or amount", ErrInvalidBulkUpdate)
+		}
+		if adjustment.Set != nil && *adjustment.Set < 0 {
+			return fmt.Errorf("%w: price cannot be negative", ErrInvalidBulkUpdate)
+		}
+		if adjustment.Percent != nil && *adjustment.Percent < -100 {
+			return fmt.Errorf("%w: a price cannot be lowered by more than 100%%", ErrInvalidBulkUpdate)
+		}
+	}
+	if valueOrZero(changes.CgstRate) < 0 || valueOrZero(changes.SgstRate) < 0 {
+		return fmt.Errorf("%w: tax rates cannot be negative", ErrInvalidBulkUpdate)
+	}
+	if changes.CategoryId != nil {
+		category, err := s.categoryRepo.GetCategoryByID(ctx, *changes.CategoryId)
+		if err != nil {
+			s.logger.Debugw("Failed to get category by ID", "error", err, "category_id", *changes.CategoryId)
+			return err
+		}
+		if category == nil {
+			return fmt.Errorf("%w: category %d not found", ErrInvalidBulkUpdate, *changes.CategoryId)
+		}
+	}
+	return nil
+}
+
+// bulkUpdateProducts returns the products the update selects, in ID order,
+// with the variants of any product that has them. Empty selectors are dropped
+// from the update.
+func (s *ProductService) bulkUpdateProducts(ctx context.Context, update *v1.ProductBulkUpdate) ([]v1.Product, error) {
+	if update.ProductIds != nil && len(*update.ProductIds) == 0 {
+		update.ProductIds = nil
+	}
+	if update.Filter != nil {
+		filter := *update.Filter
+		if filter.HsnCode != nil {
+			if hsn := strings.TrimSpace(*filter.HsnCode); hsn == "" {
+				filter.HsnCode = nil
+			} else {
+				filter.HsnCode = &hsn
+			}
+		}
+		update.Filter = &filter
+		if filter.CategoryId == nil && filter.HsnCode == nil {
+			update.Filter = nil
+		}
+	}
+	if update.ProductIds == nil && update.Filter == nil {
+		return nil, fmt.Errorf("%w: productIds or a filter is required", ErrInvalidBulkUpdate)
+	}
+
+	// Each selector narrows the products down; nil means none has been
+	// applied yet.
+	var selected map[int]v1.Product
+	narrow := func(products []v1.Product) {
+		matched := map[int]v1.Product{}
+		for _, product := range products {
+			if _, ok := selected[*product.Id]; selected == nil || ok {
+				matched[*product.Id] = product
+			}
+		}
+		selected = matched
+	}
+
+	if update.ProductIds != nil {
+		var products []v1.Product
+		for _, id := range *update.ProductIds {
+			product, err := s.productRepo.GetProductByID(ctx, id)
+			if err != nil {
+				s.logger.Debugw("Failed to get product by ID", "error", err, "product_id", id)
+				return nil, err
+			}
+			if product == nil {
+				return nil, fmt.Errorf("%w: product %d not found", ErrInvalidBulkUpdate, id)
+			}
+			products = append(products, *product)
+		}
+		narrow(products)
+	}
+	if update.Filter != nil && update.Filter.CategoryId != nil {
+		category, err := s.categoryRepo.GetCategoryByID(ctx, *update.Filter.CategoryId)
+		if err != nil {
+			s.logger.Debugw("Failed to get category by ID", "error", err, "category_id", *update.Filter.CategoryId)
+			return nil, err
+		}
+		if category == nil {
+			return nil, fmt.Errorf("%w: category %d not found", ErrInvalidBulkUpdate, *update.Filter.CategoryId)
+		}
+		products, err := s.productRepo.GetProductsInCategory(ctx, *update.Filter.CategoryId)
+		if err != nil {
+			return nil, err
+		}
+		narrow(activeProducts(products, false))
+	}
+	if update.Filter != nil && update.Filter.HsnCode != nil {
+		products, err := s.productRepo.GetProductsByHSNAt(ctx, *update.Filter.HsnCode, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		narrow(activeProducts(products, false))
+	}
+
+	for _, product := range selected {
+		if !hasVariants(product) {
+			continue
+		}
+		variants, err := s.productRepo.GetVariants(ctx, *product.Id)
+		if err != nil {
+			s.logger.Debugw("Failed to get variants", "error", err, "product_id", *product.Id)
+			return nil, err
+		}
+		for _, variant := range variants {
+			selected[*variant.Id] = variant
+		}
+	}
+
+	products := make([]v1.Product, 0, len(selected))
+	for _, product := range selected {
+		products = append(products, product)
+	}
+	slices.SortFunc(products, func(a, b v1.Product) int { return *a.Id - *b.Id })
+	return products, nil
+}
+
+// adjustedPrice applies the adjustment to the price, rounded to the paisa.
+func adjustedPrice(price float64, adjustment v1.PriceAdjustment) float64 {
+	switch {
+	case adjustment.Set != nil:
+		return round2(float64(*adjustment.Set))
+	case adjustment.Percent != nil:
+		return round2(price * (1 + float64(*adjustment.Percent)/100))
+	default:
+		return round2(price + float64(valueOrZero(adjustment.Amount)))
+	}
+}
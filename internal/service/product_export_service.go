@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/spreadsheet"
+)
+
+// defaultExportColumns are the columns ImportProducts reads, so that an
+// export can be edited and imported back, followed by the stock on hand.
+var defaultExportColumns = []v1.ProductExportColumn{
+	v1.ProductExportColumnName, v1.ProductExportColumnDescription, v1.ProductExportColumnPrice,
+	v1.ProductExportColumnCgstRate, v1.ProductExportColumnSgstRate, v1.ProductExportColumnHsnCode,
+	v1.ProductExportColumnSku, v1.ProductExportColumnBarcodes, v1.ProductExportColumnCategory,
+	v1.ProductExportColumnUnit, v1.ProductExportColumnPurchaseUnit, v1.ProductExportColumnPurchaseUnitFactor,
+	v1.ProductExportColumnStockOnHand,
+}
+
+// ExportProducts writes the catalogue to w in the requested format, one
+// product at a time. Nothing is written when the filters are invalid, so the
+// caller can still report the error.
+func (s *ProductService) ExportProducts(ctx context.Context, params v1.GetProductsExportParams, w io.Writer) error {
+	format := v1.Csv
+	if params.Format != nil {
+		format = *params.Format
+	}
+	columns := defaultExportColumns
+	if params.Columns != nil && len(*params.Columns) > 0 {
+		columns = *params.Columns
+	}
+
+	if params.Category != nil {
+		category, err := s.categoryRepo.GetCategoryByID(ctx, *params.Category)
+		if err != nil {
+			s.logger.Debugw("Failed to get category by ID", "error", err, "category_id", *params.Category)
+			return err
+		}
+		if category == nil {
+			return ErrCategoryNotFound
+		}
+	}
+	// The paths are read once up front rather than for every product.
+	categories, err := s.categoryRepo.GetAllCategories(ctx)
+	if err != nil {
+		s.logger.Debugw("Failed to get categories", "error", err)
+		return err
+	}
+	byID := categoriesByID(categories)
+
+	writer, err := spreadsheet.NewWriter(string(format), w)
+	if err != nil {
+		return err
+	}
+	header := make([]any, len(columns))
+	for i, column := range columns {
+		header[i] = string(column)
+	}
+	if err := writer.WriteRow(header); err != nil {
+		return err
+	}
+
+	count := 0
+	err = s.productRepo.ExportProducts(ctx, params.Category, params.UpdatedSince, func(product v1.Product) error {
+		if archived(product) && !valueOrZero(params.IncludeArchived) {
+			return nil
+		}
+		values := make([]any, len(columns))
+		for i, column := range columns {
+			values[i] = exportValue(product, column, byID)
+		}
+		count++
+		return writer.WriteRow(values)
+	})
+	if err != nil {
+		s.logger.Debugw("Failed to export products", "error", err)
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	s.logger.Infow("Products exported", "format", format, "products", count)
+	return nil
+}
+
+// exportValue returns the value of one column of the product, nil when it
+// is not set.
+func exportValue(product v1.Product, column v1.ProductExportColumn, categories map[int]v1.Category) any {
+	switch column {
+	case v1.ProductExportColumnId:
+		return optionalValue(product.Id)
+	case v1.ProductExportColumnName:
+		return optionalValue(product.Name)
+	case v1.ProductExportColumnDescription:
+		return optionalValue(product.Description)
+	case v1.ProductExportColumnPrice:
+		return optionalValue(product.Price)
+	case v1.ProductExportColumnRegularPrice:
+		return optionalValue(product.RegularPrice)
+	case v1.ProductExportColumnCgstRate:
+		return optionalValue(product.CgstRate)
+	case v1.ProductExportColumnSgstRate:
+		return optionalValue(product.SgstRate)
+	case v1.ProductExportColumnHsnCode:
+		return optionalValue(product.HsnCode)
+	case v1.ProductExportColumnSku:
+		return optionalValue(product.Sku)
+	case v1.ProductExportColumnBarcodes:
+		return valueOrZero(product.Barcodes)
+	case v1.ProductExportColumnCategory:
+		if product.CategoryId == nil {
+			return nil
+		}
+		return categoryPath(categories, *product.CategoryId)
+	case v1.ProductExportColumnCategoryId:
+		return optionalValue(product.CategoryId)
+	case v1.ProductExportColumnUnit:
+		return string(valueOrZero(product.Unit))
+	case v1.ProductExportColumnPurchaseUnit:
+		return optionalValue(product.PurchaseUnit)
+	case v1.ProductExportColumnPurchaseUnitFactor:
+		return optionalValue(product.PurchaseUnitFactor)
+	case v1.ProductExportColumnStockOnHand:
+		return valueOrZero(product.StockOnHand)
+	case v1.ProductExportColumnParentId:
+		return optionalValue(product.ParentId)
+	case v1.ProductExportColumnVariantLabel:
+		return optionalValue(product.VariantLabel)
+	case v1.ProductExportColumnUpdatedAt:
+		return optionalValue(product.UpdatedAt)
+	case v1.ProductExportColumnActive:
+		return optionalValue(product.Active)
+	default:
+		return nil
+	}
+}
+
+// optionalValue dereferences v, returning an untyped nil for a nil pointer.
+func optionalValue[T any](v *T) any {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
@@ -0,0 +1,445 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/ewaybill"
+	"github.com/nitinjangam/pos-receipt-system/internal/spreadsheet"
+)
+
+// maxImportRows caps the data rows of one import file.
+const maxImportRows = 10000
+
+// importFields are the product fields a column can be mapped to.
+var importFields = []string{
+	"name", "description", "price", "cgstRate", "sgstRate", "hsnCode", "sku", "barcodes",
+	"category", "unit", "purchaseUnit", "purchaseUnitFactor",
+}
+
+// ProductImport is an uploaded product file and how to read it.
+type ProductImport struct {
+	Filename string
+	File     io.Reader
+	// Mapping maps product fields to column headers; unmapped fields are
+	// read from the column whose header matches the field name.
+	Mapping map[string]string
+	Sheet   string
+	Mode    v1.ProductImportMode
+	DryRun  bool
+}
+
+// importBatch collects the validated rows of an import file along with what
+// they claim, so that later rows cannot reuse a name, SKU or barcode.
+type importBatch struct {
+	mode       v1.ProductImportMode
+	header     []string
+	columns    map[string]int
+	categories map[string][]int
+	names      map[string]int
+	skus       map[string]int
+	barcodes   map[string]int
+
+	created []v1.Product
+	updated []v1.Product
+	rates   []v1.TaxRate
+}
+
+// ImportProducts validates every row of the file and, unless it is a dry run
+// or any row is invalid, creates and updates the products in one
+// transaction. Row errors are returned in the report; the error is only set
+// when the file cannot be read at all.
+func (s *ProductService) ImportProducts(ctx context.Context, upload ProductImport) (v1.ProductImportReport, error) {
+	if upload.Mode == "" {
+		upload.Mode = v1.Create
+	}
+	rows, err := spreadsheet.Read(upload.Filename, upload.File, upload.Sheet)
+	if err != nil {
+		return v1.ProductImportReport{}, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+	}
+	if len(rows) == 0 {
+		return v1.ProductImportReport{}, fmt.Errorf("%w: the file is empty", ErrInvalidImport)
+	}
+	columns, err := importColumns(rows[0], upload.Mapping)
+	if err != nil {
+		return v1.ProductImportReport{}, err
+	}
+	categories, err := s.categoriesByPath(ctx)
+	if err != nil {
+		return v1.ProductImportReport{}, err
+	}
+
+	batch := &importBatch{
+		mode:       upload.Mode,
+		header:     rows[0],
+		columns:    columns,
+		categories: categories,
+		names:      map[string]int{},
+		skus:       map[string]int{},
+		barcodes:   map[string]int{},
+	}
+	report := v1.ProductImportReport{
+		DryRun:  &upload.DryRun,
+		Mode:    &upload.Mode,
+		Rows:    new(int),
+		Created: new(int),
+		Updated: new(int),
+		Errors:  &[]v1.ProductImportError{},
+	}
+	for i, row := range rows[1:] {
+		if blankRow(row) {
+			continue
+		}
+		if *report.Rows++; *report.Rows > maxImportRows {
+			return v1.ProductImportReport{}, fmt.Errorf("%w: more than %d rows", ErrInvalidImport, maxImportRows)
+		}
+		// Rows are numbered as in the spreadsheet, the header being row 1.
+		rowErrors, err := s.importRow(ctx, batch, i+2, row)
+		if err != nil {
+			return v1.ProductImportReport{}, err
+		}
+		*report.Errors = append(*report.Errors, rowErrors...)
+	}
+
+	*report.Created, *report.Updated = len(batch.created), len(batch.updated)
+	if upload.DryRun || len(*report.Errors) > 0 {
+		return report, nil
+	}
+	if err := s.productRepo.ImportProducts(ctx, batch.created, batch.updated, batch.rates); err != nil {
+		s.logger.Debugw("Failed to import products", "error", err, "file", upload.Filename)
+		return v1.ProductImportReport{}, err
+	}
+	s.logger.Infow("Products imported", "file", upload.Filename, "created", *report.Created, "updated", *report.Updated)
+	return report, nil
+}
+
+// importRow reads one row into a new product or, in upsert mode, an update
+// of the product with the row's SKU, and adds it to the batch if it is
+// valid.
+func (s *ProductService) importRow(ctx context.Context, batch *importBatch, line int, row []string) ([]v1.ProductImportError, error) {
+	var rowErrors []v1.ProductImportError
+	fail := func(field, format string, args ...any) {
+		message := fmt.Sprintf(format, args...)
+		rowError := v1.ProductImportError{Row: &line, Message: &message}
+		if index, ok := batch.columns[field]; ok {
+			rowError.Column = &batch.header[index]
+		}
+		rowErrors = append(rowErrors, rowError)
+	}
+	cell := func(field string) (string, bool) {
+		index, ok := batch.columns[field]
+		if !ok {
+			return "", false
+		}
+		if index >= len(row) {
+			return "", true
+		}
+		return strings.TrimSpace(row[index]), true
+	}
+
+	// In upsert mode the SKU picks the product to update, and columns that
+	// are not imported keep its values.
+	var product, existing v1.Product
+	sku, _ := cell("sku")
+	if batch.mode == v1.Upsert && sku != "" {
+		owner, err := s.productRepo.GetProductBySKU(ctx, sku)
+		if err != nil {
+			s.logger.Debugw("Failed to get product by SKU", "error", err, "sku", sku)
+			return nil, err
+		}
+		if owner != nil {
+			product, existing = *owner, *owner
+		}
+	}
+
+	if name, ok := cell("name"); ok {
+		product.Name = &name
+	}
+	if valueOrZero(product.Name) == "" {
+		fail("name", "name is required")
+	}
+	if description, ok := cell("description"); ok {
+		product.Description = optional(description)
+	}
+	if value, ok := cell("price"); ok {
+		product.Price = nil
+		price, err := parseImportNumber(value)
+		switch {
+		case value == "":
+			fail("price", "price is required")
+		case err != nil:
+			fail("price", "price %q is not a number", value)
+		case price < 0:
+			fail("price", "price cannot be negative")
+		default:
+			product.Price = float32Ptr(price)
+		}
+	} else if product.Price == nil {
+		fail("price", "price is required")
+	}
+	for _, field := range []string{"cgstRate", "sgstRate"} {
+		value, ok := cell(field)
+		if !ok {
+			continue
+		}
+		var rate *float32
+		if value != "" {
+			parsed, err := parseImportNumber(strings.TrimSuffix(value, "%"))
+			if err != nil || parsed < 0 || parsed > 100 {
+				fail(field, "%s %q is not a percentage between 0 and 100", field, value)
+				continue
+			}
+			rate = float32Ptr(parsed)
+		}
+		if field == "cgstRate" {
+			product.CgstRate = rate
+		} else {
+			product.SgstRate = rate
+		}
+	}
+	if hsnCode, ok := cell("hsnCode"); ok {
+		product.HsnCode = optional(hsnCode)
+		if hsnCode != "" && !ewaybill.ValidHSN(hsnCode) {
+			fail("hsnCode", "HSN code %q must have 4, 6 or 8 digits", hsnCode)
+		}
+	}
+	if _, ok := cell("sku"); ok {
+		product.Sku = optional(sku)
+	}
+	if value, ok := cell("barcodes"); ok {
+		barcodes := strings.FieldsFunc(value, func(r rune) bool {
+			return r == ',' || r == ';' || r == '|' || unicode.IsSpace(r)
+		})
+		product.Barcodes = &barcodes
+	}
+	if category, ok := cell("category"); ok {
+		product.CategoryId = nil
+		if category != "" {
+			id, err := batch.category(category)
+			if err != nil {
+				fail("category", "%v", err)
+			}
+			product.CategoryId = id
+		}
+	}
+	if unit, ok := cell("unit"); ok {
+		product.Unit = (*v1.Unit)(optional(strings.ToLower(unit)))
+	}
+	if purchaseUnit, ok := cell("purchaseUnit"); ok {
+		product.PurchaseUnit = optional(purchaseUnit)
+	}
+	if value, ok := cell("purchaseUnitFactor"); ok {
+		product.PurchaseUnitFactor = nil
+		if value != "" {
+			factor, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				fail("purchaseUnitFactor", "purchase unit factor %q is not a number", value)
+			}
+			product.PurchaseUnitFactor = &factor
+		}
+	}
+	if len(rowErrors) > 0 {
+		return rowErrors, nil
+	}
+
+	// The checks shared with POST and PUT /products.
+	defaultUnit := v1.Pcs
+	if existing.Unit != nil {
+		defaultUnit = *existing.Unit
+	}
+	for _, check := range []struct {
+		field string
+		run   func() error
+	}{
+		{"unit", func() error { return validateUnits(&product, defaultUnit) }},
+		{"supplierId", func() error { return s.validateReordering(ctx, &product) }},
+		{"sku", func() error { return s.validateCodes(ctx, &product) }},
+		{"category", func() error { return s.inheritCategoryDefaults(ctx, &product) }},
+	} {
+		if err := check.run(); err != nil {
+			if !errors.Is(err, ErrInvalidProduct) && !errors.Is(err, ErrProductConflict) {
+				return nil, err
+			}
+			fail(check.field, "%v", err)
+			return rowErrors, nil
+		}
+	}
+	if product.CgstRate == nil {
+		fail("cgstRate", "cgstRate is required unless the category sets it")
+	}
+	if product.SgstRate == nil {
+		fail("sgstRate", "sgstRate is required unless the category sets it")
+	}
+
+	// Names, SKUs and barcodes must be unique across the file, and a name
+	// may only be taken from the product being updated.
+	name := strings.ToLower(*product.Name)
+	if other, ok := batch.names[name]; ok {
+		fail("name", "name %q is also used on row %d", *product.Name, other)
+	} else if existing.Id == nil || !strings.EqualFold(*product.Name, valueOrZero(existing.Name)) {
+		owner, err := s.productRepo.GetProductByName(ctx, *product.Name)
+		if err != nil {
+			s.logger.Debugw("Failed to get product by name", "error", err, "product_name", *product.Name)
+			return nil, err
+		}
+		if owner != nil && !sameProduct(owner, &product) {
+			fail("name", "name %q already belongs to product %d", *product.Name, valueOrZero(owner.Id))
+		}
+	}
+	if product.Sku != nil {
+		if other, ok := batch.skus[*product.Sku]; ok {
+			fail("sku", "SKU %s is also used on row %d", *product.Sku, other)
+		}
+	}
+	for _, code := range valueOrZero(product.Barcodes) {
+		if other, ok := batch.barcodes[code]; ok {
+			fail("barcodes", "barcode %s is also used on row %d", code, other)
+		}
+	}
+	if len(rowErrors) > 0 {
+		return rowErrors, nil
+	}
+
+	batch.names[name] = line
+	if product.Sku != nil {
+		batch.skus[*product.Sku] = line
+	}
+	for _, code := range valueOrZero(product.Barcodes) {
+		batch.barcodes[code] = line
+	}
+	if existing.Id == nil {
+		batch.created = append(batch.created, product)
+		return nil, nil
+	}
+	return nil, s.addImportUpdate(ctx, batch, existing, product)
+}
+
+// addImportUpdate adds the update of an existing product to the batch along
+// with the updates it carries over to the product's variants.
+func (s *ProductService) addImportUpdate(ctx context.Context, batch *importBatch, existing, product v1.Product) error {
+	keepRegularPrice(existing, &product)
+	updates, rates, err := s.productUpdates(ctx, existing, product)
+	if err != nil {
+		return err
+	}
+	batch.updated = append(batch.updated, updates...)
+	batch.rates = append(batch.rates, rates...)
+	return nil
+}
+
+// category resolves a category cell, which holds a category ID, a full path
+// such as "Groceries > Rice", or a name found only once in the tree.
+func (b *importBatch) category(value string) (*int, error) {
+	if id, err := strconv.Atoi(value); err == nil {
+		for _, ids := range b.categories {
+			if slices.Contains(ids, id) {
+				return &id, nil
+			}
+		}
+		return nil, fmt.Errorf("category %d not found", id)
+	}
+
+	ids := b.categories[strings.ToLower(value)]
+	switch len(ids) {
+	case 0:
+		return nil, fmt.Errorf("category %q not found", value)
+	case 1:
+		return &ids[0], nil
+	default:
+		return nil, fmt.Errorf("category name %q is ambiguous, use its full path", value)
+	}
+}
+
+// categoriesByPath indexes the category IDs by lower-case path and by
+// lower-case name.
+func (s *ProductService) categoriesByPath(ctx context.Context) (map[string][]int, error) {
+	categories, err := s.categoryRepo.GetAllCategories(ctx)
+	if err != nil {
+		s.logger.Debugw("Failed to get categories", "error", err)
+		return nil, err
+	}
+
+	byID := categoriesByID(categories)
+	index := map[string][]int{}
+	for _, category := range categories {
+		path := strings.ToLower(categoryPath(byID, *category.Id))
+		index[path] = append(index[path], *category.Id)
+		if name := strings.ToLower(category.Name); name != path {
+			index[name] = append(index[name], *category.Id)
+		}
+	}
+	return index, nil
+}
+
+// importColumns maps each product field to the index of its column, first
+// from the explicit mapping and then by matching headers to field names.
+func importColumns(header []string, mapping map[string]string) (map[string]int, error) {
+	columns := map[string]int{}
+	for field, column := range mapping {
+		if !slices.Contains(importFields, field) {
+			return nil, fmt.Errorf("%w: unknown field %q in mapping, expected one of %s", ErrInvalidImport, field, strings.Join(importFields, ", "))
+		}
+		index := slices.IndexFunc(header, func(h string) bool { return strings.EqualFold(strings.TrimSpace(h), strings.TrimSpace(column)) })
+		if index < 0 {
+			return nil, fmt.Errorf("%w: column %q mapped to %s not found", ErrInvalidImport, column, field)
+		}
+		columns[field] = index
+	}
+
+	for _, field := range importFields {
+		if _, ok := columns[field]; ok {
+			continue
+		}
+		key := headerKey(field)
+		index := slices.IndexFunc(header, func(h string) bool { return headerKey(h) == key || headerKey(h)+"s" == key })
+		if index >= 0 {
+			columns[field] = index
+		}
+	}
+
+	if _, ok := columns["name"]; !ok {
+		return nil, fmt.Errorf("%w: no column for the product name", ErrInvalidImport)
+	}
+	return columns, nil
+}
+
+// headerKey lower-cases a header and drops everything but letters and
+// digits, so that "HSN Code", "hsn_code" and hsnCode compare equal.
+func headerKey(header string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, header)
+}
+
+// parseImportNumber parses a number that may carry a rupee sign and
+// thousands separators.
+func parseImportNumber(value string) (float64, error) {
+	return strconv.ParseFloat(strings.NewReplacer("₹", "", ",", "", " ", "").Replace(value), 64)
+}
+
+func blankRow(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// optional returns nil for an empty string.
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -3,45 +3,90 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
 
 	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/barcode"
 	"github.com/nitinjangam/pos-receipt-system/internal/repository"
+	"github.com/nitinjangam/pos-receipt-system/internal/search"
+	"github.com/nitinjangam/pos-receipt-system/internal/uom"
 	"go.uber.org/zap"
 )
 
 type ProductServiceInterface interface {
-	GetProducts(ctx context.Context, productName string) ([]v1.Product, error)
+	GetProducts(ctx context.Context, params v1.GetProductsParams) ([]v1.Product, error)
 	PostProducts(ctx context.Context, products v1.Product) error
 	PutProductsId(ctx context.Context, product v1.Product) (v1.Product, error)
 	DeleteProductsId(ctx context.Context, id int) error
+	ArchiveProduct(ctx context.Context, id int) (v1.Product, error)
+	UnarchiveProduct(ctx context.Context, id int) (v1.Product, error)
+	LookupProduct(ctx context.Context, code string) (v1.Product, error)
+	GenerateBarcode(ctx context.Context, id int) (v1.Product, error)
+	GetBarcodeImage(code string, format v1.GetBarcodesCodeParamsFormat) ([]byte, error)
+	GetVariants(ctx context.Context, id int) ([]v1.Product, error)
+	SearchProducts(ctx context.Context, params v1.GetProductsSearchParams) (v1.ProductSearchResults, error)
+	ImportProducts(ctx context.Context, upload ProductImport) (v1.ProductImportReport, error)
+	ExportProducts(ctx context.Context, params v1.GetProductsExportParams, w io.Writer) error
+	BulkUpdateProducts(ctx context.Context, update v1.ProductBulkUpdate, dryRun bool) (v1.ProductBulkUpdate, error)
+	GetBulkUpdates(ctx context.Context) ([]v1.ProductBulkUpdate, error)
+	GenerateVariants(ctx context.Context, id int, request v1.VariantGeneration) ([]v1.Product, error)
 }
 
+// maxVariants caps the combinations one product can expand into.
+const maxVariants = 1000
+
+const (
+	defaultSearchLimit = 20
+	// maxSearchLimit also bounds the products returned for GET /products?name=.
+	maxSearchLimit = 100
+)
+
 type ProductService struct {
-	productRepo *repository.ProductRepository
-	logger      *zap.SugaredLogger
+	productRepo  *repository.ProductRepository
+	categoryRepo *repository.CategoryRepository
+	supplierRepo *repository.SupplierRepository
+	imageService ImageServiceInterface
+	logger       *zap.SugaredLogger
 }
 
-func NewProductService(productRepository *repository.ProductRepository, logger *zap.SugaredLogger) *ProductService {
+func NewProductService(productRepository *repository.ProductRepository, categoryRepository *repository.CategoryRepository, supplierRepository *repository.SupplierRepository, imageService ImageServiceInterface,
+	logger *zap.SugaredLogger) *ProductService {
 	return &ProductService{
-		productRepo: productRepository,
-		logger:      logger,
+		productRepo:  productRepository,
+		categoryRepo: categoryRepository,
+		supplierRepo: supplierRepository,
+		imageService: imageService,
+		logger:       logger,
 	}
 }
 
-func (s *ProductService) GetProducts(ctx context.Context, prodctName string) ([]v1.Product, error) {
+// GetProducts lists the catalogue, leaving out archived products unless
+// asked for them.
+func (s *ProductService) GetProducts(ctx context.Context, params v1.GetProductsParams) ([]v1.Product, error) {
+	prodctName := valueOrZero(params.Name)
+	if params.Category != nil {
+		products, err := s.getProductsInCategory(ctx, *params.Category, prodctName)
+		if err != nil {
+			return nil, err
+		}
+		return activeProducts(products, valueOrZero(params.IncludeArchived)), nil
+	}
 	if prodctName != "" {
-		// Get product by name from the repository
-		product, err := s.productRepo.GetProductByName(ctx, prodctName)
+		// Partial matches come from the search index, best match first
+		limit := maxSearchLimit
+		results, err := s.SearchProducts(ctx, v1.GetProductsSearchParams{Q: prodctName, Limit: &limit})
 		if err != nil {
-			s.logger.Debugw("Failed to get product by name", "error", err, "product_name", prodctName)
 			return nil, err
 		}
-		if product == nil {
-			s.logger.Debugw("Product not found", "product_name", prodctName)
-			return nil, errors.New("product not found")
+		products := []v1.Product{}
+		for _, result := range valueOrZero(results.Results) {
+			products = append(products, *result.Product)
 		}
-		// Return a slice with the single product
-		return []v1.Product{*product}, nil
+		return products, nil
 	}
 	// Get all products from the repository
 	products, err := s.productRepo.GetAllProducts(ctx)
@@ -55,10 +100,72 @@ func (s *ProductService) GetProducts(ctx context.Context, prodctName string) ([]
 		return nil, nil // or return an empty slice if preferred
 	}
 
-	return products, nil
+	return activeProducts(products, valueOrZero(params.IncludeArchived)), nil
+}
+
+// activeProducts drops the archived products unless includeArchived is set.
+func activeProducts(products []v1.Product, includeArchived bool) []v1.Product {
+	if includeArchived {
+		return products
+	}
+	active := []v1.Product{}
+	for _, product := range products {
+		if !archived(product) {
+			active = append(active, product)
+		}
+	}
+	return active
+}
+
+// getProductsInCategory lists the products in the category and its
+// descendants, optionally narrowed to those whose name contains productName.
+func (s *ProductService) getProductsInCategory(ctx context.Context, categoryID int, productName string) ([]v1.Product, error) {
+	category, err := s.categoryRepo.GetCategoryByID(ctx, categoryID)
+	if err != nil {
+		s.logger.Debugw("Failed to get category by ID", "error", err, "category_id", categoryID)
+		return nil, err
+	}
+	if category == nil {
+		return nil, ErrCategoryNotFound
+	}
+
+	products, err := s.productRepo.GetProductsInCategory(ctx, categoryID)
+	if err != nil {
+		s.logger.Debugw("Failed to get products in category", "error", err, "category_id", categoryID)
+		return nil, err
+	}
+	if productName == "" {
+		return products, nil
+	}
+
+	matches := []v1.Product{}
+	for _, product := range products {
+		if strings.Contains(strings.ToLower(valueOrZero(product.Name)), strings.ToLower(productName)) {
+			matches = append(matches, product)
+		}
+	}
+	return matches, nil
 }
 
 func (s *ProductService) PostProducts(ctx context.Context, product v1.Product) error {
+	// Variants are only created by GenerateVariants.
+	product.ParentId, product.VariantLabel, product.OptionValues, product.VariantOptions = nil, nil, nil, nil
+	if err := validateUnits(&product, v1.Pcs); err != nil {
+		return err
+	}
+	if err := s.validateReordering(ctx, &product); err != nil {
+		return err
+	}
+	if err := s.validateBundle(ctx, &product, v1.Product{}); err != nil {
+		return err
+	}
+	if err := s.validateCodes(ctx, &product); err != nil {
+		return err
+	}
+	if err := s.inheritCategoryDefaults(ctx, &product); err != nil {
+		return err
+	}
+
 	// Check if the product already exists
 	existingProduct, err := s.productRepo.GetProductByName(ctx, *product.Name)
 	if err != nil {
@@ -84,11 +191,37 @@ func (s *ProductService) PutProductsId(ctx context.Context, product v1.Product)
 	}
 	if existingProduct == nil {
 		s.logger.Debugw("Product not found", "product_id", product.Id)
-		return v1.Product{}, errors.New("product not found")
+		return v1.Product{}, ErrProductNotFound
+	}
+	if err := validateUnits(&product, valueOrZero(existingProduct.Unit)); err != nil {
+		return v1.Product{}, err
+	}
+	if err := s.validateReordering(ctx, &product); err != nil {
+		return v1.Product{}, err
+	}
+	if err := s.validateBundle(ctx, &product, *existingProduct); err != nil {
+		return v1.Product{}, err
+	}
+	if err := s.validateCodes(ctx, &product); err != nil {
+		return v1.Product{}, err
 	}
+	if err := s.inheritCategoryDefaults(ctx, &product); err != nil {
+		return v1.Product{}, err
+	}
+
+	// The variant fields are not editable.
+	product.ParentId, product.VariantLabel = existingProduct.ParentId, existingProduct.VariantLabel
+	product.OptionValues, product.VariantOptions = existingProduct.OptionValues, existingProduct.VariantOptions
 
-	// Update the product in the repository
-	if err := s.productRepo.UpdateProduct(ctx, product); err != nil {
+	keepRegularPrice(*existingProduct, &product)
+
+	// Update the product and its variants, with any manual rate changes, in
+	// one transaction
+	updates, rates, err := s.productUpdates(ctx, *existingProduct, product)
+	if err != nil {
+		return v1.Product{}, err
+	}
+	if err := s.productRepo.UpdateProducts(ctx, updates, rates); err != nil {
 		s.logger.Debugw("Failed to update product", "error", err, "product", product)
 		return v1.Product{}, err
 	}
@@ -96,6 +229,62 @@ func (s *ProductService) PutProductsId(ctx context.Context, product v1.Product)
 	return product, nil
 }
 
+// productUpdates returns the update of an existing product along with the
+// updates it carries over to the product's variants, and the tax rate
+// changes to record with them. A variant whose regular price still equals
+// the parent's old one follows the new price; any other price is an override
+// and is kept. A scheduled rate that is already in effect would shadow the
+// rates stored on a product, so a manual rate change is recorded as
+// effective from now.
+func (s *ProductService) productUpdates(ctx context.Context, existing, product v1.Product) ([]v1.Product, []v1.TaxRate, error) {
+	updates := []v1.Product{product}
+	previous := []v1.Product{existing}
+	if hasVariants(existing) {
+		variants, err := s.productRepo.GetVariants(ctx, *existing.Id)
+		if err != nil {
+			s.logger.Debugw("Failed to get variants", "error", err, "product_id", *existing.Id)
+			return nil, nil, err
+		}
+		for _, variant := range variants {
+			updates = append(updates, updatedVariant(existing, product, variant))
+			previous = append(previous, variant)
+		}
+	}
+
+	var rates []v1.TaxRate
+	for i, update := range updates {
+		if taxRatesChanged(previous[i], update) {
+			rates = append(rates, manualRateChange(update))
+		}
+	}
+	return updates, rates, nil
+}
+
+// updatedVariant returns the variant with the shared fields of the updated
+// parent.
+func updatedVariant(existing, parent, variant v1.Product) v1.Product {
+	updated := variant
+	updated.Price = variant.RegularPrice
+	updated.Name, updated.Description = parent.Name, parent.Description
+	updated.HsnCode, updated.CategoryId = parent.HsnCode, parent.CategoryId
+	updated.CgstRate, updated.SgstRate = parent.CgstRate, parent.SgstRate
+	updated.Unit, updated.PurchaseUnit, updated.PurchaseUnitFactor = parent.Unit, parent.PurchaseUnit, parent.PurchaseUnitFactor
+	updated.BatchTracked = parent.BatchTracked
+	if valueOrZero(variant.RegularPrice) == valueOrZero(existing.RegularPrice) {
+		updated.Price = parent.Price
+	}
+	return updated
+}
+
+// keepRegularPrice leaves the regular price alone when the product comes back
+// with the scheduled price it was read at, so that saving a product during a
+// sale does not make the sale price permanent.
+func keepRegularPrice(existing v1.Product, product *v1.Product) {
+	if existing.PriceScheduleId != nil && product.Price != nil && *product.Price == valueOrZero(existing.Price) {
+		product.Price = existing.RegularPrice
+	}
+}
+
 func (s *ProductService) DeleteProductsId(ctx context.Context, id int) error {
 	// Check if the product exists
 	existingProduct, err := s.productRepo.GetProductByID(ctx, id)
@@ -105,14 +294,769 @@ func (s *ProductService) DeleteProductsId(ctx context.Context, id int) error {
 	}
 	if existingProduct == nil {
 		s.logger.Debugw("Product not found", "product_id", id)
-		return errors.New("product not found")
+		return ErrProductNotFound
 	}
 
-	// Delete the product from the repository
-	if err := s.productRepo.DeleteProduct(ctx, id); err != nil {
+	// Delete the product with its variants, which cannot outlive their
+	// parent, then the files of their images. Products that sales, purchase
+	// orders, the stock ledger, bundles, stocktakes or transfers refer to have
+	// to stay, which the repository checks as it deletes.
+	keys, err := s.productRepo.DeleteProduct(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrProductInUse) {
+			return fmt.Errorf("%w: %v", ErrProductConflict, err)
+		}
 		s.logger.Debugw("Failed to delete product", "error", err, "product_id", id)
 		return err
 	}
+	s.imageService.DeleteImageFiles(ctx, keys)
 
 	return nil
 }
+
+// ArchiveProduct takes the product and its variants out of the catalogue.
+func (s *ProductService) ArchiveProduct(ctx context.Context, id int) (v1.Product, error) {
+	return s.setActive(ctx, id, false)
+}
+
+// UnarchiveProduct returns the product and its variants to the catalogue. A
+// variant can only return once its parent has.
+func (s *ProductService) UnarchiveProduct(ctx context.Context, id int) (v1.Product, error) {
+	return s.setActive(ctx, id, true)
+}
+
+func (s *ProductService) setActive(ctx context.Context, id int, active bool) (v1.Product, error) {
+	product, err := s.productRepo.GetProductByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get product by ID", "error", err, "product_id", id)
+		return v1.Product{}, err
+	}
+	if product == nil {
+		return v1.Product{}, ErrProductNotFound
+	}
+	if active && product.ParentId != nil {
+		parent, err := s.productRepo.GetProductByID(ctx, *product.ParentId)
+		if err != nil {
+			s.logger.Debugw("Failed to get product by ID", "error", err, "product_id", *product.ParentId)
+			return v1.Product{}, err
+		}
+		if parent != nil && archived(*parent) {
+			return v1.Product{}, fmt.Errorf("%w: unarchive product %d, the parent of variant %d, first", ErrInvalidProduct, *parent.Id, id)
+		}
+	}
+
+	ids := []int{id}
+	variants, err := s.productRepo.GetVariants(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get variants", "error", err, "product_id", id)
+		return v1.Product{}, err
+	}
+	for _, variant := range variants {
+		ids = append(ids, *variant.Id)
+	}
+	if err := s.productRepo.SetActive(ctx, ids, active); err != nil {
+		s.logger.Debugw("Failed to set product active", "error", err, "product_id", id, "active", active)
+		return v1.Product{}, err
+	}
+	s.logger.Infow("Product active flag changed", "product_id", id, "active", active, "variants", len(variants))
+
+	product, err = s.productRepo.GetProductByID(ctx, id)
+	if err != nil {
+		return v1.Product{}, err
+	}
+	return *product, nil
+}
+
+// archived reports whether the product has been taken out of the catalogue.
+func archived(product v1.Product) bool {
+	return product.Active != nil && !*product.Active
+}
+
+// LookupProduct finds the product carrying a scanned barcode. A UPC-A also
+// matches its EAN-13 form and the other way round. Archived products are not
+// found.
+func (s *ProductService) LookupProduct(ctx context.Context, code string) (v1.Product, error) {
+	code = barcode.Normalize(code)
+	if err := barcode.Validate(code); err != nil {
+		return v1.Product{}, fmt.Errorf("%w: %v", ErrInvalidBarcode, err)
+	}
+
+	product, err := s.productRepo.GetProductByBarcode(ctx, barcode.Equivalents(code))
+	if err != nil {
+		s.logger.Debugw("Failed to get product by barcode", "error", err, "barcode", code)
+		return v1.Product{}, err
+	}
+	if product == nil || archived(*product) {
+		return v1.Product{}, ErrProductNotFound
+	}
+	return *product, nil
+}
+
+// GenerateBarcode assigns an internal EAN-13, derived from the product ID, to
+// a loose item that has no barcode of its own.
+func (s *ProductService) GenerateBarcode(ctx context.Context, id int) (v1.Product, error) {
+	product, err := s.productRepo.GetProductByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get product by ID", "error", err, "product_id", id)
+		return v1.Product{}, err
+	}
+	if product == nil {
+		return v1.Product{}, ErrProductNotFound
+	}
+	if barcodes := valueOrZero(product.Barcodes); len(barcodes) > 0 {
+		return v1.Product{}, fmt.Errorf("%w: product %d already has barcode %s", ErrProductConflict, id, barcodes[0])
+	}
+
+	code := barcode.Internal(id)
+	owner, err := s.productRepo.GetProductByBarcode(ctx, []string{code})
+	if err != nil {
+		return v1.Product{}, err
+	}
+	if owner != nil {
+		return v1.Product{}, fmt.Errorf("%w: barcode %s already belongs to product %d", ErrProductConflict, code, valueOrZero(owner.Id))
+	}
+	if err := s.productRepo.AddBarcode(ctx, id, code); err != nil {
+		s.logger.Debugw("Failed to add barcode", "error", err, "product_id", id)
+		return v1.Product{}, err
+	}
+
+	s.logger.Infow("Internal barcode generated", "product_id", id, "barcode", code)
+	product.Barcodes = &[]string{code}
+	return *product, nil
+}
+
+// GetBarcodeImage renders a barcode as SVG or PNG.
+func (s *ProductService) GetBarcodeImage(code string, format v1.GetBarcodesCodeParamsFormat) ([]byte, error) {
+	render := barcode.SVG
+	if format == v1.Png {
+		render = barcode.PNG
+	}
+	image, err := render(barcode.Normalize(code))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBarcode, err)
+	}
+	return image, nil
+}
+
+// GetVariants lists the variants generated from the product.
+func (s *ProductService) GetVariants(ctx context.Context, id int) ([]v1.Product, error) {
+	product, err := s.productRepo.GetProductByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get product by ID", "error", err, "product_id", id)
+		return nil, err
+	}
+	if product == nil {
+		return nil, ErrProductNotFound
+	}
+
+	variants, err := s.productRepo.GetVariants(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get variants", "error", err, "product_id", id)
+		return nil, err
+	}
+	if variants == nil {
+		variants = []v1.Product{}
+	}
+	return variants, nil
+}
+
+// GenerateVariants sets the options of the product and creates a variant for
+// every combination of option values that does not have one yet. Variants
+// copy the parent's name, description, price, HSN code, tax rates, category
+// and units; their SKU is the parent's SKU followed by the option values.
+// Once variants exist, values can be added to the options but the options
+// themselves cannot change, so that existing labels stay meaningful.
+func (s *ProductService) GenerateVariants(ctx context.Context, id int, request v1.VariantGeneration) ([]v1.Product, error) {
+	parent, err := s.productRepo.GetProductByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get product by ID", "error", err, "product_id", id)
+		return nil, err
+	}
+	if parent == nil {
+		return nil, ErrProductNotFound
+	}
+	if parent.ParentId != nil {
+		return nil, fmt.Errorf("%w: product %d is a variant of product %d", ErrInvalidProduct, id, *parent.ParentId)
+	}
+	if archived(*parent) {
+		return nil, fmt.Errorf("%w: product %d is archived", ErrInvalidProduct, id)
+	}
+	if isBundle(*parent) {
+		return nil, fmt.Errorf("%w: product %d is a bundle", ErrInvalidProduct, id)
+	}
+	if err := s.checkNotComponent(ctx, id, "given variants"); err != nil {
+		return nil, err
+	}
+
+	options, err := normalizeVariantOptions(request.Options)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.productRepo.GetVariants(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get variants", "error", err, "product_id", id)
+		return nil, err
+	}
+	if len(existing) > 0 && !sameOptionNames(valueOrZero(parent.VariantOptions), options) {
+		return nil, fmt.Errorf("%w: product %d already has variants by %s", ErrInvalidProduct, id,
+			strings.Join(optionNames(valueOrZero(parent.VariantOptions)), ", "))
+	}
+	if len(existing) > 0 {
+		options = mergeVariantOptions(valueOrZero(parent.VariantOptions), options)
+	}
+
+	labels := map[string]bool{}
+	for _, variant := range existing {
+		labels[valueOrZero(variant.VariantLabel)] = true
+	}
+	skus := map[string]bool{}
+	var variants []v1.Product
+	for _, values := range variantCombinations(options) {
+		label := strings.Join(values, " / ")
+		if labels[label] {
+			continue
+		}
+		if len(existing)+len(variants) >= maxVariants {
+			return nil, fmt.Errorf("%w: more than %d variants", ErrInvalidProduct, maxVariants)
+		}
+
+		optionValues := map[string]string{}
+		for i, option := range options {
+			optionValues[option.Name] = values[i]
+		}
+		variant := v1.Product{
+			Name:               parent.Name,
+			Description:        parent.Description,
+			Price:              parent.RegularPrice,
+			HsnCode:            parent.HsnCode,
+			CgstRate:           parent.CgstRate,
+			SgstRate:           parent.SgstRate,
+			CategoryId:         parent.CategoryId,
+			Unit:               parent.Unit,
+			PurchaseUnit:       parent.PurchaseUnit,
+			PurchaseUnitFactor: parent.PurchaseUnitFactor,
+			BatchTracked:       parent.BatchTracked,
+			SupplierId:         parent.SupplierId,
+			VariantLabel:       &label,
+			OptionValues:       &optionValues,
+		}
+		if parent.Sku != nil {
+			sku := variantSKU(*parent.Sku, values)
+			if skus[sku] {
+				return nil, fmt.Errorf("%w: SKU %s would be generated twice", ErrProductConflict, sku)
+			}
+			skus[sku] = true
+			variant.Sku = &sku
+		}
+		if err := s.validateCodes(ctx, &variant); err != nil {
+			return nil, err
+		}
+		variants = append(variants, variant)
+	}
+
+	if err := s.productRepo.CreateVariants(ctx, id, options, variants); err != nil {
+		s.logger.Debugw("Failed to create variants", "error", err, "product_id", id)
+		return nil, err
+	}
+
+	s.logger.Infow("Variants generated", "product_id", id, "created", len(variants))
+	return s.GetVariants(ctx, id)
+}
+
+// normalizeVariantOptions trims option names and values, drops repeated
+// values and rejects empty or repeated options.
+func normalizeVariantOptions(options []v1.VariantOption) ([]v1.VariantOption, error) {
+	names := map[string]bool{}
+	normalized := make([]v1.VariantOption, 0, len(options))
+	for _, option := range options {
+		name := strings.TrimSpace(option.Name)
+		if name == "" {
+			return nil, fmt.Errorf("%w: option name is required", ErrInvalidProduct)
+		}
+		if names[strings.ToLower(name)] {
+			return nil, fmt.Errorf("%w: option %s is repeated", ErrInvalidProduct, name)
+		}
+		names[strings.ToLower(name)] = true
+
+		seen := map[string]bool{}
+		values := []string{}
+		for _, value := range option.Values {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				return nil, fmt.Errorf("%w: option %s has an empty value", ErrInvalidProduct, name)
+			}
+			if !seen[value] {
+				seen[value] = true
+				values = append(values, value)
+			}
+		}
+		if len(values) == 0 {
+			return nil, fmt.Errorf("%w: option %s has no values", ErrInvalidProduct, name)
+		}
+		normalized = append(normalized, v1.VariantOption{Name: name, Values: values})
+	}
+	return normalized, nil
+}
+
+// variantCombinations returns every combination of option values, varying
+// the last option fastest.
+func variantCombinations(options []v1.VariantOption) [][]string {
+	combinations := [][]string{{}}
+	for _, option := range options {
+		var next [][]string
+		for _, combination := range combinations {
+			for _, value := range option.Values {
+				next = append(next, append(append([]string{}, combination...), value))
+			}
+		}
+		combinations = next
+	}
+	return combinations
+}
+
+// variantSKU appends the option values to the parent SKU, e.g. TEE-M-RED.
+func variantSKU(parentSKU string, values []string) string {
+	parts := []string{parentSKU}
+	for _, value := range values {
+		parts = append(parts, strings.ToUpper(strings.Join(strings.Fields(value), "")))
+	}
+	return strings.Join(parts, "-")
+}
+
+// mergeVariantOptions adds the values of the requested options to the
+// current ones, so that values left out of a request are not forgotten.
+func mergeVariantOptions(current, requested []v1.VariantOption) []v1.VariantOption {
+	merged := make([]v1.VariantOption, len(current))
+	for i, option := range current {
+		values := append([]string{}, option.Values...)
+		for _, value := range requested[i].Values {
+			if !slices.Contains(values, value) {
+				values = append(values, value)
+			}
+		}
+		merged[i] = v1.VariantOption{Name: option.Name, Values: values}
+	}
+	return merged
+}
+
+func sameOptionNames(a, b []v1.VariantOption) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !strings.EqualFold(a[i].Name, b[i].Name) {
+			return false
+		}
+	}
+	return true
+}
+
+func optionNames(options []v1.VariantOption) []string {
+	names := make([]string, len(options))
+	for i, option := range options {
+		names[i] = option.Name
+	}
+	return names
+}
+
+// hasVariants reports whether the product is a parent that is sold through
+// its variants.
+func hasVariants(product v1.Product) bool {
+	return len(valueOrZero(product.VariantOptions)) > 0
+}
+
+// isBundle reports whether the product is a bundle that is sold as its
+// components.
+func isBundle(product v1.Product) bool {
+	return len(valueOrZero(product.BundleComponents)) > 0
+}
+
+// validateBundle checks the components of a bundle: each must be a product
+// sold on its own, listed once, in a quantity counted in its unit. A bundle
+// keeps no stock of its own, so it is neither batch tracked nor reordered,
+// and a product with stock on hand or one that is itself a component cannot
+// become one. existing is the product being updated, if any.
+func (s *ProductService) validateBundle(ctx context.Context, product *v1.Product, existing v1.Product) error {
+	if !isBundle(*product) {
+		product.BundleComponents = nil
+		return nil
+	}
+	switch {
+	case existing.ParentId != nil:
+		return fmt.Errorf("%w: variant %d cannot be a bundle", ErrInvalidProduct, *existing.Id)
+	case hasVariants(existing):
+		return fmt.Errorf("%w: product %d is sold through its variants and cannot be a bundle", ErrInvalidProduct, *existing.Id)
+	case valueOrZero(product.BatchTracked):
+		return fmt.Errorf("%w: a bundle cannot be batch tracked, its components are", ErrInvalidProduct)
+	case product.ReorderLevel != nil || product.ReorderQuantity != nil:
+		return fmt.Errorf("%w: a bundle is not reordered, its components are", ErrInvalidProduct)
+	}
+	if existing.Id != nil && !isBundle(existing) {
+		if onHand := valueOrZero(existing.StockOnHand); onHand != 0 {
+			return fmt.Errorf("%w: product %d has %s on hand, adjust it to zero before making it a bundle", ErrInvalidProduct, *existing.Id,
+				uom.Format(onHand, string(valueOrZero(existing.Unit))))
+		}
+		if err := s.checkNotComponent(ctx, *existing.Id, "a bundle"); err != nil {
+			return err
+		}
+	}
+
+	seen := map[int]bool{}
+	components := make([]v1.BundleComponent, 0, len(*product.BundleComponents))
+	for _, component := range *product.BundleComponents {
+		if existing.Id != nil && component.ProductId == *existing.Id {
+			return fmt.Errorf("%w: a bundle cannot contain itself", ErrInvalidProduct)
+		}
+		if seen[component.ProductId] {
+			return fmt.Errorf("%w: component %d is listed twice", ErrInvalidProduct, component.ProductId)
+		}
+		seen[component.ProductId] = true
+
+		item, err := s.productRepo.GetProductByID(ctx, component.ProductId)
+		if err != nil {
+			s.logger.Debugw("Failed to get product by ID", "error", err, "product_id", component.ProductId)
+			return err
+		}
+		switch {
+		case item == nil:
+			return fmt.Errorf("%w: component %d not found", ErrInvalidProduct, component.ProductId)
+		case isBundle(*item):
+			return fmt.Errorf("%w: component %d is itself a bundle", ErrInvalidProduct, component.ProductId)
+		case hasVariants(*item):
+			return fmt.Errorf("%w: component %d is sold through its variants, use one of them", ErrInvalidProduct, component.ProductId)
+		case component.Quantity <= 0:
+			return fmt.Errorf("%w: component %d quantity must be positive", ErrInvalidProduct, component.ProductId)
+		}
+		if err := uom.Check(component.Quantity, string(valueOrZero(item.Unit))); err != nil {
+			return fmt.Errorf("%w: component %d: %v", ErrInvalidProduct, component.ProductId, err)
+		}
+		components = append(components, v1.BundleComponent{ProductId: component.ProductId, Quantity: component.Quantity})
+	}
+	product.BundleComponents = &components
+	return nil
+}
+
+// checkNotComponent refuses a change, described by what the product would be
+// or become, to a product that bundles are made of.
+func (s *ProductService) checkNotComponent(ctx context.Context, id int, change string) error {
+	bundles, err := s.productRepo.GetBundlesWithComponent(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get bundles with component", "error", err, "product_id", id)
+		return err
+	}
+	if len(bundles) > 0 {
+		return fmt.Errorf("%w: product %d is a component of bundle %d and cannot be %s", ErrProductConflict, id, *bundles[0].Id, change)
+	}
+	return nil
+}
+
+// validateCodes normalises the SKU and barcodes of the product, checks the
+// barcode check digits and makes sure no other product uses them.
+func (s *ProductService) validateCodes(ctx context.Context, product *v1.Product) error {
+	if product.Sku != nil {
+		sku := strings.TrimSpace(*product.Sku)
+		product.Sku = &sku
+		if sku == "" {
+			product.Sku = nil
+		}
+	}
+	if product.Sku != nil {
+		owner, err := s.productRepo.GetProductBySKU(ctx, *product.Sku)
+		if err != nil {
+			return err
+		}
+		if owner != nil && !sameProduct(owner, product) {
+			return fmt.Errorf("%w: SKU %s already belongs to product %d", ErrProductConflict, *product.Sku, valueOrZero(owner.Id))
+		}
+	}
+
+	if product.Barcodes == nil {
+		return nil
+	}
+	seen := map[string]bool{}
+	barcodes := []string{}
+	for _, code := range *product.Barcodes {
+		code = barcode.Normalize(code)
+		if err := barcode.Validate(code); err != nil {
+			return fmt.Errorf("%w: barcode %q: %v", ErrInvalidProduct, code, err)
+		}
+		if seen[code] {
+			continue
+		}
+		seen[code] = true
+
+		owner, err := s.productRepo.GetProductByBarcode(ctx, barcode.Equivalents(code))
+		if err != nil {
+			return err
+		}
+		if owner != nil && !sameProduct(owner, product) {
+			return fmt.Errorf("%w: barcode %s already belongs to product %d", ErrProductConflict, code, valueOrZero(owner.Id))
+		}
+		barcodes = append(barcodes, code)
+	}
+	product.Barcodes = &barcodes
+	return nil
+}
+
+// SearchProducts looks the query up in the product search index. Every word
+// must match a name, description, SKU or barcode word by prefix, or the
+// phonetic key of a name or description word. When nothing matches, the
+// words are widened to the indexed terms within search.MaxDistance typos.
+func (s *ProductService) SearchProducts(ctx context.Context, params v1.GetProductsSearchParams) (v1.ProductSearchResults, error) {
+	limit, offset := defaultSearchLimit, valueOrZero(params.Offset)
+	if params.Limit != nil {
+		limit = min(max(*params.Limit, 1), maxSearchLimit)
+	}
+	results := v1.ProductSearchResults{
+		Query:   &params.Q,
+		Limit:   &limit,
+		Offset:  &offset,
+		Total:   new(int),
+		Fuzzy:   new(bool),
+		Results: &[]v1.ProductSearchResult{},
+	}
+
+	terms := search.Terms(params.Q)
+	if len(terms) == 0 {
+		return results, nil
+	}
+
+	alternatives := make([][]string, len(terms))
+	for i, term := range terms {
+		alternatives[i] = []string{quoteTerm(term) + "*"}
+		if key := search.WordKey(term); key != term && len([]rune(key)) > 1 {
+			alternatives[i] = append(alternatives[i], "phonetic : "+quoteTerm(key)+"*")
+		}
+	}
+	found, total, err := s.productRepo.SearchProducts(ctx, matchExpression(alternatives), limit, offset)
+	if err != nil {
+		s.logger.Debugw("Failed to search products", "error", err, "query", params.Q)
+		return v1.ProductSearchResults{}, err
+	}
+
+	if total == 0 {
+		fuzzy, err := s.fuzzyAlternatives(ctx, terms, alternatives)
+		if err != nil {
+			return v1.ProductSearchResults{}, err
+		}
+		if fuzzy != nil {
+			found, total, err = s.productRepo.SearchProducts(ctx, matchExpression(fuzzy), limit, offset)
+			if err != nil {
+				s.logger.Debugw("Failed to search products", "error", err, "query", params.Q)
+				return v1.ProductSearchResults{}, err
+			}
+			*results.Fuzzy = true
+		}
+	}
+
+	for i := range found {
+		found[i].Highlight = marked(found[i].Highlight)
+	}
+	*results.Total = total
+	results.Results = &found
+	return results, nil
+}
+
+// fuzzyAlternatives adds to each term the indexed terms within its typo
+// allowance. It returns nil when no term has any.
+func (s *ProductService) fuzzyAlternatives(ctx context.Context, terms []string, alternatives [][]string) ([][]string, error) {
+	minLength, maxLength := 0, 0
+	for _, term := range terms {
+		n, distance := len([]rune(term)), search.MaxDistance(term)
+		if distance == 0 {
+			continue
+		}
+		if minLength == 0 || n-distance < minLength {
+			minLength = n - distance
+		}
+		maxLength = max(maxLength, n+distance)
+	}
+	if maxLength == 0 {
+		return nil, nil
+	}
+
+	vocabulary, err := s.productRepo.GetSearchTerms(ctx, minLength, maxLength)
+	if err != nil {
+		s.logger.Debugw("Failed to get search terms", "error", err)
+		return nil, err
+	}
+
+	widened := false
+	fuzzy := make([][]string, len(terms))
+	for i, term := range terms {
+		fuzzy[i] = alternatives[i]
+		distance := search.MaxDistance(term)
+		for _, candidate := range vocabulary {
+			if distance > 0 && candidate != term && search.Distance(term, candidate) <= distance {
+				fuzzy[i] = append(fuzzy[i], quoteTerm(candidate))
+				widened = true
+			}
+		}
+	}
+	if !widened {
+		return nil, nil
+	}
+	return fuzzy, nil
+}
+
+// matchExpression requires every term, matching any of its alternatives.
+func matchExpression(alternatives [][]string) string {
+	groups := make([]string, len(alternatives))
+	for i, group := range alternatives {
+		groups[i] = "(" + strings.Join(group, " OR ") + ")"
+	}
+	return strings.Join(groups, " AND ")
+}
+
+// quoteTerm makes a term an FTS5 string so that words such as AND or NEAR
+// are not read as operators.
+func quoteTerm(term string) string {
+	return `"` + strings.ReplaceAll(term, `"`, `""`) + `"`
+}
+
+// marked keeps only the highlighted fields that contain a match, and drops
+// the highlight of a phonetic or fuzzy match altogether.
+func marked(highlight *v1.SearchHighlight) *v1.SearchHighlight {
+	if highlight == nil {
+		return nil
+	}
+	found := false
+	for _, field := range []**string{&highlight.Name, &highlight.Description, &highlight.Sku} {
+		if *field != nil && !strings.Contains(**field, "<mark>") {
+			*field = nil
+		}
+		found = found || *field != nil
+	}
+	if !found {
+		return nil
+	}
+	return highlight
+}
+
+// validateUnits defaults the unit of measure and checks that a purchase unit
+// comes with the number of units it holds.
+func validateUnits(product *v1.Product, defaultUnit v1.Unit) error {
+	if product.Unit == nil || *product.Unit == "" {
+		product.Unit = &defaultUnit
+	}
+	if !uom.Valid(string(*product.Unit)) {
+		return fmt.Errorf("%w: unknown unit %q", ErrInvalidProduct, *product.Unit)
+	}
+
+	if product.PurchaseUnit != nil {
+		purchaseUnit := strings.TrimSpace(*product.PurchaseUnit)
+		product.PurchaseUnit = &purchaseUnit
+		if purchaseUnit == "" {
+			product.PurchaseUnit = nil
+		}
+	}
+	switch {
+	case product.PurchaseUnit != nil && product.PurchaseUnitFactor == nil:
+		return fmt.Errorf("%w: purchase unit %s needs a purchase unit factor", ErrInvalidProduct, *product.PurchaseUnit)
+	case product.PurchaseUnit == nil && product.PurchaseUnitFactor != nil:
+		return fmt.Errorf("%w: purchase unit factor needs a purchase unit", ErrInvalidProduct)
+	case product.PurchaseUnitFactor != nil && *product.PurchaseUnitFactor <= 0:
+		return fmt.Errorf("%w: purchase unit factor must be positive", ErrInvalidProduct)
+	}
+	return nil
+}
+
+// validateReordering checks that the reorder level and quantity are counted
+// in the product's unit and that the preferred supplier exists.
+func (s *ProductService) validateReordering(ctx context.Context, product *v1.Product) error {
+	unit := string(valueOrZero(product.Unit))
+	for _, field := range []struct {
+		name     string
+		quantity *float64
+	}{
+		{"reorder level", product.ReorderLevel},
+		{"reorder quantity", product.ReorderQuantity},
+	} {
+		if field.quantity == nil {
+			continue
+		}
+		if *field.quantity < 0 {
+			return fmt.Errorf("%w: %s must not be negative", ErrInvalidProduct, field.name)
+		}
+		if err := uom.Check(*field.quantity, unit); err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrInvalidProduct, field.name, err)
+		}
+	}
+
+	if product.SupplierId == nil {
+		return nil
+	}
+	supplier, err := s.supplierRepo.GetSupplierByID(ctx, *product.SupplierId)
+	if err != nil {
+		s.logger.Debugw("Failed to get supplier by ID", "error", err, "supplier_id", *product.SupplierId)
+		return err
+	}
+	if supplier == nil {
+		return fmt.Errorf("%w: supplier %d not found", ErrInvalidProduct, *product.SupplierId)
+	}
+	return nil
+}
+
+// inheritCategoryDefaults checks that the product's category exists and fills
+// in the HSN code and tax rates the product leaves out from the nearest
+// category up the tree that sets them.
+func (s *ProductService) inheritCategoryDefaults(ctx context.Context, product *v1.Product) error {
+	if product.CategoryId == nil {
+		return nil
+	}
+
+	seen := map[int]bool{}
+	for id := product.CategoryId; id != nil && !seen[*id]; {
+		seen[*id] = true
+		category, err := s.categoryRepo.GetCategoryByID(ctx, *id)
+		if err != nil {
+			s.logger.Debugw("Failed to get category by ID", "error", err, "category_id", *id)
+			return err
+		}
+		if category == nil {
+			if id == product.CategoryId {
+				return fmt.Errorf("%w: category %d not found", ErrInvalidProduct, *id)
+			}
+			break
+		}
+
+		if product.HsnCode == nil {
+			product.HsnCode = category.HsnCode
+		}
+		if product.CgstRate == nil {
+			product.CgstRate = category.CgstRate
+		}
+		if product.SgstRate == nil {
+			product.SgstRate = category.SgstRate
+		}
+		id = category.ParentId
+	}
+	return nil
+}
+
+func sameProduct(a, b *v1.Product) bool {
+	return a.Id != nil && b.Id != nil && *a.Id == *b.Id
+}
+
+// manualRateChange records the product's rates as effective from now.
+func manualRateChange(product v1.Product) v1.TaxRate {
+	return v1.TaxRate{
+		ProductId:     product.Id,
+		CgstRate:      valueOrZero(product.CgstRate),
+		SgstRate:      valueOrZero(product.SgstRate),
+		EffectiveFrom: time.Now(),
+	}
+}
+
+func taxRatesChanged(existing, updated v1.Product) bool {
+	return valueOrZero(existing.CgstRate) != valueOrZero(updated.CgstRate) ||
+		valueOrZero(existing.SgstRate) != valueOrZero(updated.SgstRate)
+}
+
+func valueOrZero[T any](v *T) T {
+	var zero T
+	if v == nil {
+		return zero
+	}
+	return *v
+}
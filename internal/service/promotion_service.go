@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/promotion"
+	"github.com/nitinjangam/pos-receipt-system/internal/repository"
+	"go.uber.org/zap"
+)
+
+type PromotionServiceInterface interface {
+	GetPromotions(ctx context.Context) ([]v1.Promotion, error)
+	GetPromotion(ctx context.Context, id int) (v1.Promotion, error)
+	PostPromotion(ctx context.Context, promotion v1.Promotion) (v1.Promotion, error)
+	PutPromotion(ctx context.Context, promotion v1.Promotion) (v1.Promotion, error)
+	DeletePromotion(ctx context.Context, id int) error
+}
+
+type PromotionService struct {
+	promotionRepo *repository.PromotionRepository
+	productRepo   *repository.ProductRepository
+	categoryRepo  *repository.CategoryRepository
+	logger        *zap.SugaredLogger
+}
+
+func NewPromotionService(promotionRepository *repository.PromotionRepository, productRepository *repository.ProductRepository,
+	categoryRepository *repository.CategoryRepository, logger *zap.SugaredLogger) *PromotionService {
+	return &PromotionService{
+		promotionRepo: promotionRepository,
+		productRepo:   productRepository,
+		categoryRepo:  categoryRepository,
+		logger:        logger,
+	}
+}
+
+func (s *PromotionService) GetPromotions(ctx context.Context) ([]v1.Promotion, error) {
+	promotions, err := s.promotionRepo.GetAllPromotions(ctx)
+	if err != nil {
+		s.logger.Debugw("Failed to get promotions", "error", err)
+		return nil, err
+	}
+	return promotions, nil
+}
+
+func (s *PromotionService) GetPromotion(ctx context.Context, id int) (v1.Promotion, error) {
+	promotion, err := s.promotionRepo.GetPromotionByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get promotion by ID", "error", err, "promotion_id", id)
+		return v1.Promotion{}, err
+	}
+	if promotion == nil {
+		return v1.Promotion{}, ErrPromotionNotFound
+	}
+	return *promotion, nil
+}
+
+func (s *PromotionService) PostPromotion(ctx context.Context, promotion v1.Promotion) (v1.Promotion, error) {
+	if err := s.validatePromotion(ctx, &promotion); err != nil {
+		return v1.Promotion{}, err
+	}
+
+	id, err := s.promotionRepo.CreatePromotion(ctx, promotion)
+	if err != nil {
+		s.logger.Debugw("Failed to create promotion", "error", err, "promotion", promotion)
+		return v1.Promotion{}, err
+	}
+
+	s.logger.Infow("Promotion created", "promotion_id", id, "name", promotion.Name)
+	return s.GetPromotion(ctx, id)
+}
+
+func (s *PromotionService) PutPromotion(ctx context.Context, promotion v1.Promotion) (v1.Promotion, error) {
+	if _, err := s.GetPromotion(ctx, *promotion.Id); err != nil {
+		return v1.Promotion{}, err
+	}
+	if err := s.validatePromotion(ctx, &promotion); err != nil {
+		return v1.Promotion{}, err
+	}
+
+	if err := s.promotionRepo.UpdatePromotion(ctx, promotion); err != nil {
+		s.logger.Debugw("Failed to update promotion", "error", err, "promotion", promotion)
+		return v1.Promotion{}, err
+	}
+	return s.GetPromotion(ctx, *promotion.Id)
+}
+
+// DeletePromotion removes a promotion that has not been applied to any sale;
+// one that has is kept so that its sales can be traced to it, and can be
+// deactivated instead.
+func (s *PromotionService) DeletePromotion(ctx context.Context, id int) error {
+	if _, err := s.GetPromotion(ctx, id); err != nil {
+		return err
+	}
+
+	sales, err := s.promotionRepo.CountPromotionSales(ctx, id)
+	if err != nil {
+		return err
+	}
+	if sales > 0 {
+		return fmt.Errorf("%w: promotion %d has been applied to %d sales, deactivate it instead", ErrPromotionInUse, id, sales)
+	}
+
+	if err := s.promotionRepo.DeletePromotion(ctx, id); err != nil {
+		s.logger.Debugw("Failed to delete promotion", "error", err, "promotion_id", id)
+		return err
+	}
+	return nil
+}
+
+// validatePromotion trims the name and description, checks the rules and
+// that the products and categories they name exist.
+func (s *PromotionService) validatePromotion(ctx context.Context, p *v1.Promotion) error {
+	p.Name = strings.TrimSpace(p.Name)
+	if p.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidPromotion)
+	}
+	if p.Description != nil {
+		if description := strings.TrimSpace(*p.Description); description == "" {
+			p.Description = nil
+		} else {
+			p.Description = &description
+		}
+	}
+	if err := promotion.Validate(*p); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidPromotion, err)
+	}
+
+	conditions := valueOrZero(p.Conditions)
+	for _, id := range valueOrZero(conditions.ProductIds) {
+		product, err := s.productRepo.GetProductByID(ctx, id)
+		if err != nil {
+			s.logger.Debugw("Failed to get product by ID", "error", err, "product_id", id)
+			return err
+		}
+		if product == nil {
+			return fmt.Errorf("%w: product %d not found", ErrInvalidPromotion, id)
+		}
+	}
+	for _, id := range valueOrZero(conditions.CategoryIds) {
+		category, err := s.categoryRepo.GetCategoryByID(ctx, id)
+		if err != nil {
+			s.logger.Debugw("Failed to get category by ID", "error", err, "category_id", id)
+			return err
+		}
+		if category == nil {
+			return fmt.Errorf("%w: category %d not found", ErrInvalidPromotion, id)
+		}
+	}
+	return nil
+}
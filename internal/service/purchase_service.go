@@ -0,0 +1,518 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/repository"
+	"github.com/nitinjangam/pos-receipt-system/internal/uom"
+	"go.uber.org/zap"
+)
+
+type PurchaseServiceInterface interface {
+	GetPurchaseOrders(ctx context.Context, params v1.GetPurchaseOrdersParams) ([]v1.PurchaseOrder, error)
+	GetSupplierPurchaseOrders(ctx context.Context, supplierID int, params v1.GetSuppliersIdPurchaseOrdersParams) ([]v1.PurchaseOrder, error)
+	GetPurchaseOrder(ctx context.Context, id int) (v1.PurchaseOrder, error)
+	PostPurchaseOrder(ctx context.Context, order v1.PurchaseOrder) (v1.PurchaseOrder, error)
+	PutPurchaseOrder(ctx context.Context, id int, order v1.PurchaseOrder) (v1.PurchaseOrder, error)
+	PlacePurchaseOrder(ctx context.Context, id int) (v1.PurchaseOrder, error)
+	ClosePurchaseOrder(ctx context.Context, id int) (v1.PurchaseOrder, error)
+	DraftLowStockPurchaseOrders(ctx context.Context, params v1.PostReportsLowStockPurchaseOrdersParams) (v1.LowStockPurchaseOrders, error)
+	GetGoodsReceipts(ctx context.Context, purchaseOrderID int) ([]v1.GoodsReceipt, error)
+	GetGoodsReceipt(ctx context.Context, id int) (v1.GoodsReceipt, error)
+	PostGoodsReceipt(ctx context.Context, purchaseOrderID int, receipt v1.GoodsReceipt) (v1.GoodsReceipt, error)
+}
+
+type PurchaseService struct {
+	purchaseRepo    *repository.PurchaseRepository
+	supplierRepo    *repository.SupplierRepository
+	productRepo     *repository.ProductRepository
+	inventoryRepo   *repository.InventoryRepository
+	settingsService SettingsServiceInterface
+	reportService   ReportServiceInterface
+	logger          *zap.SugaredLogger
+}
+
+func NewPurchaseService(purchaseRepository *repository.PurchaseRepository, supplierRepository *repository.SupplierRepository,
+	productRepository *repository.ProductRepository, inventoryRepository *repository.InventoryRepository, settingsService SettingsServiceInterface,
+	reportService ReportServiceInterface, logger *zap.SugaredLogger) *PurchaseService {
+	return &PurchaseService{
+		purchaseRepo:    purchaseRepository,
+		supplierRepo:    supplierRepository,
+		productRepo:     productRepository,
+		inventoryRepo:   inventoryRepository,
+		settingsService: settingsService,
+		reportService:   reportService,
+		logger:          logger,
+	}
+}
+
+func (s *PurchaseService) GetPurchaseOrders(ctx context.Context, params v1.GetPurchaseOrdersParams) ([]v1.PurchaseOrder, error) {
+	var statuses []v1.PurchaseOrderStatus
+	if params.Status != nil {
+		statuses = append(statuses, *params.Status)
+	}
+	return s.getPurchaseOrders(ctx, nil, statuses)
+}
+
+// GetSupplierPurchaseOrders returns the orders placed with the supplier, only
+// those still awaiting goods when outstanding is set.
+func (s *PurchaseService) GetSupplierPurchaseOrders(ctx context.Context, supplierID int, params v1.GetSuppliersIdPurchaseOrdersParams) ([]v1.PurchaseOrder, error) {
+	if _, err := s.getSupplier(ctx, supplierID); err != nil {
+		return nil, err
+	}
+
+	var statuses []v1.PurchaseOrderStatus
+	if valueOrZero(params.Outstanding) {
+		statuses = []v1.PurchaseOrderStatus{v1.Open, v1.PartiallyReceived}
+	}
+	return s.getPurchaseOrders(ctx, &supplierID, statuses)
+}
+
+func (s *PurchaseService) getPurchaseOrders(ctx context.Context, supplierID *int, statuses []v1.PurchaseOrderStatus) ([]v1.PurchaseOrder, error) {
+	orders, err := s.purchaseRepo.GetPurchaseOrders(ctx, supplierID, statuses)
+	if err != nil {
+		s.logger.Debugw("Failed to get purchase orders", "error", err)
+		return nil, err
+	}
+	for i := range orders {
+		withPurchaseOrderTotals(&orders[i])
+	}
+	return orders, nil
+}
+
+func (s *PurchaseService) GetPurchaseOrder(ctx context.Context, id int) (v1.PurchaseOrder, error) {
+	order, err := s.purchaseRepo.GetPurchaseOrderByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get purchase order by ID", "error", err, "purchase_order_id", id)
+		return v1.PurchaseOrder{}, err
+	}
+	if order == nil {
+		return v1.PurchaseOrder{}, ErrPurchaseOrderNotFound
+	}
+	withPurchaseOrderTotals(order)
+	return *order, nil
+}
+
+// PostPurchaseOrder places an order with the supplier, or saves it as a draft
+// to be placed later.
+func (s *PurchaseService) PostPurchaseOrder(ctx context.Context, order v1.PurchaseOrder) (v1.PurchaseOrder, error) {
+	status := valueOrZero(order.Status)
+	switch status {
+	case "":
+		order.Status = statusPtr(v1.Open)
+	case v1.Draft, v1.Open:
+	default:
+		return v1.PurchaseOrder{}, fmt.Errorf("%w: an order is created open or as a draft, not %s", ErrInvalidPurchaseOrder, status)
+	}
+	if err := s.validatePurchaseOrder(ctx, &order); err != nil {
+		return v1.PurchaseOrder{}, err
+	}
+
+	id, err := s.purchaseRepo.CreatePurchaseOrder(ctx, order)
+	if err != nil {
+		s.logger.Debugw("Failed to create purchase order", "error", err)
+		return v1.PurchaseOrder{}, err
+	}
+
+	s.logger.Infow("Purchase order created", "purchase_order_id", id, "supplier_id", order.SupplierId, "status", *order.Status)
+	return s.GetPurchaseOrder(ctx, id)
+}
+
+// PutPurchaseOrder replaces the supplier, details and lines of a draft.
+func (s *PurchaseService) PutPurchaseOrder(ctx context.Context, id int, order v1.PurchaseOrder) (v1.PurchaseOrder, error) {
+	existing, err := s.GetPurchaseOrder(ctx, id)
+	if err != nil {
+		return v1.PurchaseOrder{}, err
+	}
+	if valueOrZero(existing.Status) != v1.Draft {
+		return v1.PurchaseOrder{}, fmt.Errorf("%w: purchase order %d is %s", ErrPurchaseOrderPlaced, id, valueOrZero(existing.Status))
+	}
+	if err := s.validatePurchaseOrder(ctx, &order); err != nil {
+		return v1.PurchaseOrder{}, err
+	}
+
+	order.Id = &id
+	if err := s.purchaseRepo.UpdatePurchaseOrder(ctx, order); err != nil {
+		if errors.Is(err, repository.ErrStatusChanged) {
+			return v1.PurchaseOrder{}, fmt.Errorf("%w: purchase order %d is no longer a draft", ErrPurchaseOrderPlaced, id)
+		}
+		s.logger.Debugw("Failed to update purchase order", "error", err, "purchase_order_id", id)
+		return v1.PurchaseOrder{}, err
+	}
+	return s.GetPurchaseOrder(ctx, id)
+}
+
+// PlacePurchaseOrder places a draft with its supplier, after which goods can
+// be received against it.
+func (s *PurchaseService) PlacePurchaseOrder(ctx context.Context, id int) (v1.PurchaseOrder, error) {
+	order, err := s.GetPurchaseOrder(ctx, id)
+	if err != nil {
+		return v1.PurchaseOrder{}, err
+	}
+	if valueOrZero(order.Status) != v1.Draft {
+		return v1.PurchaseOrder{}, fmt.Errorf("%w: purchase order %d is %s", ErrPurchaseOrderPlaced, id, valueOrZero(order.Status))
+	}
+
+	if err := s.purchaseRepo.PlacePurchaseOrder(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrStatusChanged) {
+			return v1.PurchaseOrder{}, fmt.Errorf("%w: purchase order %d is no longer a draft", ErrPurchaseOrderPlaced, id)
+		}
+		s.logger.Debugw("Failed to place purchase order", "error", err, "purchase_order_id", id)
+		return v1.PurchaseOrder{}, err
+	}
+
+	s.logger.Infow("Purchase order placed", "purchase_order_id", id)
+	return s.GetPurchaseOrder(ctx, id)
+}
+
+// validatePurchaseOrder checks the supplier and products of the order. Lines
+// take the tax rates of the product unless given; a supplier without a GSTIN
+// is not registered for GST and cannot charge it.
+func (s *PurchaseService) validatePurchaseOrder(ctx context.Context, order *v1.PurchaseOrder) error {
+	supplier, err := s.supplierRepo.GetSupplierByID(ctx, order.SupplierId)
+	if err != nil {
+		return err
+	}
+	if supplier == nil {
+		return fmt.Errorf("%w: supplier %d not found", ErrInvalidPurchaseOrder, order.SupplierId)
+	}
+	if len(order.Items) == 0 {
+		return fmt.Errorf("%w: an order needs at least one line", ErrInvalidPurchaseOrder)
+	}
+
+	for i := range order.Items {
+		item := &order.Items[i]
+		product, err := s.productRepo.GetProductByID(ctx, item.ProductId)
+		if err != nil {
+			s.logger.Debugw("Failed to get product by ID", "error", err, "product_id", item.ProductId)
+			return err
+		}
+		switch {
+		case product == nil:
+			return fmt.Errorf("%w: product %d not found", ErrInvalidPurchaseOrder, item.ProductId)
+		case hasVariants(*product):
+			return fmt.Errorf("%w: product %d is bought through its variants", ErrInvalidPurchaseOrder, item.ProductId)
+		case isBundle(*product):
+			return fmt.Errorf("%w: product %d is a bundle, order its components", ErrInvalidPurchaseOrder, item.ProductId)
+		case archived(*product):
+			return fmt.Errorf("%w: product %d is archived", ErrInvalidPurchaseOrder, item.ProductId)
+		}
+		if err := uom.Check(item.Quantity, string(valueOrZero(product.Unit))); err != nil {
+			return fmt.Errorf("%w: product %d: %v", ErrInvalidPurchaseOrder, item.ProductId, err)
+		}
+
+		if supplier.Gstin == nil {
+			if valueOrZero(item.CgstRate) != 0 || valueOrZero(item.SgstRate) != 0 {
+				return fmt.Errorf("%w: supplier %d has no GSTIN and cannot charge GST", ErrInvalidPurchaseOrder, order.SupplierId)
+			}
+			item.CgstRate, item.SgstRate = float32Ptr(0), float32Ptr(0)
+			continue
+		}
+		if item.CgstRate == nil {
+			item.CgstRate = float32Ptr(float64(valueOrZero(product.CgstRate)))
+		}
+		if item.SgstRate == nil {
+			item.SgstRate = float32Ptr(float64(valueOrZero(product.SgstRate)))
+		}
+	}
+
+	return nil
+}
+
+// ClosePurchaseOrder closes an order that is still awaiting goods; what has
+// not been received by then is no longer expected. Closing a draft discards
+// it.
+func (s *PurchaseService) ClosePurchaseOrder(ctx context.Context, id int) (v1.PurchaseOrder, error) {
+	order, err := s.GetPurchaseOrder(ctx, id)
+	if err != nil {
+		return v1.PurchaseOrder{}, err
+	}
+	if !outstanding(order) && valueOrZero(order.Status) != v1.Draft {
+		return v1.PurchaseOrder{}, fmt.Errorf("%w: purchase order %d is %s", ErrPurchaseOrderClosed, id, valueOrZero(order.Status))
+	}
+
+	if err := s.purchaseRepo.ClosePurchaseOrder(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrStatusChanged) {
+			return v1.PurchaseOrder{}, fmt.Errorf("%w: purchase order %d was received or closed by another request", ErrPurchaseOrderClosed, id)
+		}
+		s.logger.Debugw("Failed to close purchase order", "error", err, "purchase_order_id", id)
+		return v1.PurchaseOrder{}, err
+	}
+
+	s.logger.Infow("Purchase order closed", "purchase_order_id", id)
+	return s.GetPurchaseOrder(ctx, id)
+}
+
+// DraftLowStockPurchaseOrders drafts a purchase order per supplier for the
+// quantities suggested on the low-stock report, at the latest cost price of
+// each product. Items without a supplier to order from are returned for
+// ordering by hand. Quantities already on a draft count as on order, so
+// drafting again does not order them twice.
+func (s *PurchaseService) DraftLowStockPurchaseOrders(ctx context.Context, params v1.PostReportsLowStockPurchaseOrdersParams) (v1.LowStockPurchaseOrders, error) {
+	report, err := s.reportService.GetLowStockReport(ctx, v1.GetReportsLowStockParams{Days: params.Days})
+	if err != nil {
+		return v1.LowStockPurchaseOrders{}, err
+	}
+
+	var suppliers []int
+	lines := map[int][]v1.PurchaseOrderItem{}
+	unassigned := []v1.LowStockItem{}
+	for _, item := range valueOrZero(report.Items) {
+		if valueOrZero(item.SuggestedQuantity) <= 0 {
+			continue
+		}
+		if item.SupplierId == nil {
+			unassigned = append(unassigned, item)
+			continue
+		}
+		if _, ok := lines[*item.SupplierId]; !ok {
+			suppliers = append(suppliers, *item.SupplierId)
+		}
+		lines[*item.SupplierId] = append(lines[*item.SupplierId], v1.PurchaseOrderItem{
+			ProductId: *item.ProductId,
+			Quantity:  *item.SuggestedQuantity,
+			UnitCost:  valueOrZero(item.CostPrice),
+		})
+	}
+
+	drafts := make([]v1.PurchaseOrder, 0, len(suppliers))
+	note := fmt.Sprintf("Drafted from the low-stock report over %d days", valueOrZero(report.Days))
+	for _, supplierID := range suppliers {
+		draft := v1.PurchaseOrder{
+			SupplierId: supplierID,
+			Status:     statusPtr(v1.Draft),
+			Note:       &note,
+			Items:      lines[supplierID],
+		}
+		if err := s.validatePurchaseOrder(ctx, &draft); err != nil {
+			return v1.LowStockPurchaseOrders{}, err
+		}
+		drafts = append(drafts, draft)
+	}
+
+	// The drafts are created together, so that a failure leaves no orders
+	// behind for some of the suppliers only.
+	ids, err := s.purchaseRepo.CreatePurchaseOrders(ctx, drafts)
+	if err != nil {
+		s.logger.Debugw("Failed to create purchase orders", "error", err)
+		return v1.LowStockPurchaseOrders{}, err
+	}
+
+	orders := []v1.PurchaseOrder{}
+	for i, id := range ids {
+		s.logger.Infow("Purchase order created", "purchase_order_id", id, "supplier_id", drafts[i].SupplierId, "status", v1.Draft)
+		order, err := s.GetPurchaseOrder(ctx, id)
+		if err != nil {
+			return v1.LowStockPurchaseOrders{}, err
+		}
+		orders = append(orders, order)
+	}
+
+	return v1.LowStockPurchaseOrders{
+		PurchaseOrders: &orders,
+		Unassigned:     &unassigned,
+	}, nil
+}
+
+func (s *PurchaseService) GetGoodsReceipts(ctx context.Context, purchaseOrderID int) ([]v1.GoodsReceipt, error) {
+	if _, err := s.GetPurchaseOrder(ctx, purchaseOrderID); err != nil {
+		return nil, err
+	}
+
+	receipts, err := s.purchaseRepo.GetGoodsReceipts(ctx, purchaseOrderID)
+	if err != nil {
+		s.logger.Debugw("Failed to get goods receipts", "error", err, "purchase_order_id", purchaseOrderID)
+		return nil, err
+	}
+	return receipts, nil
+}
+
+func (s *PurchaseService) GetGoodsReceipt(ctx context.Context, id int) (v1.GoodsReceipt, error) {
+	receipt, err := s.purchaseRepo.GetGoodsReceiptByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get goods receipt by ID", "error", err, "goods_receipt_id", id)
+		return v1.GoodsReceipt{}, err
+	}
+	if receipt == nil {
+		return v1.GoodsReceipt{}, ErrGoodsReceiptNotFound
+	}
+	return *receipt, nil
+}
+
+// PostGoodsReceipt receives goods against an outstanding order. No line may
+// bring in more than is outstanding on its order line, and batch-tracked
+// products go into a batch as with any other purchase. Tax is worked out at
+// the rates of the order line, as integrated GST when the supplier is in
+// another state than the business.
+func (s *PurchaseService) PostGoodsReceipt(ctx context.Context, purchaseOrderID int, receipt v1.GoodsReceipt) (v1.GoodsReceipt, error) {
+	order, err := s.GetPurchaseOrder(ctx, purchaseOrderID)
+	if err != nil {
+		return v1.GoodsReceipt{}, err
+	}
+	if !outstanding(order) {
+		return v1.GoodsReceipt{}, fmt.Errorf("%w: purchase order %d is %s", ErrPurchaseOrderClosed, purchaseOrderID, valueOrZero(order.Status))
+	}
+	if len(receipt.Items) == 0 {
+		return v1.GoodsReceipt{}, fmt.Errorf("%w: a receipt needs at least one line", ErrInvalidGoodsReceipt)
+	}
+
+	supplier, err := s.getSupplier(ctx, order.SupplierId)
+	if err != nil {
+		return v1.GoodsReceipt{}, err
+	}
+	settings, err := s.settingsService.GetSettings(ctx)
+	if err != nil {
+		return v1.GoodsReceipt{}, err
+	}
+	interstate := supplier.StateCode != nil && settings.StateCode != nil && *supplier.StateCode != *settings.StateCode
+
+	lines := make(map[int]v1.PurchaseOrderItem, len(order.Items))
+	for _, line := range order.Items {
+		lines[*line.Id] = line
+	}
+	received := map[int]float64{}
+	batches := make([]*v1.ProductBatch, len(receipt.Items))
+	var subtotal, cgstTotal, sgstTotal, igstTotal float64
+	for i := range receipt.Items {
+		item := &receipt.Items[i]
+		line, ok := lines[item.PurchaseOrderItemId]
+		if !ok {
+			return v1.GoodsReceipt{}, fmt.Errorf("%w: line %d is not on purchase order %d", ErrInvalidGoodsReceipt, item.PurchaseOrderItemId,
+				purchaseOrderID)
+		}
+		unit := string(valueOrZero(line.Unit))
+		if err := uom.Check(item.Quantity, unit); err != nil {
+			return v1.GoodsReceipt{}, fmt.Errorf("%w: line %d: %v", ErrInvalidGoodsReceipt, item.PurchaseOrderItemId, err)
+		}
+		received[*line.Id] = uom.Round(received[*line.Id]+item.Quantity, unit)
+		if remaining := valueOrZero(line.OutstandingQuantity); received[*line.Id] > remaining {
+			return v1.GoodsReceipt{}, fmt.Errorf("%w: line %d has %s outstanding, %s received", ErrInvalidGoodsReceipt, *line.Id,
+				uom.Format(remaining, unit), uom.Format(received[*line.Id], unit))
+		}
+
+		product, err := s.productRepo.GetProductByID(ctx, line.ProductId)
+		if err != nil {
+			return v1.GoodsReceipt{}, err
+		}
+		if product == nil {
+			return v1.GoodsReceipt{}, fmt.Errorf("%w: product %d not found", ErrInvalidGoodsReceipt, line.ProductId)
+		}
+		request := v1.StockMovementRequest{
+			Reason:    v1.StockMovementRequestReasonPurchase,
+			Quantity:  item.Quantity,
+			BatchNo:   item.BatchNo,
+			ExpiresOn: item.ExpiresOn,
+			Mrp:       item.Mrp,
+		}
+		if batches[i], err = movementBatch(ctx, s.inventoryRepo, *product, request, &v1.StockMovement{}); err != nil {
+			if errors.Is(err, ErrInvalidStockMovement) {
+				return v1.GoodsReceipt{}, fmt.Errorf("%w: line %d: %v", ErrInvalidGoodsReceipt, *line.Id, err)
+			}
+			return v1.GoodsReceipt{}, err
+		}
+
+		item.ProductId = &line.ProductId
+		item.Name, item.VariantLabel, item.Unit = line.Name, line.VariantLabel, line.Unit
+		if item.UnitCost == nil {
+			item.UnitCost = &line.UnitCost
+		}
+		calculateGoodsReceiptItem(item, line, interstate)
+
+		subtotal += float64(*item.Subtotal)
+		cgstTotal += float64(*item.CgstAmount)
+		sgstTotal += float64(*item.SgstAmount)
+		igstTotal += float64(*item.IgstAmount)
+	}
+
+	receipt.PurchaseOrderId = &purchaseOrderID
+	receipt.SupplierId = &order.SupplierId
+	receipt.Interstate = &interstate
+	receipt.Subtotal = float32Ptr(round2(subtotal))
+	receipt.CgstTotal = float32Ptr(round2(cgstTotal))
+	receipt.SgstTotal = float32Ptr(round2(sgstTotal))
+	receipt.IgstTotal = float32Ptr(round2(igstTotal))
+	receipt.TaxTotal = float32Ptr(round2(cgstTotal + sgstTotal + igstTotal))
+	receipt.Total = float32Ptr(round2(subtotal + cgstTotal + sgstTotal + igstTotal))
+
+	id, err := s.purchaseRepo.CreateGoodsReceipt(ctx, receipt, batches)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrStatusChanged):
+			return v1.GoodsReceipt{}, fmt.Errorf("%w: purchase order %d was received or closed by another request", ErrPurchaseOrderClosed,
+				purchaseOrderID)
+		case errors.Is(err, repository.ErrOverReceived):
+			return v1.GoodsReceipt{}, fmt.Errorf("%w: %v, goods were received against it by another request", ErrInvalidGoodsReceipt, err)
+		}
+		s.logger.Debugw("Failed to create goods receipt", "error", err, "purchase_order_id", purchaseOrderID)
+		return v1.GoodsReceipt{}, err
+	}
+
+	s.logger.Infow("Goods received", "goods_receipt_id", id, "purchase_order_id", purchaseOrderID, "total", *receipt.Total)
+	return s.GetGoodsReceipt(ctx, id)
+}
+
+func (s *PurchaseService) getSupplier(ctx context.Context, id int) (*v1.Supplier, error) {
+	supplier, err := s.supplierRepo.GetSupplierByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get supplier by ID", "error", err, "supplier_id", id)
+		return nil, err
+	}
+	if supplier == nil {
+		return nil, ErrSupplierNotFound
+	}
+	return supplier, nil
+}
+
+// calculateGoodsReceiptItem works out the taxable value and input tax of a
+// received line at the rates of its order line.
+func calculateGoodsReceiptItem(item *v1.GoodsReceiptItem, line v1.PurchaseOrderItem, interstate bool) {
+	cgstRate, sgstRate := float64(valueOrZero(line.CgstRate)), float64(valueOrZero(line.SgstRate))
+	var igstRate float64
+	if interstate {
+		cgstRate, sgstRate, igstRate = 0, 0, cgstRate+sgstRate
+	}
+
+	subtotal := round2(float64(*item.UnitCost) * item.Quantity)
+	cgstAmount := round2(subtotal * cgstRate / 100)
+	sgstAmount := round2(subtotal * sgstRate / 100)
+	igstAmount := round2(subtotal * igstRate / 100)
+
+	item.CgstRate, item.SgstRate, item.IgstRate = float32Ptr(cgstRate), float32Ptr(sgstRate), float32Ptr(igstRate)
+	item.CgstAmount, item.SgstAmount, item.IgstAmount = float32Ptr(cgstAmount), float32Ptr(sgstAmount), float32Ptr(igstAmount)
+	item.Subtotal = float32Ptr(subtotal)
+	item.LineTotal = float32Ptr(round2(subtotal + cgstAmount + sgstAmount + igstAmount))
+}
+
+// withPurchaseOrderTotals fills in the outstanding quantity of each line and
+// the value of the order at the agreed costs and rates.
+func withPurchaseOrderTotals(order *v1.PurchaseOrder) {
+	var subtotal, taxTotal float64
+	for i := range order.Items {
+		item := &order.Items[i]
+		remaining := 0.0
+		if outstanding(*order) {
+			remaining = uom.Round(max(item.Quantity-valueOrZero(item.ReceivedQuantity), 0), string(valueOrZero(item.Unit)))
+		}
+		item.OutstandingQuantity = &remaining
+
+		value := round2(float64(item.UnitCost) * item.Quantity)
+		subtotal += value
+		taxTotal += round2(value * float64(valueOrZero(item.CgstRate)+valueOrZero(item.SgstRate)) / 100)
+	}
+	order.Subtotal = float32Ptr(round2(subtotal))
+	order.TaxTotal = float32Ptr(round2(taxTotal))
+	order.Total = float32Ptr(round2(subtotal + taxTotal))
+}
+
+// outstanding reports whether the order is still awaiting goods.
+func outstanding(order v1.PurchaseOrder) bool {
+	status := valueOrZero(order.Status)
+	return status == v1.Open || status == v1.PartiallyReceived
+}
+
+func statusPtr(status v1.PurchaseOrderStatus) *v1.PurchaseOrderStatus {
+	return &status
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/repository"
+	"github.com/nitinjangam/pos-receipt-system/internal/uom"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+)
+
+type ReportServiceInterface interface {
+	GetTaxReport(ctx context.Context, params v1.GetReportsTaxParams) (v1.TaxReport, error)
+	GetCMP08Report(ctx context.Context, params v1.GetReportsCmp08Params) (v1.CMP08Report, error)
+	GetNearExpiryReport(ctx context.Context, params v1.GetReportsNearExpiryParams) (v1.NearExpiryReport, error)
+	GetInputTaxReport(ctx context.Context, params v1.GetReportsInputTaxParams) (v1.InputTaxReport, error)
+	GetLowStockReport(ctx context.Context, params v1.GetReportsLowStockParams) (v1.LowStockReport, error)
+	GetStockValuationReport(ctx context.Context, params v1.GetReportsStockValuationParams) (v1.StockValuationReport, error)
+}
+
+// defaultLowStockDays is the sales period of the low-stock report when none
+// is given.
+const defaultLowStockDays = 30
+
+type ReportService struct {
+	reportRepo      *repository.ReportRepository
+	categoryRepo    *repository.CategoryRepository
+	settingsService SettingsServiceInterface
+	logger          *zap.SugaredLogger
+}
+
+func NewReportService(reportRepository *repository.ReportRepository, categoryRepository *repository.CategoryRepository,
+	settingsService SettingsServiceInterface, logger *zap.SugaredLogger) *ReportService {
+	return &ReportService{
+		reportRepo:      reportRepository,
+		categoryRepo:    categoryRepository,
+		settingsService: settingsService,
+		logger:          logger,
+	}
+}
+
+// GetTaxReport summarises taxable value and tax by supply type and rate, and
+// lists the B2B invoices for the period. Voided sales are left out.
+func (s *ReportService) GetTaxReport(ctx context.Context, params v1.GetReportsTaxParams) (v1.TaxReport, error) {
+	summary, err := s.reportRepo.GetTaxSummary(ctx, params.From, params.To)
+	if err != nil {
+		s.logger.Debugw("Failed to get tax summary", "error", err)
+		return v1.TaxReport{}, err
+	}
+
+	invoices, err := s.reportRepo.GetB2BInvoices(ctx, params.From, params.To)
+	if err != nil {
+		s.logger.Debugw("Failed to get B2B invoices", "error", err)
+		return v1.TaxReport{}, err
+	}
+
+	return v1.TaxReport{
+		From:        params.From,
+		To:          params.To,
+		Summary:     &summary,
+		B2bInvoices: &invoices,
+	}, nil
+}
+
+// GetCMP08Report totals the bills of supply issued in a quarter of the
+// financial year and the composition tax payable on them, split equally
+// between central and state tax.
+func (s *ReportService) GetCMP08Report(ctx context.Context, params v1.GetReportsCmp08Params) (v1.CMP08Report, error) {
+	from := time.Date(params.FinancialYear, time.April+time.Month(3*(params.Quarter-1)), 1, 0, 0, 0, 0, time.Local)
+	to := from.AddDate(0, 3, 0)
+
+	settings, err := s.settingsService.GetSettings(ctx)
+	if err != nil {
+		return v1.CMP08Report{}, err
+	}
+	rate := float64(valueOrZero(settings.CompositionRate))
+
+	turnover, bills, err := s.reportRepo.GetCompositionTurnover(ctx, from, to)
+	if err != nil {
+		s.logger.Debugw("Failed to get composition turnover", "error", err)
+		return v1.CMP08Report{}, err
+	}
+
+	taxPayable := round2(turnover * rate / 100)
+	centralTax := round2(taxPayable / 2)
+	return v1.CMP08Report{
+		FinancialYear:   &params.FinancialYear,
+		Quarter:         &params.Quarter,
+		From:            &from,
+		To:              &to,
+		CompositionRate: float32Ptr(rate),
+		Turnover:        float32Ptr(round2(turnover)),
+		Bills:           &bills,
+		CentralTax:      float32Ptr(centralTax),
+		StateTax:        float32Ptr(round2(taxPayable - centralTax)),
+		TaxPayable:      float32Ptr(taxPayable),
+	}, nil
+}
+
+// GetNearExpiryReport lists the batches with stock on hand that expire within
+// the given number of days, or the configured number when left out, along
+// with those that have already expired.
+func (s *ReportService) GetNearExpiryReport(ctx context.Context, params v1.GetReportsNearExpiryParams) (v1.NearExpiryReport, error) {
+	days := params.Days
+	if days == nil {
+		settings, err := s.settingsService.GetSettings(ctx)
+		if err != nil {
+			return v1.NearExpiryReport{}, err
+		}
+		days = settings.NearExpiryDays
+	}
+
+	asOf := today()
+	batches, err := s.reportRepo.GetExpiringBatches(ctx, asOf.AddDate(0, 0, *days+1))
+	if err != nil {
+		s.logger.Debugw("Failed to get expiring batches", "error", err)
+		return v1.NearExpiryReport{}, err
+	}
+	setDaysToExpiry(batches, asOf)
+
+	return v1.NearExpiryReport{
+		AsOf:    &openapi_types.Date{Time: asOf},
+		Days:    days,
+		Batches: &batches,
+	}, nil
+}
+
+// GetInputTaxReport summarises the taxable value and input tax of the goods
+// received in the period by rate, with the input tax credit available.
+func (s *ReportService) GetInputTaxReport(ctx context.Context, params v1.GetReportsInputTaxParams) (v1.InputTaxReport, error) {
+	summary, err := s.reportRepo.GetInputTaxSummary(ctx, params.From, params.To)
+	if err != nil {
+		s.logger.Debugw("Failed to get input tax summary", "error", err)
+		return v1.InputTaxReport{}, err
+	}
+
+	var taxableValue, taxTotal float64
+	for _, row := range summary {
+		taxableValue += float64(valueOrZero(row.TaxableValue))
+		taxTotal += float64(valueOrZero(row.CgstAmount) + valueOrZero(row.SgstAmount) + valueOrZero(row.IgstAmount))
+	}
+
+	return v1.InputTaxReport{
+		From:         params.From,
+		To:           params.To,
+		Summary:      &summary,
+		TaxableValue: float32Ptr(round2(taxableValue)),
+		TaxTotal:     float32Ptr(round2(taxTotal)),
+	}, nil
+}
+
+// GetLowStockReport lists the products at or below their reorder level with
+// their average daily sales over the last number of days and the quantity to
+// order to cover as many days again above the reorder level.
+func (s *ReportService) GetLowStockReport(ctx context.Context, params v1.GetReportsLowStockParams) (v1.LowStockReport, error) {
+	days := defaultLowStockDays
+	if params.Days != nil {
+		days = *params.Days
+	}
+
+	items, err := s.reportRepo.GetLowStock(ctx, time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		s.logger.Debugw("Failed to get low stock", "error", err)
+		return v1.LowStockReport{}, err
+	}
+	for i := range items {
+		suggestReorder(&items[i], days)
+	}
+
+	return v1.LowStockReport{
+		AsOf:  &openapi_types.Date{Time: today()},
+		Days:  &days,
+		Items: &items,
+	}, nil
+}
+
+// GetStockValuationReport values the stock of each product as it stood at the
+// end of the given day, or today, at the cost its movements were posted at,
+// and totals it, along with the goods then in transit to other stores. The
+// method reported is the valuation method now in use.
+func (s *ReportService) GetStockValuationReport(ctx context.Context, params v1.GetReportsStockValuationParams) (v1.StockValuationReport, error) {
+	asOf := today()
+	if params.AsOf != nil {
+		asOf = params.AsOf.Time
+	}
+
+	if params.CategoryId != nil {
+		category, err := s.categoryRepo.GetCategoryByID(ctx, *params.CategoryId)
+		if err != nil {
+			s.logger.Debugw("Failed to get category by ID", "error", err, "category_id", *params.CategoryId)
+			return v1.StockValuationReport{}, err
+		}
+		if category == nil {
+			return v1.StockValuationReport{}, ErrCategoryNotFound
+		}
+	}
+
+	settings, err := s.settingsService.GetSettings(ctx)
+	if err != nil {
+		return v1.StockValuationReport{}, err
+	}
+
+	// The day ends at local midnight.
+	y, m, d := asOf.Date()
+	before := time.Date(y, m, d+1, 0, 0, 0, 0, time.Local)
+	items, err := s.reportRepo.GetStockValuation(ctx, before, params.CategoryId)
+	if err != nil {
+		s.logger.Debugw("Failed to get stock valuation", "error", err)
+		return v1.StockValuationReport{}, err
+	}
+	inTransit, err := s.reportRepo.GetInTransitValue(ctx, before, params.CategoryId)
+	if err != nil {
+		s.logger.Debugw("Failed to get in-transit value", "error", err)
+		return v1.StockValuationReport{}, err
+	}
+
+	var total float64
+	for i := range items {
+		item := &items[i]
+		value := valueOrZero(item.Value)
+		unitCost := math.Round(value/valueOrZero(item.Quantity)*10000) / 10000
+		item.UnitCost = &unitCost
+		total += value
+	}
+	total = round2(total)
+
+	return v1.StockValuationReport{
+		AsOf:           &openapi_types.Date{Time: asOf},
+		Method:         settings.ValuationMethod,
+		Items:          &items,
+		TotalValue:     &total,
+		InTransitValue: &inTransit,
+	}, nil
+}
+
+// suggestReorder works out the daily sales of the item and the quantity to
+// order: enough to cover the period's sales on top of the reorder level, and
+// at least the reorder quantity, less what is already on order. Nothing is
+// suggested once the stock on hand and on order covers the period.
+func suggestReorder(item *v1.LowStockItem, days int) {
+	unit := string(valueOrZero(item.Unit))
+	sold := max(valueOrZero(item.SoldQuantity), 0)
+	daily := round2(sold / float64(days))
+	item.SoldQuantity = &sold
+	item.DailySales = &daily
+
+	target := valueOrZero(item.ReorderLevel) + sold
+	shortfall := target - valueOrZero(item.StockOnHand) - valueOrZero(item.OnOrderQuantity)
+	suggested := 0.0
+	if shortfall >= 0 {
+		suggested = uom.Ceil(max(shortfall, valueOrZero(item.ReorderQuantity)), unit)
+	}
+	item.SuggestedQuantity = &suggested
+}
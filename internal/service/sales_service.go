@@ -1,66 +1,621 @@
 package service
 
 import (
-	"github.com/gin-gonic/gin"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/promotion"
+	"github.com/nitinjangam/pos-receipt-system/internal/receipt"
 	"github.com/nitinjangam/pos-receipt-system/internal/repository"
+	"github.com/nitinjangam/pos-receipt-system/internal/uom"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 // SalesServiceInterface defines the methods for the sales service.
 type SalesServiceInterface interface {
-	GetSales(c *gin.Context)
-	PostSales(c *gin.Context)
-	DeleteSalesId(c *gin.Context, id int)
-	PutSalesId(c *gin.Context, id int)
-	GetSalesIdReceipt(c *gin.Context, id int)
+	GetSales(ctx context.Context) ([]v1.Sale, error)
+	PostSales(ctx context.Context, request v1.PostSalesJSONRequestBody) (v1.Sale, error)
+	PreviewSale(ctx context.Context, request v1.PostSalesPreviewJSONRequestBody) (v1.Sale, error)
+	GetSaleReceipt(ctx context.Context, id int) ([]byte, error)
+	VoidSale(ctx context.Context, id int) error
 }
 
 type SalesService struct {
 	logger          *zap.SugaredLogger
 	tracer          trace.Tracer
 	salesRepository *repository.SalesRepository
+	productRepo     *repository.ProductRepository
+	customerRepo    *repository.CustomerRepository
+	inventoryRepo   *repository.InventoryRepository
+	priceListRepo   *repository.PriceListRepository
+	promotionRepo   *repository.PromotionRepository
+	categoryRepo    *repository.CategoryRepository
+	settingsService SettingsServiceInterface
 }
 
-func NewSalesService(tracer trace.Tracer, logger *zap.SugaredLogger, salesRepository *repository.SalesRepository) *SalesService {
+func NewSalesService(tracer trace.Tracer, logger *zap.SugaredLogger, salesRepository *repository.SalesRepository,
+	productRepository *repository.ProductRepository, customerRepository *repository.CustomerRepository,
+	inventoryRepository *repository.InventoryRepository, priceListRepository *repository.PriceListRepository,
+	promotionRepository *repository.PromotionRepository, categoryRepository *repository.CategoryRepository,
+	settingsService SettingsServiceInterface) *SalesService {
 	return &SalesService{
 		logger:          logger,
 		tracer:          tracer,
 		salesRepository: salesRepository,
+		productRepo:     productRepository,
+		customerRepo:    customerRepository,
+		inventoryRepo:   inventoryRepository,
+		priceListRepo:   priceListRepository,
+		promotionRepo:   promotionRepository,
+		categoryRepo:    categoryRepository,
+		settingsService: settingsService,
+	}
+}
+
+func (s *SalesService) GetSales(ctx context.Context) ([]v1.Sale, error) {
+	ctx, span := s.tracer.Start(ctx, "SalesService.GetSales")
+	defer span.End()
+
+	sales, err := s.salesRepository.GetAllSales(ctx)
+	if err != nil {
+		s.logger.Debugw("Failed to get sales", "error", err)
+		return nil, err
+	}
+	return sales, nil
+}
+
+// saleStock holds, by product, what is on hand and what a sale asks for.
+type saleStock struct {
+	onHand    map[int]float64
+	requested map[int]float64
+	units     map[int]string
+	tracked   map[int]bool
+}
+
+// PostSales prices the sale, checks that there is stock for it and stores
+// it. Lines of batch-tracked products are allocated to batches first expiry
+// first out, and the cost of the goods sold is taken by the valuation
+// method.
+func (s *SalesService) PostSales(ctx context.Context, request v1.PostSalesJSONRequestBody) (v1.Sale, error) {
+	ctx, span := s.tracer.Start(ctx, "SalesService.PostSales")
+	defer span.End()
+
+	settings, err := s.settingsService.GetSettings(ctx)
+	if err != nil {
+		return v1.Sale{}, err
+	}
+	sale, stock, err := s.priceSale(ctx, request, settings)
+	if err != nil {
+		return v1.Sale{}, err
+	}
+
+	if !valueOrZero(settings.AllowNegativeStock) {
+		for _, item := range *sale.Items {
+			productID := *item.ProductId
+			if quantity := stock.requested[productID]; quantity > stock.onHand[productID] {
+				return v1.Sale{}, fmt.Errorf("%w: product %d has %s on hand, %s requested", ErrInsufficientStock, productID,
+					uom.Format(stock.onHand[productID], stock.units[productID]), uom.Format(quantity, stock.units[productID]))
+			}
+		}
+	}
+
+	if err := s.allocateBatches(ctx, *sale.Items, stock.tracked, stock.onHand, stock.units); err != nil {
+		return v1.Sale{}, err
+	}
+
+	// The check above is repeated as the stock is taken out, for sales
+	// posted at the same time.
+	id, err := s.salesRepository.CreateSale(ctx, sale, valueOrZero(settings.ValuationMethod), valueOrZero(settings.AllowNegativeStock))
+	if err != nil {
+		s.logger.Debugw("Failed to create sale", "error", err)
+		return v1.Sale{}, err
+	}
+	sale.Id = &id
+
+	s.logger.Infow("Sale created", "sale_id", id, "grand_total", *sale.GrandTotal)
+	return sale, nil
+}
+
+// PreviewSale prices a cart as PostSales would, without checking stock or
+// storing the sale.
+func (s *SalesService) PreviewSale(ctx context.Context, request v1.PostSalesPreviewJSONRequestBody) (v1.Sale, error) {
+	ctx, span := s.tracer.Start(ctx, "SalesService.PreviewSale")
+	defer span.End()
+
+	settings, err := s.settingsService.GetSettings(ctx)
+	if err != nil {
+		return v1.Sale{}, err
+	}
+	sale, _, err := s.priceSale(ctx, request, settings)
+	return sale, err
+}
+
+// priceSale prices every line with the product price and the tax rates
+// effective now, applies the promotions running now and totals the sale. A
+// price on the price list of the sale, or else on the default list, overrides
+// the product price. A sale to a buyer in another state is charged integrated
+// GST at the central and state rates combined. A store under the composition
+// scheme collects no tax and issues a bill of supply instead. A bundle is sold
+// as its components, each line taxed at its own rates.
+func (s *SalesService) priceSale(ctx context.Context, request v1.SaleRequest, settings v1.Settings) (v1.Sale, saleStock, error) {
+	composition := valueOrZero(settings.CompositionScheme)
+
+	soldAt := time.Now().UTC()
+	supplyType := v1.B2C
+	documentType := v1.TaxInvoice
+	if composition {
+		documentType = v1.BillOfSupply
+	}
+	sale := v1.Sale{
+		SoldAt:       &soldAt,
+		SupplyType:   &supplyType,
+		DocumentType: &documentType,
+		Items:        &[]v1.SaleItem{},
+	}
+
+	if request.CustomerId != nil {
+		customer, err := s.customerRepo.GetCustomerByID(ctx, *request.CustomerId)
+		if err != nil {
+			s.logger.Debugw("Failed to get customer by ID", "error", err, "customer_id", *request.CustomerId)
+			return v1.Sale{}, saleStock{}, err
+		}
+		if customer == nil {
+			return v1.Sale{}, saleStock{}, fmt.Errorf("%w: %d", ErrCustomerNotFound, *request.CustomerId)
+		}
+		sale.CustomerId = customer.Id
+		sale.BilledTo = customer
+		// Only a registered buyer can claim input tax credit.
+		if customer.Gstin != nil {
+			supplyType = v1.B2B
+		}
+	}
+	// The place of supply is the buyer's state, as on the e-way bill.
+	interstate := !composition && sale.BilledTo != nil && sale.BilledTo.StateCode != nil && settings.StateCode != nil &&
+		*sale.BilledTo.StateCode != *settings.StateCode
+	sale.Interstate = &interstate
+
+	priceLists, err := s.salePriceLists(ctx, request.PriceListId, sale.BilledTo)
+	if err != nil {
+		return v1.Sale{}, saleStock{}, err
+	}
+	if len(priceLists) > 0 {
+		sale.PriceListId = &priceLists[0]
+	}
+	// Quantity breaks apply to everything bought of a product, however many
+	// lines it is spread over.
+	quantities := map[int]float64{}
+	for _, line := range request.Items {
+		quantities[line.ProductId] += line.Quantity
+	}
+
+	stock := saleStock{
+		onHand:    map[int]float64{},
+		requested: map[int]float64{},
+		units:     map[int]string{},
+		tracked:   map[int]bool{},
+	}
+	products := map[int]v1.Product{}
+	for _, line := range request.Items {
+		product, err := s.productRepo.GetProductAt(ctx, line.ProductId, soldAt)
+		if err != nil {
+			s.logger.Debugw("Failed to get product by ID", "error", err, "product_id", line.ProductId)
+			return v1.Sale{}, saleStock{}, err
+		}
+		if product == nil {
+			return v1.Sale{}, saleStock{}, fmt.Errorf("%w: %d", ErrProductNotFound, line.ProductId)
+		}
+		if hasVariants(*product) {
+			return v1.Sale{}, saleStock{}, fmt.Errorf("%w: product %d is sold through its variants", ErrInvalidProduct, line.ProductId)
+		}
+		if archived(*product) {
+			return v1.Sale{}, saleStock{}, fmt.Errorf("%w: product %d is archived", ErrInvalidProduct, line.ProductId)
+		}
+		if err := uom.Check(line.Quantity, string(valueOrZero(product.Unit))); err != nil {
+			return v1.Sale{}, saleStock{}, fmt.Errorf("%w: product %d: %v", ErrInvalidQuantity, line.ProductId, err)
+		}
+		if err := s.applyPriceList(ctx, product, priceLists, quantities[line.ProductId]); err != nil {
+			return v1.Sale{}, saleStock{}, err
+		}
+
+		sold := []soldProduct{{product: *product, quantity: line.Quantity}}
+		if isBundle(*product) {
+			if sold, err = s.explodeBundle(ctx, *product, line.Quantity, soldAt); err != nil {
+				return v1.Sale{}, saleStock{}, err
+			}
+		}
+
+		for _, part := range sold {
+			product, productID := part.product, *part.product.Id
+			if composition {
+				product.CgstRate, product.SgstRate = nil, nil
+			}
+			item := calculateSaleItem(product, part.quantity)
+			if part.bundle != nil {
+				item = pricedSaleItem(product, part.quantity, part.subtotal/part.quantity, part.subtotal, part.bundle)
+			}
+			if interstate {
+				chargeIntegratedTax(&item)
+			}
+
+			products[productID] = product
+			stock.onHand[productID] = valueOrZero(product.StockOnHand)
+			stock.units[productID] = string(valueOrZero(product.Unit))
+			stock.tracked[productID] = valueOrZero(product.BatchTracked)
+			stock.requested[productID] = uom.Round(stock.requested[productID]+part.quantity, stock.units[productID])
+
+			*sale.Items = append(*sale.Items, item)
+		}
+	}
+
+	if err := s.applyPromotions(ctx, &sale, products); err != nil {
+		return v1.Sale{}, saleStock{}, err
+	}
+
+	var subtotal, discountTotal, cgstTotal, sgstTotal, igstTotal float64
+	for _, item := range *sale.Items {
+		subtotal += float64(*item.Subtotal)
+		discountTotal += float64(valueOrZero(item.Discount))
+		cgstTotal += float64(*item.CgstAmount)
+		sgstTotal += float64(*item.SgstAmount)
+		igstTotal += float64(*item.IgstAmount)
+	}
+	sale.Subtotal = float32Ptr(round2(subtotal))
+	sale.DiscountTotal = float32Ptr(round2(discountTotal))
+	sale.CgstTotal = float32Ptr(round2(cgstTotal))
+	sale.SgstTotal = float32Ptr(round2(sgstTotal))
+	sale.IgstTotal = float32Ptr(round2(igstTotal))
+	sale.TaxTotal = float32Ptr(round2(cgstTotal + sgstTotal + igstTotal))
+	sale.GrandTotal = float32Ptr(round2(subtotal + cgstTotal + sgstTotal + igstTotal))
+	return sale, stock, nil
+}
+
+// GetSaleReceipt renders the sale as a PDF tax invoice.
+func (s *SalesService) GetSaleReceipt(ctx context.Context, id int) ([]byte, error) {
+	ctx, span := s.tracer.Start(ctx, "SalesService.GetSaleReceipt")
+	defer span.End()
+
+	sale, err := s.salesRepository.GetSaleByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get sale by ID", "error", err, "sale_id", id)
+		return nil, err
+	}
+	if sale == nil {
+		return nil, ErrSaleNotFound
+	}
+
+	var buf bytes.Buffer
+	if err := receipt.Render(&buf, *sale); err != nil {
+		s.logger.Debugw("Failed to render receipt", "error", err, "sale_id", id)
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// VoidSale cancels the sale and returns its items to stock. A voided sale is
+// kept for the audit trail but left out of tax reports.
+func (s *SalesService) VoidSale(ctx context.Context, id int) error {
+	ctx, span := s.tracer.Start(ctx, "SalesService.VoidSale")
+	defer span.End()
+
+	sale, err := s.salesRepository.GetSaleByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get sale by ID", "error", err, "sale_id", id)
+		return err
+	}
+	if sale == nil {
+		return ErrSaleNotFound
+	}
+	if sale.VoidedAt != nil {
+		return fmt.Errorf("%w: sale %d was voided at %s", ErrSaleVoided, id, sale.VoidedAt.Format(time.RFC3339))
+	}
+
+	if err := s.salesRepository.VoidSale(ctx, *sale); err != nil {
+		if errors.Is(err, repository.ErrStatusChanged) {
+			return fmt.Errorf("%w: sale %d", ErrSaleVoided, id)
+		}
+		s.logger.Debugw("Failed to void sale", "error", err, "sale_id", id)
+		return err
+	}
+
+	s.logger.Infow("Sale voided", "sale_id", id)
+	return nil
+}
+
+// soldProduct is a product sold on a sale line, on its own or as a
+// component of a bundle at its share of the bundle subtotal.
+type soldProduct struct {
+	product  v1.Product
+	quantity float64
+	subtotal float64
+	bundle   *v1.SaleItemBundle
+}
+
+// applyPromotions applies the promotions running at the time of sale to its
+// lines, given the products sold by ID, and taxes each line on what is left
+// of it. Components of a bundle are already sold at the bundle price and are
+// left alone.
+func (s *SalesService) applyPromotions(ctx context.Context, sale *v1.Sale, products map[int]v1.Product) error {
+	promotions, err := s.promotionRepo.GetActivePromotions(ctx, *sale.SoldAt)
+	if err != nil {
+		s.logger.Debugw("Failed to get active promotions", "error", err)
+		return err
+	}
+	if len(promotions) == 0 {
+		return nil
+	}
+
+	var categories map[int]v1.Category
+	for _, p := range promotions {
+		if len(valueOrZero(valueOrZero(p.Conditions).CategoryIds)) > 0 {
+			all, err := s.categoryRepo.GetAllCategories(ctx)
+			if err != nil {
+				s.logger.Debugw("Failed to get categories", "error", err)
+				return err
+			}
+			categories = categoriesByID(all)
+			break
+		}
+	}
+
+	items := *sale.Items
+	lines := make([]promotion.Line, len(items))
+	for i, item := range items {
+		product := products[*item.ProductId]
+		lines[i] = promotion.Line{
+			ProductID:  *item.ProductId,
+			ParentID:   valueOrZero(product.ParentId),
+			Categories: categoryAncestors(categories, product.CategoryId),
+			Quantity:   *item.Quantity,
+			UnitPrice:  float64(*item.UnitPrice),
+			Subtotal:   round2(float64(*item.Subtotal)),
+			Excluded:   item.Bundle != nil,
+		}
+	}
+
+	discounts, applied := promotion.Apply(promotions, lines, *sale.SoldAt)
+	if len(applied) == 0 {
+		return nil
+	}
+	for i := range items {
+		if discounts[i] > 0 {
+			items[i].Discount = float32Ptr(discounts[i])
+			setLineAmounts(&items[i], round2(lines[i].Subtotal-discounts[i]))
+		}
+	}
+	sale.Promotions = &applied
+	return nil
+}
+
+// salePriceLists returns the price lists to look prices up in, most specific
+// first: the list asked for, or else the list of the customer or of their
+// group, followed by the default list.
+func (s *SalesService) salePriceLists(ctx context.Context, requested *int, customer *v1.Customer) ([]int, error) {
+	var priceLists []int
+	selected := requested
+	if selected != nil {
+		priceList, err := s.priceListRepo.GetPriceListByID(ctx, *selected)
+		if err != nil {
+			s.logger.Debugw("Failed to get price list by ID", "error", err, "price_list_id", *selected)
+			return nil, err
+		}
+		if priceList == nil {
+			return nil, fmt.Errorf("%w: %d", ErrPriceListNotFound, *selected)
+		}
+	} else if customer != nil {
+		selected = customer.PriceListId
+		if selected == nil && customer.GroupId != nil {
+			group, err := s.customerRepo.GetCustomerGroupByID(ctx, *customer.GroupId)
+			if err != nil {
+				s.logger.Debugw("Failed to get customer group by ID", "error", err, "group_id", *customer.GroupId)
+				return nil, err
+			}
+			if group != nil {
+				selected = group.PriceListId
+			}
+		}
+	}
+	if selected != nil {
+		priceLists = append(priceLists, *selected)
+	}
+
+	defaultList, err := s.priceListRepo.GetDefaultPriceList(ctx)
+	if err != nil {
+		s.logger.Debugw("Failed to get default price list", "error", err)
+		return nil, err
+	}
+	if defaultList != nil && (selected == nil || *defaultList.Id != *selected) {
+		priceLists = append(priceLists, *defaultList.Id)
+	}
+	return priceLists, nil
+}
+
+// applyPriceList replaces the price of the product with the tier that the
+// quantity reaches on the first price list that prices the product. The
+// product keeps its own price when none does.
+func (s *SalesService) applyPriceList(ctx context.Context, product *v1.Product, priceLists []int, quantity float64) error {
+	for _, priceListID := range priceLists {
+		price, err := s.priceListRepo.GetTierPrice(ctx, priceListID, *product.Id, quantity)
+		if err != nil {
+			s.logger.Debugw("Failed to get price list price", "error", err, "price_list_id", priceListID, "product_id", *product.Id)
+			return err
+		}
+		if price != nil {
+			product.Price = price
+			return nil
+		}
+	}
+	return nil
+}
+
+// explodeBundle returns the components of the bundle, as they are at the time
+// of sale, for the given number of bundles. The bundle price is spread over
+// the components in proportion to what they would sell for on their own, or
+// to their quantities when they carry no price. The rounding difference goes
+// to the component with the largest share so that the subtotals add up to the
+// bundle subtotal.
+func (s *SalesService) explodeBundle(ctx context.Context, bundle v1.Product, quantity float64, soldAt time.Time) ([]soldProduct, error) {
+	ref := &v1.SaleItemBundle{
+		ProductId: bundle.Id,
+		Name:      bundle.Name,
+		Quantity:  &quantity,
+		Unit:      bundle.Unit,
+		UnitPrice: bundle.Price,
+	}
+
+	definition := valueOrZero(bundle.BundleComponents)
+	components := make([]soldProduct, len(definition))
+	weights := make([]float64, len(definition))
+	var totalValue, totalQuantity float64
+	for i, component := range definition {
+		product, err := s.productRepo.GetProductAt(ctx, component.ProductId, soldAt)
+		if err != nil {
+			s.logger.Debugw("Failed to get product by ID", "error", err, "product_id", component.ProductId)
+			return nil, err
+		}
+		if product == nil {
+			return nil, fmt.Errorf("%w: %d, a component of bundle %d", ErrProductNotFound, component.ProductId, *bundle.Id)
+		}
+		if archived(*product) {
+			return nil, fmt.Errorf("%w: component %d of bundle %d is archived", ErrInvalidProduct, component.ProductId, *bundle.Id)
+		}
+		components[i] = soldProduct{
+			product:  *product,
+			quantity: uom.Round(component.Quantity*quantity, string(valueOrZero(product.Unit))),
+			bundle:   ref,
+		}
+		weights[i] = float64(valueOrZero(product.Price)) * components[i].quantity
+		totalValue += weights[i]
+		totalQuantity += components[i].quantity
+	}
+	if totalValue <= 0 {
+		for i := range components {
+			weights[i] = components[i].quantity
+		}
+		totalValue = totalQuantity
+	}
+
+	bundleSubtotal := round2(float64(valueOrZero(bundle.Price)) * quantity)
+	largest, spread := 0, 0.0
+	for i := range components {
+		components[i].subtotal = round2(bundleSubtotal * weights[i] / totalValue)
+		spread += components[i].subtotal
+		if weights[i] > weights[largest] {
+			largest = i
+		}
+	}
+	components[largest].subtotal = round2(components[largest].subtotal + bundleSubtotal - spread)
+	return components, nil
+}
+
+// allocateBatches takes the quantity of each line of a batch-tracked product
+// from its batches as batchAllocator does. A line that would need expired
+// stock is refused; any quantity left beyond that, allowed by the negative
+// stock policy, is sold outside a batch.
+func (s *SalesService) allocateBatches(ctx context.Context, items []v1.SaleItem, tracked map[int]bool, onHand map[int]float64,
+	units map[int]string) error {
+	allocator := newBatchAllocator(s.inventoryRepo)
+	for i := range items {
+		productID := *items[i].ProductId
+		if !tracked[productID] {
+			continue
+		}
+
+		batches, err := allocator.allocate(ctx, productID, *items[i].Quantity, onHand[productID], units[productID])
+		if err != nil {
+			s.logger.Debugw("Failed to allocate batches", "error", err, "product_id", productID)
+			return err
+		}
+		allocated := make([]v1.SaleItemBatch, 0, len(batches))
+		for _, batch := range batches {
+			allocated = append(allocated, v1.SaleItemBatch{
+				BatchId:   batch.Id,
+				BatchNo:   batch.BatchNo,
+				ExpiresOn: batch.ExpiresOn,
+				Mrp:       batch.Mrp,
+				Quantity:  batch.Quantity,
+			})
+		}
+		items[i].Batches = &allocated
+	}
+	return nil
+}
+
+// calculateSaleItem snapshots the product price and tax rates onto a sale line.
+func calculateSaleItem(product v1.Product, quantity float64) v1.SaleItem {
+	var price float64
+	if product.Price != nil {
+		price = float64(*product.Price)
+	}
+	return pricedSaleItem(product, quantity, price, round2(price*quantity), nil)
+}
+
+// pricedSaleItem snapshots the product and its tax rates onto a sale line
+// sold at the given price and subtotal, as a component of the bundle if one
+// is given.
+func pricedSaleItem(product v1.Product, quantity, price, subtotal float64, bundle *v1.SaleItemBundle) v1.SaleItem {
+	var cgstRate, sgstRate float64
+	if product.CgstRate != nil {
+		cgstRate = float64(*product.CgstRate)
+	}
+	if product.SgstRate != nil {
+		sgstRate = float64(*product.SgstRate)
+	}
+
+	item := v1.SaleItem{
+		ProductId:    product.Id,
+		Name:         product.Name,
+		VariantLabel: product.VariantLabel,
+		HsnCode:      product.HsnCode,
+		Quantity:     &quantity,
+		Unit:         product.Unit,
+		UnitPrice:    float32Ptr(price),
+		CgstRate:     float32Ptr(cgstRate),
+		SgstRate:     float32Ptr(sgstRate),
+		IgstRate:     float32Ptr(0),
+		Discount:     float32Ptr(0),
+		Bundle:       bundle,
 	}
+	setLineAmounts(&item, subtotal)
+	return item
 }
 
-func (s *SalesService) GetSales(c *gin.Context) {
-	// return status not implemented
-	c.JSON(501, gin.H{
-		"message": "Not Implemented",
-	})
+// chargeIntegratedTax moves the central and state GST of an inter-state line
+// into integrated GST at their combined rate.
+func chargeIntegratedTax(item *v1.SaleItem) {
+	igstRate := float64(valueOrZero(item.CgstRate) + valueOrZero(item.SgstRate))
+	item.CgstRate, item.SgstRate, item.IgstRate = float32Ptr(0), float32Ptr(0), float32Ptr(igstRate)
+	setLineAmounts(item, float64(valueOrZero(item.Subtotal)))
 }
 
-func (s *SalesService) PostSales(c *gin.Context) {
-	// return status not implemented
-	c.JSON(501, gin.H{
-		"message": "Not Implemented",
-	})
+// setLineAmounts sets the subtotal of the line and the tax and total that
+// follow from it at the rates of the line.
+func setLineAmounts(item *v1.SaleItem, subtotal float64) {
+	cgstAmount := round2(subtotal * float64(valueOrZero(item.CgstRate)) / 100)
+	sgstAmount := round2(subtotal * float64(valueOrZero(item.SgstRate)) / 100)
+	igstAmount := round2(subtotal * float64(valueOrZero(item.IgstRate)) / 100)
+	item.Subtotal = float32Ptr(subtotal)
+	item.CgstAmount = float32Ptr(cgstAmount)
+	item.SgstAmount = float32Ptr(sgstAmount)
+	item.IgstAmount = float32Ptr(igstAmount)
+	item.LineTotal = float32Ptr(round2(subtotal + cgstAmount + sgstAmount + igstAmount))
 }
 
-func (s *SalesService) DeleteSalesId(c *gin.Context, id int) {
-	// return status not implemented
-	c.JSON(501, gin.H{
-		"message": "Not Implemented",
-	})
+// round2 rounds a currency amount to paise.
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
 }
 
-func (s *SalesService) PutSalesId(c *gin.Context, id int) {
-	// return status not implemented
-	c.JSON(501, gin.H{
-		"message": "Not Implemented",
-	})
+func float32Ptr(v float64) *float32 {
+	f := float32(v)
+	return &f
 }
 
-func (s *SalesService) GetSalesIdReceipt(c *gin.Context, id int) {
-	// return status not implemented
-	c.JSON(501, gin.H{
-		"message": "Not Implemented",
-	})
+func float64Ptr(v float64) *float64 {
+	return &v
 }
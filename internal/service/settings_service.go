@@ -1,15 +1,50 @@
 package service
 
 import (
-	"github.com/gin-gonic/gin"
+	"context"
+	"fmt"
+	"strconv"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
 	"github.com/nitinjangam/pos-receipt-system/internal/repository"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
+// DefaultEWayBillThreshold is the consignment value above which an e-way bill
+// is required when no threshold is configured.
+const DefaultEWayBillThreshold = 50000
+
+// DefaultCompositionRate is the composition tax rate (%) for traders when no
+// rate is configured.
+const DefaultCompositionRate = 1
+
+// DefaultNearExpiryDays is how many days ahead the near-expiry report looks
+// when no period is configured.
+const DefaultNearExpiryDays = 30
+
+// Keys of the business settings in the settings table.
+const (
+	settingBusinessName       = "business_name"
+	settingAddress            = "address"
+	settingCity               = "city"
+	settingPincode            = "pincode"
+	settingStateCode          = "state_code"
+	settingGSTIN              = "gstin"
+	settingPhone              = "phone"
+	settingEmail              = "email"
+	settingDefaultTaxRate     = "default_tax_rate"
+	settingEWayBillThreshold  = "eway_bill_threshold"
+	settingCompositionScheme  = "composition_scheme"
+	settingCompositionRate    = "composition_rate"
+	settingAllowNegativeStock = "allow_negative_stock"
+	settingNearExpiryDays     = "near_expiry_days"
+	settingValuationMethod    = "valuation_method"
+)
+
 type SettingsServiceInterface interface {
-	GetSettings(c *gin.Context)
-	PutSettings(c *gin.Context)
+	GetSettings(ctx context.Context) (v1.Settings, error)
+	PutSettings(ctx context.Context, settings v1.Settings) (v1.Settings, error)
 }
 
 type SettingsService struct {
@@ -26,16 +61,173 @@ func NewSettingsService(tracer trace.Tracer, logger *zap.SugaredLogger, settings
 	}
 }
 
-func (s *SettingsService) GetSettings(c *gin.Context) {
-	// return status not implemented
-	c.JSON(501, gin.H{
-		"message": "Not Implemented",
-	})
+func (s *SettingsService) GetSettings(ctx context.Context) (v1.Settings, error) {
+	ctx, span := s.tracer.Start(ctx, "SettingsService.GetSettings")
+	defer span.End()
+
+	values, err := s.settingsRepo.GetSettings(ctx)
+	if err != nil {
+		s.logger.Debugw("Failed to get settings", "error", err)
+		return v1.Settings{}, err
+	}
+
+	settings := v1.Settings{
+		BusinessName:       stringSetting(values, settingBusinessName),
+		Address:            stringSetting(values, settingAddress),
+		City:               stringSetting(values, settingCity),
+		Pincode:            stringSetting(values, settingPincode),
+		StateCode:          stringSetting(values, settingStateCode),
+		Gstin:              stringSetting(values, settingGSTIN),
+		Phone:              stringSetting(values, settingPhone),
+		Email:              stringSetting(values, settingEmail),
+		DefaultTaxRate:     floatSetting(values, settingDefaultTaxRate),
+		EwayBillThreshold:  floatSetting(values, settingEWayBillThreshold),
+		CompositionScheme:  boolSetting(values, settingCompositionScheme),
+		CompositionRate:    floatSetting(values, settingCompositionRate),
+		AllowNegativeStock: boolSetting(values, settingAllowNegativeStock),
+		NearExpiryDays:     intSetting(values, settingNearExpiryDays),
+		ValuationMethod:    valuationMethodSetting(values, settingValuationMethod),
+	}
+	if settings.EwayBillThreshold == nil {
+		threshold := float32(DefaultEWayBillThreshold)
+		settings.EwayBillThreshold = &threshold
+	}
+	if settings.CompositionScheme == nil {
+		composition := false
+		settings.CompositionScheme = &composition
+	}
+	if settings.AllowNegativeStock == nil {
+		allowNegative := true
+		settings.AllowNegativeStock = &allowNegative
+	}
+	if settings.CompositionRate == nil {
+		rate := float32(DefaultCompositionRate)
+		settings.CompositionRate = &rate
+	}
+	if settings.NearExpiryDays == nil {
+		days := DefaultNearExpiryDays
+		settings.NearExpiryDays = &days
+	}
+	if settings.ValuationMethod == nil {
+		method := v1.WeightedAverage
+		settings.ValuationMethod = &method
+	}
+	return settings, nil
+}
+
+// PutSettings replaces the business settings; fields left out are cleared.
+func (s *SettingsService) PutSettings(ctx context.Context, settings v1.Settings) (v1.Settings, error) {
+	ctx, span := s.tracer.Start(ctx, "SettingsService.PutSettings")
+	defer span.End()
+
+	gstin, stateCode, err := normalizeGSTINAndState(settings.Gstin, settings.StateCode)
+	if err != nil {
+		return v1.Settings{}, fmt.Errorf("%w: %v", ErrInvalidSettings, err)
+	}
+	settings.Gstin, settings.StateCode = gstin, stateCode
+
+	values := map[string]*string{
+		settingBusinessName:       settings.BusinessName,
+		settingAddress:            settings.Address,
+		settingCity:               settings.City,
+		settingPincode:            settings.Pincode,
+		settingStateCode:          settings.StateCode,
+		settingGSTIN:              settings.Gstin,
+		settingPhone:              settings.Phone,
+		settingEmail:              settings.Email,
+		settingDefaultTaxRate:     formatFloatSetting(settings.DefaultTaxRate),
+		settingEWayBillThreshold:  formatFloatSetting(settings.EwayBillThreshold),
+		settingCompositionScheme:  formatBoolSetting(settings.CompositionScheme),
+		settingCompositionRate:    formatFloatSetting(settings.CompositionRate),
+		settingAllowNegativeStock: formatBoolSetting(settings.AllowNegativeStock),
+		settingNearExpiryDays:     formatIntSetting(settings.NearExpiryDays),
+		settingValuationMethod:    (*string)(settings.ValuationMethod),
+	}
+	if err := s.settingsRepo.SaveSettings(ctx, values); err != nil {
+		s.logger.Debugw("Failed to save settings", "error", err)
+		return v1.Settings{}, err
+	}
+
+	return s.GetSettings(ctx)
+}
+
+func stringSetting(values map[string]string, key string) *string {
+	value, ok := values[key]
+	if !ok {
+		return nil
+	}
+	return &value
 }
 
-func (s *SettingsService) PutSettings(c *gin.Context) {
-	// return status not implemented
-	c.JSON(501, gin.H{
-		"message": "Not Implemented",
-	})
+func floatSetting(values map[string]string, key string) *float32 {
+	value, ok := values[key]
+	if !ok {
+		return nil
+	}
+	f, err := strconv.ParseFloat(value, 32)
+	if err != nil {
+		return nil
+	}
+	result := float32(f)
+	return &result
+}
+
+func intSetting(values map[string]string, key string) *int {
+	value, ok := values[key]
+	if !ok {
+		return nil
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return nil
+	}
+	return &i
+}
+
+func boolSetting(values map[string]string, key string) *bool {
+	value, ok := values[key]
+	if !ok {
+		return nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil
+	}
+	return &b
+}
+
+func valuationMethodSetting(values map[string]string, key string) *v1.ValuationMethod {
+	value, ok := values[key]
+	if !ok {
+		return nil
+	}
+	method := v1.ValuationMethod(value)
+	if method != v1.Fifo && method != v1.WeightedAverage {
+		return nil
+	}
+	return &method
+}
+
+func formatBoolSetting(value *bool) *string {
+	if value == nil {
+		return nil
+	}
+	formatted := strconv.FormatBool(*value)
+	return &formatted
+}
+
+func formatFloatSetting(value *float32) *string {
+	if value == nil {
+		return nil
+	}
+	formatted := strconv.FormatFloat(float64(*value), 'f', -1, 32)
+	return &formatted
+}
+
+func formatIntSetting(value *int) *string {
+	if value == nil {
+		return nil
+	}
+	formatted := strconv.Itoa(*value)
+	return &formatted
 }
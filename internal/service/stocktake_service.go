@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/barcode"
+	"github.com/nitinjangam/pos-receipt-system/internal/repository"
+	"github.com/nitinjangam/pos-receipt-system/internal/uom"
+	"go.uber.org/zap"
+)
+
+type StocktakeServiceInterface interface {
+	GetStocktakes(ctx context.Context, params v1.GetStocktakesParams) ([]v1.Stocktake, error)
+	GetStocktake(ctx context.Context, id int) (v1.Stocktake, error)
+	PostStocktake(ctx context.Context, stocktake v1.Stocktake) (v1.Stocktake, error)
+	GetStocktakeCounts(ctx context.Context, id int) ([]v1.StocktakeCount, error)
+	PostStocktakeCounts(ctx context.Context, id int, request v1.StocktakeCountRequest) (v1.Stocktake, error)
+	ApproveStocktake(ctx context.Context, id int, approval v1.StocktakeApproval) (v1.Stocktake, error)
+	CancelStocktake(ctx context.Context, id int) (v1.Stocktake, error)
+}
+
+type StocktakeService struct {
+	stocktakeRepo   *repository.StocktakeRepository
+	productRepo     *repository.ProductRepository
+	categoryRepo    *repository.CategoryRepository
+	settingsService SettingsServiceInterface
+	logger          *zap.SugaredLogger
+}
+
+func NewStocktakeService(stocktakeRepository *repository.StocktakeRepository, productRepository *repository.ProductRepository,
+	categoryRepository *repository.CategoryRepository, settingsService SettingsServiceInterface, logger *zap.SugaredLogger) *StocktakeService {
+	return &StocktakeService{
+		stocktakeRepo:   stocktakeRepository,
+		productRepo:     productRepository,
+		categoryRepo:    categoryRepository,
+		settingsService: settingsService,
+		logger:          logger,
+	}
+}
+
+func (s *StocktakeService) GetStocktakes(ctx context.Context, params v1.GetStocktakesParams) ([]v1.Stocktake, error) {
+	stocktakes, err := s.stocktakeRepo.GetStocktakes(ctx, params.Status)
+	if err != nil {
+		s.logger.Debugw("Failed to get stocktakes", "error", err)
+		return nil, err
+	}
+	for i := range stocktakes {
+		withStocktakeTotals(&stocktakes[i])
+	}
+	return stocktakes, nil
+}
+
+func (s *StocktakeService) GetStocktake(ctx context.Context, id int) (v1.Stocktake, error) {
+	stocktake, err := s.stocktakeRepo.GetStocktakeByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get stocktake by ID", "error", err, "stocktake_id", id)
+		return v1.Stocktake{}, err
+	}
+	if stocktake == nil {
+		return v1.Stocktake{}, ErrStocktakeNotFound
+	}
+	withStocktakeTotals(stocktake)
+	return *stocktake, nil
+}
+
+// PostStocktake starts a stocktake of the products in the category, or of
+// the whole catalogue, freezing their stock on hand. Archived products,
+// bundles and products sold through their variants are not counted. Only
+// one stocktake can be counting at a time, so that no product is counted
+// twice.
+func (s *StocktakeService) PostStocktake(ctx context.Context, stocktake v1.Stocktake) (v1.Stocktake, error) {
+	stocktake.Name = strings.TrimSpace(stocktake.Name)
+	if stocktake.Name == "" {
+		return v1.Stocktake{}, fmt.Errorf("%w: name is required", ErrInvalidStocktake)
+	}
+
+	counting := v1.Counting
+	open, err := s.stocktakeRepo.GetStocktakes(ctx, &counting)
+	if err != nil {
+		return v1.Stocktake{}, err
+	}
+	if len(open) > 0 {
+		return v1.Stocktake{}, fmt.Errorf("%w: stocktake %d is still counting", ErrStocktakeInProgress, *open[0].Id)
+	}
+
+	var products []v1.Product
+	if stocktake.CategoryId != nil {
+		category, err := s.categoryRepo.GetCategoryByID(ctx, *stocktake.CategoryId)
+		if err != nil {
+			s.logger.Debugw("Failed to get category by ID", "error", err, "category_id", *stocktake.CategoryId)
+			return v1.Stocktake{}, err
+		}
+		if category == nil {
+			return v1.Stocktake{}, fmt.Errorf("%w: category %d not found", ErrInvalidStocktake, *stocktake.CategoryId)
+		}
+		products, err = s.productRepo.GetProductsInCategory(ctx, *stocktake.CategoryId)
+		if err != nil {
+			return v1.Stocktake{}, err
+		}
+	} else {
+		products, err = s.productRepo.GetAllProducts(ctx)
+		if err != nil {
+			return v1.Stocktake{}, err
+		}
+	}
+
+	var productIDs []int
+	for _, product := range products {
+		if !archived(product) && !isBundle(product) && !hasVariants(product) {
+			productIDs = append(productIDs, *product.Id)
+		}
+	}
+	if len(productIDs) == 0 {
+		return v1.Stocktake{}, fmt.Errorf("%w: there are no products to count", ErrInvalidStocktake)
+	}
+
+	id, err := s.stocktakeRepo.CreateStocktake(ctx, stocktake, productIDs)
+	if err != nil {
+		if errors.Is(err, ErrStocktakeInProgress) {
+			return v1.Stocktake{}, err
+		}
+		s.logger.Debugw("Failed to create stocktake", "error", err)
+		return v1.Stocktake{}, err
+	}
+
+	s.logger.Infow("Stocktake started", "stocktake_id", id, "products", len(productIDs))
+	return s.GetStocktake(ctx, id)
+}
+
+func (s *StocktakeService) GetStocktakeCounts(ctx context.Context, id int) ([]v1.StocktakeCount, error) {
+	if _, err := s.GetStocktake(ctx, id); err != nil {
+		return nil, err
+	}
+
+	counts, err := s.stocktakeRepo.GetStocktakeCounts(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get stocktake counts", "error", err, "stocktake_id", id)
+		return nil, err
+	}
+	return counts, nil
+}
+
+// PostStocktakeCounts adds counts from a scanner to the quantities counted so
+// far. Counts name a product by ID or by barcode, must be in the product's
+// unit and cannot take the total counted for a product below zero.
+func (s *StocktakeService) PostStocktakeCounts(ctx context.Context, id int, request v1.StocktakeCountRequest) (v1.Stocktake, error) {
+	stocktake, err := s.GetStocktake(ctx, id)
+	if err != nil {
+		return v1.Stocktake{}, err
+	}
+	if valueOrZero(stocktake.Status) != v1.Counting {
+		return v1.Stocktake{}, fmt.Errorf("%w: stocktake %d is %s", ErrStocktakeClosed, id, valueOrZero(stocktake.Status))
+	}
+	if len(request.Counts) == 0 {
+		return v1.Stocktake{}, fmt.Errorf("%w: at least one count is required", ErrInvalidStocktake)
+	}
+
+	items := map[int]v1.StocktakeItem{}
+	counted := map[int]float64{}
+	for _, item := range valueOrZero(stocktake.Items) {
+		items[*item.ProductId] = item
+		counted[*item.ProductId] = valueOrZero(item.CountedQuantity)
+	}
+
+	for i := range request.Counts {
+		count := &request.Counts[i]
+		if count.ProductId == nil {
+			productID, err := s.productByBarcode(ctx, valueOrZero(count.Barcode))
+			if err != nil {
+				return v1.Stocktake{}, err
+			}
+			count.ProductId = &productID
+		}
+		item, ok := items[*count.ProductId]
+		if !ok {
+			return v1.Stocktake{}, fmt.Errorf("%w: product %d is not in stocktake %d", ErrInvalidStocktake, *count.ProductId, id)
+		}
+
+		unit := string(valueOrZero(item.Unit))
+		if err := uom.Check(count.Quantity, unit); err != nil {
+			return v1.Stocktake{}, fmt.Errorf("%w: product %d: %v", ErrInvalidStocktake, *count.ProductId, err)
+		}
+		counted[*count.ProductId] = uom.Round(counted[*count.ProductId]+count.Quantity, unit)
+		if counted[*count.ProductId] < 0 {
+			return v1.Stocktake{}, fmt.Errorf("%w: product %d would be counted at %s", ErrInvalidStocktake, *count.ProductId,
+				uom.Format(counted[*count.ProductId], unit))
+		}
+	}
+
+	var device *string
+	if request.Device != nil {
+		if name := strings.TrimSpace(*request.Device); name != "" {
+			device = &name
+		}
+	}
+	// The status and the totals are checked again as the counts are added,
+	// for counts coming in at the same time.
+	if err := s.stocktakeRepo.AddStocktakeCounts(ctx, id, device, request.Counts); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrStatusChanged):
+			return v1.Stocktake{}, fmt.Errorf("%w: stocktake %d was closed by another request", ErrStocktakeClosed, id)
+		case errors.Is(err, repository.ErrNegativeCount):
+			return v1.Stocktake{}, fmt.Errorf("%w: %v, counts were added by another request", ErrInvalidStocktake, err)
+		}
+		s.logger.Debugw("Failed to add stocktake counts", "error", err, "stocktake_id", id)
+		return v1.Stocktake{}, err
+	}
+	return s.GetStocktake(ctx, id)
+}
+
+// productByBarcode returns the ID of the product carrying a scanned barcode.
+func (s *StocktakeService) productByBarcode(ctx context.Context, code string) (int, error) {
+	code = barcode.Normalize(code)
+	if code == "" {
+		return 0, fmt.Errorf("%w: a count needs a productId or a barcode", ErrInvalidStocktake)
+	}
+	product, err := s.productRepo.GetProductByBarcode(ctx, barcode.Equivalents(code))
+	if err != nil {
+		s.logger.Debugw("Failed to get product by barcode", "error", err, "barcode", code)
+		return 0, err
+	}
+	if product == nil {
+		return 0, fmt.Errorf("%w: no product has barcode %s", ErrInvalidStocktake, code)
+	}
+	return *product.Id, nil
+}
+
+// ApproveStocktake posts the variance of each counted product as a stock
+// adjustment with the reason given, and closes the stocktake. Surpluses come
+// in at the unit cost frozen when the stocktake started; shortfalls go out by
+// the valuation method. Products that were not counted are left as they are.
+func (s *StocktakeService) ApproveStocktake(ctx context.Context, id int, approval v1.StocktakeApproval) (v1.Stocktake, error) {
+	stocktake, err := s.GetStocktake(ctx, id)
+	if err != nil {
+		return v1.Stocktake{}, err
+	}
+	if valueOrZero(stocktake.Status) != v1.Counting {
+		return v1.Stocktake{}, fmt.Errorf("%w: stocktake %d is %s", ErrStocktakeClosed, id, valueOrZero(stocktake.Status))
+	}
+	reason := strings.TrimSpace(approval.Reason)
+	if reason == "" {
+		return v1.Stocktake{}, fmt.Errorf("%w: reason is required", ErrInvalidStocktake)
+	}
+
+	settings, err := s.settingsService.GetSettings(ctx)
+	if err != nil {
+		return v1.Stocktake{}, err
+	}
+	if err := s.stocktakeRepo.ApproveStocktake(ctx, id, reason, valueOrZero(settings.ValuationMethod)); err != nil {
+		if errors.Is(err, repository.ErrStatusChanged) {
+			return v1.Stocktake{}, fmt.Errorf("%w: stocktake %d was closed by another request", ErrStocktakeClosed, id)
+		}
+		s.logger.Debugw("Failed to approve stocktake", "error", err, "stocktake_id", id)
+		return v1.Stocktake{}, err
+	}
+
+	s.logger.Infow("Stocktake approved", "stocktake_id", id, "counted_products", valueOrZero(stocktake.CountedProducts),
+		"variance_value", valueOrZero(stocktake.VarianceValue))
+	return s.GetStocktake(ctx, id)
+}
+
+// CancelStocktake discards the counts of a stocktake without adjusting
+// stock.
+func (s *StocktakeService) CancelStocktake(ctx context.Context, id int) (v1.Stocktake, error) {
+	stocktake, err := s.GetStocktake(ctx, id)
+	if err != nil {
+		return v1.Stocktake{}, err
+	}
+	if valueOrZero(stocktake.Status) != v1.Counting {
+		return v1.Stocktake{}, fmt.Errorf("%w: stocktake %d is %s", ErrStocktakeClosed, id, valueOrZero(stocktake.Status))
+	}
+
+	if err := s.stocktakeRepo.CancelStocktake(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrStatusChanged) {
+			return v1.Stocktake{}, fmt.Errorf("%w: stocktake %d was closed by another request", ErrStocktakeClosed, id)
+		}
+		s.logger.Debugw("Failed to cancel stocktake", "error", err, "stocktake_id", id)
+		return v1.Stocktake{}, err
+	}
+
+	s.logger.Infow("Stocktake cancelled", "stocktake_id", id)
+	return s.GetStocktake(ctx, id)
+}
+
+// withStocktakeTotals fills in the variance of each counted line, in
+// quantity and at cost, and the totals of the stocktake.
+func withStocktakeTotals(stocktake *v1.Stocktake) {
+	items := valueOrZero(stocktake.Items)
+	var counted int
+	var value float64
+	for i := range items {
+		item := &items[i]
+		if item.CountedQuantity == nil {
+			continue
+		}
+		variance := uom.Round(*item.CountedQuantity-valueOrZero(item.ExpectedQuantity), string(valueOrZero(item.Unit)))
+		varianceValue := round2(variance * float64(valueOrZero(item.UnitCost)))
+		item.VarianceQuantity = &variance
+		item.VarianceValue = float32Ptr(varianceValue)
+		counted++
+		value += varianceValue
+	}
+	products := len(items)
+	stocktake.Products = &products
+	stocktake.CountedProducts = &counted
+	stocktake.VarianceValue = float32Ptr(round2(value))
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/gst"
+	"github.com/nitinjangam/pos-receipt-system/internal/repository"
+	"go.uber.org/zap"
+)
+
+type StoreServiceInterface interface {
+	GetStores(ctx context.Context) ([]v1.Store, error)
+	GetStore(ctx context.Context, id int) (v1.Store, error)
+	PostStore(ctx context.Context, store v1.Store) (v1.Store, error)
+	PutStore(ctx context.Context, store v1.Store) (v1.Store, error)
+}
+
+type StoreService struct {
+	storeRepo *repository.StoreRepository
+	logger    *zap.SugaredLogger
+}
+
+func NewStoreService(storeRepository *repository.StoreRepository, logger *zap.SugaredLogger) *StoreService {
+	return &StoreService{
+		storeRepo: storeRepository,
+		logger:    logger,
+	}
+}
+
+func (s *StoreService) GetStores(ctx context.Context) ([]v1.Store, error) {
+	stores, err := s.storeRepo.GetAllStores(ctx)
+	if err != nil {
+		s.logger.Debugw("Failed to get stores", "error", err)
+		return nil, err
+	}
+	for i := range stores {
+		withStoreStateName(&stores[i])
+	}
+	return stores, nil
+}
+
+func (s *StoreService) GetStore(ctx context.Context, id int) (v1.Store, error) {
+	store, err := s.storeRepo.GetStoreByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get store by ID", "error", err, "store_id", id)
+		return v1.Store{}, err
+	}
+	if store == nil {
+		return v1.Store{}, ErrStoreNotFound
+	}
+	withStoreStateName(store)
+	return *store, nil
+}
+
+func (s *StoreService) PostStore(ctx context.Context, store v1.Store) (v1.Store, error) {
+	if err := validateStore(&store); err != nil {
+		return v1.Store{}, err
+	}
+
+	id, err := s.storeRepo.CreateStore(ctx, store)
+	if err != nil {
+		s.logger.Debugw("Failed to create store", "error", err, "store", store)
+		return v1.Store{}, err
+	}
+	store.Id = &id
+	withStoreStateName(&store)
+	return store, nil
+}
+
+func (s *StoreService) PutStore(ctx context.Context, store v1.Store) (v1.Store, error) {
+	if _, err := s.GetStore(ctx, *store.Id); err != nil {
+		return v1.Store{}, err
+	}
+	if err := validateStore(&store); err != nil {
+		return v1.Store{}, err
+	}
+
+	if err := s.storeRepo.UpdateStore(ctx, store); err != nil {
+		s.logger.Debugw("Failed to update store", "error", err, "store", store)
+		return v1.Store{}, err
+	}
+	withStoreStateName(&store)
+	return store, nil
+}
+
+// validateStore normalizes the store in place and checks the GSTIN the same
+// way as for customers. Unlike suppliers, stores may share a GSTIN, as the
+// outlets of a business in one state are registered under the same one.
+func validateStore(store *v1.Store) error {
+	store.Name = strings.TrimSpace(store.Name)
+	if store.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidStore)
+	}
+	gstin, stateCode, err := normalizeGSTINAndState(store.Gstin, store.StateCode)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidStore, err)
+	}
+	store.Gstin, store.StateCode = gstin, stateCode
+	return nil
+}
+
+func withStoreStateName(store *v1.Store) {
+	if store.StateCode == nil {
+		return
+	}
+	if name, ok := gst.StateName(*store.StateCode); ok {
+		store.State = &name
+	}
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/gst"
+	"github.com/nitinjangam/pos-receipt-system/internal/repository"
+	"go.uber.org/zap"
+)
+
+type SupplierServiceInterface interface {
+	GetSuppliers(ctx context.Context) ([]v1.Supplier, error)
+	GetSupplier(ctx context.Context, id int) (v1.Supplier, error)
+	PostSupplier(ctx context.Context, supplier v1.Supplier) (v1.Supplier, error)
+	PutSupplier(ctx context.Context, supplier v1.Supplier) (v1.Supplier, error)
+}
+
+type SupplierService struct {
+	supplierRepo *repository.SupplierRepository
+	logger       *zap.SugaredLogger
+}
+
+func NewSupplierService(supplierRepository *repository.SupplierRepository, logger *zap.SugaredLogger) *SupplierService {
+	return &SupplierService{
+		supplierRepo: supplierRepository,
+		logger:       logger,
+	}
+}
+
+func (s *SupplierService) GetSuppliers(ctx context.Context) ([]v1.Supplier, error) {
+	suppliers, err := s.supplierRepo.GetAllSuppliers(ctx)
+	if err != nil {
+		s.logger.Debugw("Failed to get suppliers", "error", err)
+		return nil, err
+	}
+	for i := range suppliers {
+		withSupplierStateName(&suppliers[i])
+	}
+	return suppliers, nil
+}
+
+func (s *SupplierService) GetSupplier(ctx context.Context, id int) (v1.Supplier, error) {
+	supplier, err := s.supplierRepo.GetSupplierByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get supplier by ID", "error", err, "supplier_id", id)
+		return v1.Supplier{}, err
+	}
+	if supplier == nil {
+		return v1.Supplier{}, ErrSupplierNotFound
+	}
+	withSupplierStateName(supplier)
+	return *supplier, nil
+}
+
+func (s *SupplierService) PostSupplier(ctx context.Context, supplier v1.Supplier) (v1.Supplier, error) {
+	if err := s.validateSupplier(ctx, &supplier); err != nil {
+		return v1.Supplier{}, err
+	}
+
+	id, err := s.supplierRepo.CreateSupplier(ctx, supplier)
+	if err != nil {
+		s.logger.Debugw("Failed to create supplier", "error", err, "supplier", supplier)
+		return v1.Supplier{}, err
+	}
+	supplier.Id = &id
+	withSupplierStateName(&supplier)
+	return supplier, nil
+}
+
+func (s *SupplierService) PutSupplier(ctx context.Context, supplier v1.Supplier) (v1.Supplier, error) {
+	existing, err := s.supplierRepo.GetSupplierByID(ctx, *supplier.Id)
+	if err != nil {
+		s.logger.Debugw("Failed to get supplier by ID", "error", err, "supplier_id", *supplier.Id)
+		return v1.Supplier{}, err
+	}
+	if existing == nil {
+		return v1.Supplier{}, ErrSupplierNotFound
+	}
+	if err := s.validateSupplier(ctx, &supplier); err != nil {
+		return v1.Supplier{}, err
+	}
+
+	if err := s.supplierRepo.UpdateSupplier(ctx, supplier); err != nil {
+		s.logger.Debugw("Failed to update supplier", "error", err, "supplier", supplier)
+		return v1.Supplier{}, err
+	}
+	withSupplierStateName(&supplier)
+	return supplier, nil
+}
+
+// validateSupplier normalizes the supplier in place and checks the GSTIN the
+// same way as for customers.
+func (s *SupplierService) validateSupplier(ctx context.Context, supplier *v1.Supplier) error {
+	supplier.LegalName = strings.TrimSpace(supplier.LegalName)
+	if supplier.LegalName == "" {
+		return fmt.Errorf("%w: legal name is required", ErrInvalidSupplier)
+	}
+	gstin, stateCode, err := normalizeGSTINAndState(supplier.Gstin, supplier.StateCode)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSupplier, err)
+	}
+	supplier.Gstin, supplier.StateCode = gstin, stateCode
+
+	if gstin != nil {
+		existing, err := s.supplierRepo.GetSupplierByGSTIN(ctx, *gstin)
+		if err != nil {
+			s.logger.Debugw("Failed to get supplier by GSTIN", "error", err, "gstin", *gstin)
+			return err
+		}
+		if existing != nil && (supplier.Id == nil || *existing.Id != *supplier.Id) {
+			return fmt.Errorf("%w: GSTIN %s is already registered to supplier %d", ErrInvalidSupplier, *gstin, *existing.Id)
+		}
+	}
+	return nil
+}
+
+func withSupplierStateName(supplier *v1.Supplier) {
+	if supplier.StateCode == nil {
+		return
+	}
+	if name, ok := gst.StateName(*supplier.StateCode); ok {
+		supplier.State = &name
+	}
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/repository"
+	"go.uber.org/zap"
+)
+
+type TaxRateServiceInterface interface {
+	GetProductTaxRates(ctx context.Context, productID int) ([]v1.TaxRate, error)
+	PostProductTaxRate(ctx context.Context, productID int, rate v1.TaxRate) (v1.TaxRate, error)
+	GetTaxRateChanges(ctx context.Context) ([]v1.TaxRateChange, error)
+	PreviewTaxRateChange(ctx context.Context, change v1.TaxRateChange) ([]v1.TaxRateChangePreview, error)
+	PostTaxRateChange(ctx context.Context, change v1.TaxRateChange) (v1.TaxRateChange, error)
+	DeleteTaxRateChange(ctx context.Context, id int) error
+}
+
+type TaxRateService struct {
+	taxRateRepo  *repository.TaxRateRepository
+	productRepo  *repository.ProductRepository
+	categoryRepo *repository.CategoryRepository
+	logger       *zap.SugaredLogger
+}
+
+func NewTaxRateService(taxRateRepository *repository.TaxRateRepository, productRepository *repository.ProductRepository,
+	categoryRepository *repository.CategoryRepository, logger *zap.SugaredLogger) *TaxRateService {
+	return &TaxRateService{
+		taxRateRepo:  taxRateRepository,
+		productRepo:  productRepository,
+		categoryRepo: categoryRepository,
+		logger:       logger,
+	}
+}
+
+func (s *TaxRateService) GetProductTaxRates(ctx context.Context, productID int) ([]v1.TaxRate, error) {
+	product, err := s.productRepo.GetProductByID(ctx, productID)
+	if err != nil {
+		s.logger.Debugw("Failed to get product by ID", "error", err, "product_id", productID)
+		return nil, err
+	}
+	if product == nil {
+		return nil, ErrProductNotFound
+	}
+
+	rates, err := s.taxRateRepo.GetTaxRates(ctx, productID)
+	if err != nil {
+		s.logger.Debugw("Failed to get tax rates", "error", err, "product_id", productID)
+		return nil, err
+	}
+	return rates, nil
+}
+
+func (s *TaxRateService) PostProductTaxRate(ctx context.Context, productID int, rate v1.TaxRate) (v1.TaxRate, error) {
+	// The change is recorded from the rates the product has at the date the
+	// new rate takes effect.
+	product, err := s.productRepo.GetProductAt(ctx, productID, rate.EffectiveFrom)
+	if err != nil {
+		s.logger.Debugw("Failed to get product by ID", "error", err, "product_id", productID)
+		return v1.TaxRate{}, err
+	}
+	if product == nil {
+		return v1.TaxRate{}, ErrProductNotFound
+	}
+
+	rate.ProductId = &productID
+	created, err := s.taxRateRepo.ScheduleTaxRate(ctx, rate, *product)
+	if err != nil {
+		s.logger.Debugw("Failed to create tax rate", "error", err, "product_id", productID)
+		return v1.TaxRate{}, err
+	}
+	return created, nil
+}
+
+func (s *TaxRateService) GetTaxRateChanges(ctx context.Context) ([]v1.TaxRateChange, error) {
+	changes, err := s.taxRateRepo.GetTaxRateChanges(ctx)
+	if err != nil {
+		s.logger.Debugw("Failed to get tax rate changes", "error", err)
+		return nil, err
+	}
+	return changes, nil
+}
+
+// PreviewTaxRateChange lists the products the change would apply to, along
+// with the rates they would otherwise have at the change's effective date.
+func (s *TaxRateService) PreviewTaxRateChange(ctx context.Context, change v1.TaxRateChange) ([]v1.TaxRateChangePreview, error) {
+	products, err := s.affectedProducts(ctx, change)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := make([]v1.TaxRateChangePreview, 0, len(products))
+	for _, product := range products {
+		preview = append(preview, v1.TaxRateChangePreview{
+			ProductId:   product.Id,
+			Name:        product.Name,
+			HsnCode:     product.HsnCode,
+			OldCgstRate: product.CgstRate,
+			OldSgstRate: product.SgstRate,
+			NewCgstRate: &change.CgstRate,
+			NewSgstRate: &change.SgstRate,
+		})
+	}
+	return preview, nil
+}
+
+func (s *TaxRateService) PostTaxRateChange(ctx context.Context, change v1.TaxRateChange) (v1.TaxRateChange, error) {
+	products, err := s.affectedProducts(ctx, change)
+	if err != nil {
+		return v1.TaxRateChange{}, err
+	}
+
+	created, err := s.taxRateRepo.CreateTaxRateChange(ctx, change, products)
+	if err != nil {
+		s.logger.Debugw("Failed to create tax rate change", "error", err, "hsn_code", change.HsnCode, "category_id", change.CategoryId)
+		return v1.TaxRateChange{}, err
+	}
+	s.logger.Infow("Tax rate change scheduled", "id", created.Id, "hsn_code", change.HsnCode, "category_id", change.CategoryId,
+		"effective_from", change.EffectiveFrom, "affected_products", len(products))
+	return created, nil
+}
+
+// affectedProducts returns the products the change applies to, with the rates
+// they have at its effective date. Products must have the HSN code and be in
+// the category, or one of its subcategories, when both are given.
+func (s *TaxRateService) affectedProducts(ctx context.Context, change v1.TaxRateChange) ([]v1.Product, error) {
+	if change.HsnCode != nil && *change.HsnCode == "" {
+		change.HsnCode = nil
+	}
+	if change.HsnCode == nil && change.CategoryId == nil {
+		return nil, fmt.Errorf("%w: an HSN code or a category is required", ErrInvalidTaxRateChange)
+	}
+
+	if change.CategoryId == nil {
+		products, err := s.productRepo.GetProductsByHSNAt(ctx, *change.HsnCode, change.EffectiveFrom)
+		if err != nil {
+			s.logger.Debugw("Failed to get products by HSN code", "error", err, "hsn_code", *change.HsnCode)
+			return nil, err
+		}
+		return products, nil
+	}
+
+	category, err := s.categoryRepo.GetCategoryByID(ctx, *change.CategoryId)
+	if err != nil {
+		s.logger.Debugw("Failed to get category by ID", "error", err, "category_id", *change.CategoryId)
+		return nil, err
+	}
+	if category == nil {
+		return nil, fmt.Errorf("%w: category %d not found", ErrInvalidTaxRateChange, *change.CategoryId)
+	}
+	products, err := s.productRepo.GetProductsInCategoryAt(ctx, *change.CategoryId, change.EffectiveFrom)
+	if err != nil {
+		s.logger.Debugw("Failed to get products in category", "error", err, "category_id", *change.CategoryId)
+		return nil, err
+	}
+	if change.HsnCode == nil {
+		return products, nil
+	}
+
+	matching := products[:0]
+	for _, product := range products {
+		if product.HsnCode != nil && *product.HsnCode == *change.HsnCode {
+			matching = append(matching, product)
+		}
+	}
+	return matching, nil
+}
+
+func (s *TaxRateService) DeleteTaxRateChange(ctx context.Context, id int) error {
+	change, err := s.taxRateRepo.GetTaxRateChangeByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get tax rate change", "error", err, "id", id)
+		return err
+	}
+	if change == nil {
+		return ErrTaxRateChangeNotFound
+	}
+	// Sales may already have been billed at the new rate.
+	if !change.EffectiveFrom.After(time.Now()) {
+		return ErrTaxRateChangeInEffect
+	}
+
+	if err := s.taxRateRepo.DeleteTaxRateChange(ctx, id); err != nil {
+		s.logger.Debugw("Failed to delete tax rate change", "error", err, "id", id)
+		return err
+	}
+	return nil
+}
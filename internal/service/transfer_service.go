@@ -0,0 +1,444 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/nitinjangam/pos-receipt-system/api/v1"
+	"github.com/nitinjangam/pos-receipt-system/internal/receipt"
+	"github.com/nitinjangam/pos-receipt-system/internal/repository"
+	"github.com/nitinjangam/pos-receipt-system/internal/uom"
+	"go.uber.org/zap"
+)
+
+type TransferServiceInterface interface {
+	GetTransfers(ctx context.Context, params v1.GetTransfersParams) ([]v1.Transfer, error)
+	GetTransfer(ctx context.Context, id int) (v1.Transfer, error)
+	PostTransfer(ctx context.Context, transfer v1.Transfer) (v1.Transfer, error)
+	DispatchTransfer(ctx context.Context, id int) (v1.Transfer, error)
+	ReceiveTransfer(ctx context.Context, id int, request v1.TransferReceipt) (v1.Transfer, error)
+	CancelTransfer(ctx context.Context, id int) (v1.Transfer, error)
+	GetTransferChallan(ctx context.Context, id int) ([]byte, error)
+}
+
+type TransferService struct {
+	transferRepo    *repository.TransferRepository
+	storeRepo       *repository.StoreRepository
+	productRepo     *repository.ProductRepository
+	inventoryRepo   *repository.InventoryRepository
+	settingsService SettingsServiceInterface
+	logger          *zap.SugaredLogger
+}
+
+func NewTransferService(transferRepository *repository.TransferRepository, storeRepository *repository.StoreRepository,
+	productRepository *repository.ProductRepository, inventoryRepository *repository.InventoryRepository, settingsService SettingsServiceInterface,
+	logger *zap.SugaredLogger) *TransferService {
+	return &TransferService{
+		transferRepo:    transferRepository,
+		storeRepo:       storeRepository,
+		productRepo:     productRepository,
+		inventoryRepo:   inventoryRepository,
+		settingsService: settingsService,
+		logger:          logger,
+	}
+}
+
+func (s *TransferService) GetTransfers(ctx context.Context, params v1.GetTransfersParams) ([]v1.Transfer, error) {
+	transfers, err := s.transferRepo.GetTransfers(ctx, params)
+	if err != nil {
+		s.logger.Debugw("Failed to get transfers", "error", err)
+		return nil, err
+	}
+	for i := range transfers {
+		withTransferTotals(&transfers[i])
+	}
+	return transfers, nil
+}
+
+func (s *TransferService) GetTransfer(ctx context.Context, id int) (v1.Transfer, error) {
+	transfer, err := s.transferRepo.GetTransferByID(ctx, id)
+	if err != nil {
+		s.logger.Debugw("Failed to get transfer by ID", "error", err, "transfer_id", id)
+		return v1.Transfer{}, err
+	}
+	if transfer == nil {
+		return v1.Transfer{}, ErrTransferNotFound
+	}
+	withTransferTotals(transfer)
+	return *transfer, nil
+}
+
+// PostTransfer records a transfer to or from another store. An outbound
+// transfer starts as a draft and is costed when it is dispatched. An inbound
+// transfer is entered from the sending store's challan, with the cost of each
+// line and, for batch-tracked products, the batches it lists, and is in
+// transit until received. Bundles and products sold through their variants
+// keep no stock of their own and cannot be transferred.
+func (s *TransferService) PostTransfer(ctx context.Context, transfer v1.Transfer) (v1.Transfer, error) {
+	status := v1.TransferDraft
+	switch transfer.Direction {
+	case v1.Outbound:
+	case v1.Inbound:
+		status = v1.TransferInTransit
+	default:
+		return v1.Transfer{}, fmt.Errorf("%w: unknown direction %q", ErrInvalidTransfer, transfer.Direction)
+	}
+	transfer.Status = &status
+
+	store, err := s.storeRepo.GetStoreByID(ctx, transfer.StoreId)
+	if err != nil {
+		s.logger.Debugw("Failed to get store by ID", "error", err, "store_id", transfer.StoreId)
+		return v1.Transfer{}, err
+	}
+	if store == nil {
+		return v1.Transfer{}, fmt.Errorf("%w: store %d not found", ErrInvalidTransfer, transfer.StoreId)
+	}
+
+	if len(transfer.Items) == 0 {
+		return v1.Transfer{}, fmt.Errorf("%w: at least one item is required", ErrInvalidTransfer)
+	}
+	seen := map[int]bool{}
+	for _, item := range transfer.Items {
+		if seen[item.ProductId] {
+			return v1.Transfer{}, fmt.Errorf("%w: product %d is listed more than once", ErrInvalidTransfer, item.ProductId)
+		}
+		seen[item.ProductId] = true
+
+		product, err := s.productRepo.GetProductByID(ctx, item.ProductId)
+		if err != nil {
+			s.logger.Debugw("Failed to get product by ID", "error", err, "product_id", item.ProductId)
+			return v1.Transfer{}, err
+		}
+		switch {
+		case product == nil:
+			return v1.Transfer{}, fmt.Errorf("%w: product %d not found", ErrInvalidTransfer, item.ProductId)
+		case hasVariants(*product):
+			return v1.Transfer{}, fmt.Errorf("%w: product %d is stocked through its variants", ErrInvalidTransfer, item.ProductId)
+		case isBundle(*product):
+			return v1.Transfer{}, fmt.Errorf("%w: product %d is a bundle, transfer its components", ErrInvalidTransfer, item.ProductId)
+		case item.Quantity <= 0:
+			return v1.Transfer{}, fmt.Errorf("%w: product %d: quantity must be greater than zero", ErrInvalidTransfer, item.ProductId)
+		case transfer.Direction == v1.Outbound && item.UnitCost != nil:
+			return v1.Transfer{}, fmt.Errorf("%w: product %d: an outbound transfer is costed from stock when dispatched", ErrInvalidTransfer,
+				item.ProductId)
+		case transfer.Direction == v1.Inbound && item.UnitCost == nil:
+			return v1.Transfer{}, fmt.Errorf("%w: product %d: unitCost from the sending store's challan is required", ErrInvalidTransfer,
+				item.ProductId)
+		case valueOrZero(item.UnitCost) < 0:
+			return v1.Transfer{}, fmt.Errorf("%w: product %d: unitCost cannot be negative", ErrInvalidTransfer, item.ProductId)
+		}
+		if err := uom.Check(item.Quantity, string(valueOrZero(product.Unit))); err != nil {
+			return v1.Transfer{}, fmt.Errorf("%w: product %d: %v", ErrInvalidTransfer, item.ProductId, err)
+		}
+		if err := s.checkTransferBatches(ctx, transfer.Direction, *product, item); err != nil {
+			return v1.Transfer{}, err
+		}
+	}
+	transfer.Reference = trimmedOrNil(transfer.Reference)
+	transfer.Note = trimmedOrNil(transfer.Note)
+
+	id, err := s.transferRepo.CreateTransfer(ctx, transfer)
+	if err != nil {
+		s.logger.Debugw("Failed to create transfer", "error", err)
+		return v1.Transfer{}, err
+	}
+
+	s.logger.Infow("Transfer created", "transfer_id", id, "direction", transfer.Direction, "store_id", transfer.StoreId)
+	return s.GetTransfer(ctx, id)
+}
+
+// DispatchTransfer takes the goods of a draft outbound transfer out of stock
+// at cost and puts them in transit. Unless negative stock is allowed, every
+// line must be covered by the stock on hand. Batch-tracked products are taken
+// from their batches as sales are, first to expire first.
+func (s *TransferService) DispatchTransfer(ctx context.Context, id int) (v1.Transfer, error) {
+	transfer, err := s.GetTransfer(ctx, id)
+	if err != nil {
+		return v1.Transfer{}, err
+	}
+	if transfer.Direction != v1.Outbound {
+		return v1.Transfer{}, fmt.Errorf("%w: transfer %d is inbound and was dispatched by the sending store", ErrTransferConflict, id)
+	}
+	if status := valueOrZero(transfer.Status); status != v1.TransferDraft {
+		return v1.Transfer{}, fmt.Errorf("%w: transfer %d is %s, only drafts can be dispatched", ErrTransferConflict, id, status)
+	}
+
+	settings, err := s.settingsService.GetSettings(ctx)
+	if err != nil {
+		return v1.Transfer{}, err
+	}
+	allowNegative := valueOrZero(settings.AllowNegativeStock)
+	allocator := newBatchAllocator(s.inventoryRepo)
+	batches := map[int][]v1.TransferItemBatch{}
+	for _, item := range transfer.Items {
+		product, err := s.productRepo.GetProductByID(ctx, item.ProductId)
+		if err != nil {
+			s.logger.Debugw("Failed to get product by ID", "error", err, "product_id", item.ProductId)
+			return v1.Transfer{}, err
+		}
+		unit := string(valueOrZero(item.Unit))
+		onHand := valueOrZero(product.StockOnHand)
+		if !allowNegative && item.Quantity > onHand {
+			return v1.Transfer{}, fmt.Errorf("%w: product %d has %s on hand, %s to transfer", ErrInsufficientStock, item.ProductId,
+				uom.Format(onHand, unit), uom.Format(item.Quantity, unit))
+		}
+		if !valueOrZero(product.BatchTracked) {
+			continue
+		}
+		taken, err := allocator.allocate(ctx, item.ProductId, item.Quantity, onHand, unit)
+		if err != nil {
+			return v1.Transfer{}, err
+		}
+		for _, batch := range taken {
+			batches[item.ProductId] = append(batches[item.ProductId], v1.TransferItemBatch{
+				BatchId:   batch.Id,
+				BatchNo:   valueOrZero(batch.BatchNo),
+				ExpiresOn: valueOrZero(batch.ExpiresOn),
+				Mrp:       batch.Mrp,
+				Quantity:  valueOrZero(batch.Quantity),
+			})
+		}
+	}
+
+	// The check above is repeated as the stock is taken out, for goods moved
+	// out at the same time.
+	if err := s.transferRepo.DispatchTransfer(ctx, id, batches, valueOrZero(settings.ValuationMethod), allowNegative); err != nil {
+		if errors.Is(err, repository.ErrStatusChanged) {
+			return v1.Transfer{}, fmt.Errorf("%w: transfer %d was changed by another request", ErrTransferConflict, id)
+		}
+		s.logger.Debugw("Failed to dispatch transfer", "error", err, "transfer_id", id)
+		return v1.Transfer{}, err
+	}
+
+	transfer, err = s.GetTransfer(ctx, id)
+	if err != nil {
+		return v1.Transfer{}, err
+	}
+	s.logger.Infow("Transfer dispatched", "transfer_id", id, "value", valueOrZero(transfer.Value))
+	return transfer, nil
+}
+
+// ReceiveTransfer records what the receiving store took in of a transfer in
+// transit; lines not listed were received in full. An inbound transfer
+// brings the goods received into stock at the cost they were dispatched at.
+// Goods short on an outbound transfer have already left stock, so the
+// discrepancy is their loss in transit.
+func (s *TransferService) ReceiveTransfer(ctx context.Context, id int, request v1.TransferReceipt) (v1.Transfer, error) {
+	transfer, err := s.GetTransfer(ctx, id)
+	if err != nil {
+		return v1.Transfer{}, err
+	}
+	if status := valueOrZero(transfer.Status); status != v1.TransferInTransit {
+		return v1.Transfer{}, fmt.Errorf("%w: transfer %d is %s, not in transit", ErrTransferConflict, id, status)
+	}
+
+	lines := map[int]*v1.TransferItem{}
+	for i := range transfer.Items {
+		item := &transfer.Items[i]
+		item.ReceivedQuantity = &item.Quantity
+		lines[item.ProductId] = item
+	}
+	seen := map[int]bool{}
+	for _, received := range valueOrZero(request.Items) {
+		item, ok := lines[received.ProductId]
+		switch {
+		case !ok:
+			return v1.Transfer{}, fmt.Errorf("%w: product %d is not on transfer %d", ErrInvalidTransfer, received.ProductId, id)
+		case seen[received.ProductId]:
+			return v1.Transfer{}, fmt.Errorf("%w: product %d is listed more than once", ErrInvalidTransfer, received.ProductId)
+		case received.ReceivedQuantity < 0:
+			return v1.Transfer{}, fmt.Errorf("%w: product %d: receivedQuantity cannot be negative", ErrInvalidTransfer, received.ProductId)
+		}
+		seen[received.ProductId] = true
+		if err := uom.Check(received.ReceivedQuantity, string(valueOrZero(item.Unit))); err != nil {
+			return v1.Transfer{}, fmt.Errorf("%w: product %d: %v", ErrInvalidTransfer, received.ProductId, err)
+		}
+		quantity := received.ReceivedQuantity
+		item.ReceivedQuantity = &quantity
+	}
+
+	if err := s.transferRepo.ReceiveTransfer(ctx, transfer, trimmedOrNil(request.Note)); err != nil {
+		if errors.Is(err, repository.ErrStatusChanged) {
+			return v1.Transfer{}, fmt.Errorf("%w: transfer %d was changed by another request", ErrTransferConflict, id)
+		}
+		s.logger.Debugw("Failed to receive transfer", "error", err, "transfer_id", id)
+		return v1.Transfer{}, err
+	}
+
+	transfer, err = s.GetTransfer(ctx, id)
+	if err != nil {
+		return v1.Transfer{}, err
+	}
+	s.logger.Infow("Transfer received", "transfer_id", id, "direction", transfer.Direction,
+		"discrepancy_value", valueOrZero(transfer.DiscrepancyValue))
+	return transfer, nil
+}
+
+// CancelTransfer cancels a draft, or an inbound transfer that has not been
+// received, neither of which has moved any stock here. Goods dispatched on an
+// outbound transfer can only be received.
+func (s *TransferService) CancelTransfer(ctx context.Context, id int) (v1.Transfer, error) {
+	transfer, err := s.GetTransfer(ctx, id)
+	if err != nil {
+		return v1.Transfer{}, err
+	}
+	status := valueOrZero(transfer.Status)
+	switch {
+	case status == v1.TransferInTransit && transfer.Direction == v1.Outbound:
+		return v1.Transfer{}, fmt.Errorf("%w: transfer %d has been dispatched", ErrTransferConflict, id)
+	case status != v1.TransferDraft && status != v1.TransferInTransit:
+		return v1.Transfer{}, fmt.Errorf("%w: transfer %d is %s", ErrTransferConflict, id, status)
+	}
+
+	if err := s.transferRepo.CancelTransfer(ctx, id, status); err != nil {
+		if errors.Is(err, repository.ErrStatusChanged) {
+			return v1.Transfer{}, fmt.Errorf("%w: transfer %d was changed by another request", ErrTransferConflict, id)
+		}
+		s.logger.Debugw("Failed to cancel transfer", "error", err, "transfer_id", id)
+		return v1.Transfer{}, err
+	}
+
+	s.logger.Infow("Transfer cancelled", "transfer_id", id)
+	return s.GetTransfer(ctx, id)
+}
+
+// GetTransferChallan renders the delivery challan of a dispatched outbound
+// transfer as a PDF, from the business's settings to the receiving store.
+func (s *TransferService) GetTransferChallan(ctx context.Context, id int) ([]byte, error) {
+	transfer, err := s.GetTransfer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.Direction != v1.Outbound {
+		return nil, fmt.Errorf("%w: transfer %d is inbound, its challan was issued by the sending store", ErrTransferConflict, id)
+	}
+	if transfer.DispatchedAt == nil {
+		return nil, fmt.Errorf("%w: transfer %d has not been dispatched", ErrTransferConflict, id)
+	}
+
+	settings, err := s.settingsService.GetSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	store, err := s.storeRepo.GetStoreByID(ctx, transfer.StoreId)
+	if err != nil {
+		s.logger.Debugw("Failed to get store by ID", "error", err, "store_id", transfer.StoreId)
+		return nil, err
+	}
+	if store == nil {
+		return nil, fmt.Errorf("store %d of transfer %d not found", transfer.StoreId, id)
+	}
+
+	var buf bytes.Buffer
+	if err := receipt.RenderChallan(&buf, transfer, settings, *store); err != nil {
+		s.logger.Debugw("Failed to render challan", "error", err, "transfer_id", id)
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// checkTransferBatches checks the batches listed on a line of a transfer.
+// The goods of an inbound transfer of a batch-tracked product must be listed
+// by batch, adding up to the line, as the sending store's challan has them,
+// and a batch already here must expire on the same date. An outbound
+// transfer is allocated its batches when it is dispatched.
+func (s *TransferService) checkTransferBatches(ctx context.Context, direction v1.TransferDirection, product v1.Product,
+	item v1.TransferItem) error {
+	batches := valueOrZero(item.Batches)
+	batchTracked := valueOrZero(product.BatchTracked)
+	switch {
+	case len(batches) > 0 && direction == v1.Outbound:
+		return fmt.Errorf("%w: product %d: the batches of an outbound transfer are allocated when it is dispatched", ErrInvalidTransfer,
+			item.ProductId)
+	case len(batches) > 0 && !batchTracked:
+		return fmt.Errorf("%w: product %d is not batch tracked", ErrInvalidTransfer, item.ProductId)
+	case direction == v1.Outbound || !batchTracked:
+		return nil
+	case len(batches) == 0:
+		return fmt.Errorf("%w: product %d is batch tracked, the batches from the sending store's challan are required", ErrInvalidTransfer,
+			item.ProductId)
+	}
+
+	unit := string(valueOrZero(product.Unit))
+	total := 0.0
+	seen := map[string]bool{}
+	for _, batch := range batches {
+		switch {
+		case strings.TrimSpace(batch.BatchNo) == "":
+			return fmt.Errorf("%w: product %d: batchNo is required", ErrInvalidTransfer, item.ProductId)
+		case seen[batch.BatchNo]:
+			return fmt.Errorf("%w: product %d: batch %s is listed more than once", ErrInvalidTransfer, item.ProductId, batch.BatchNo)
+		case batch.Quantity <= 0:
+			return fmt.Errorf("%w: product %d: batch %s: quantity must be greater than zero", ErrInvalidTransfer, item.ProductId, batch.BatchNo)
+		}
+		seen[batch.BatchNo] = true
+		if err := uom.Check(batch.Quantity, unit); err != nil {
+			return fmt.Errorf("%w: product %d: batch %s: %v", ErrInvalidTransfer, item.ProductId, batch.BatchNo, err)
+		}
+
+		existing, err := s.inventoryRepo.GetBatchByNumber(ctx, item.ProductId, batch.BatchNo)
+		if err != nil {
+			s.logger.Debugw("Failed to get batch by number", "error", err, "product_id", item.ProductId, "batch_no", batch.BatchNo)
+			return err
+		}
+		if existing != nil && !existing.ExpiresOn.Time.Equal(batch.ExpiresOn.Time) {
+			return fmt.Errorf("%w: product %d: batch %s expires on %s", ErrInvalidTransfer, item.ProductId, batch.BatchNo,
+				existing.ExpiresOn.Format(time.DateOnly))
+		}
+		total = uom.Round(total+batch.Quantity, unit)
+	}
+	if total != item.Quantity {
+		return fmt.Errorf("%w: product %d: the batches add up to %s, not %s", ErrInvalidTransfer, item.ProductId, uom.Format(total, unit),
+			uom.Format(item.Quantity, unit))
+	}
+	return nil
+}
+
+// withTransferTotals fills in the value at cost of each costed line and its
+// discrepancy once received, and the totals of the transfer. A draft
+// outbound transfer has no cost yet and so no values.
+func withTransferTotals(transfer *v1.Transfer) {
+	var value, receivedValue, discrepancyValue float64
+	costed, received := false, false
+	for i := range transfer.Items {
+		item := &transfer.Items[i]
+		if item.UnitCost == nil {
+			continue
+		}
+		costed = true
+		unitCost := *item.UnitCost
+		item.Value = float64Ptr(round2(item.Quantity * unitCost))
+		value += *item.Value
+		if item.ReceivedQuantity == nil {
+			continue
+		}
+		received = true
+		discrepancy := uom.Round(*item.ReceivedQuantity-item.Quantity, string(valueOrZero(item.Unit)))
+		item.DiscrepancyQuantity = &discrepancy
+		item.DiscrepancyValue = float64Ptr(round2(discrepancy * unitCost))
+		receivedValue += round2(*item.ReceivedQuantity * unitCost)
+		discrepancyValue += *item.DiscrepancyValue
+	}
+	if costed {
+		transfer.Value = float64Ptr(round2(value))
+	}
+	if received {
+		transfer.ReceivedValue = float64Ptr(round2(receivedValue))
+		transfer.DiscrepancyValue = float64Ptr(round2(discrepancyValue))
+	}
+}
+
+// trimmedOrNil trims optional text, treating blank text as absent.
+func trimmedOrNil(text *string) *string {
+	if text == nil {
+		return nil
+	}
+	if trimmed := strings.TrimSpace(*text); trimmed != "" {
+		return &trimmed
+	}
+	return nil
+}
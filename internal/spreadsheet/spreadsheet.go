@@ -0,0 +1,92 @@
+// Package spreadsheet reads the rows of uploaded CSV and XLSX files and
+// writes rows as CSV, XLSX or JSON.
+package spreadsheet
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported file format")
+
+// Read returns the rows of a CSV or XLSX file, telling them apart by the
+// extension of the file name. sheet names the XLSX worksheet to read; the
+// first one is read when it is empty.
+func Read(filename string, r io.Reader, sheet string) ([][]string, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv", ".txt":
+		return readCSV(r)
+	case ".xlsx":
+		return readXLSX(r, sheet)
+	default:
+		return nil, fmt.Errorf("%w: %q, expected .csv or .xlsx", ErrUnsupportedFormat, filename)
+	}
+}
+
+// readCSV reads comma, semicolon or tab separated values, whichever the
+// first line uses most, as spreadsheets save CSV with the list separator of
+// their locale.
+func readCSV(r io.Reader) ([][]string, error) {
+	br := bufio.NewReader(r)
+	if bom, _ := br.Peek(3); bytes.Equal(bom, []byte("\xef\xbb\xbf")) {
+		br.Discard(3)
+	}
+	first, _ := br.Peek(br.Buffered())
+	if i := bytes.IndexByte(first, '\n'); i >= 0 {
+		first = first[:i]
+	}
+
+	reader := csv.NewReader(br)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	for _, separator := range []rune{';', '\t'} {
+		if bytes.Count(first, []byte(string(separator))) > bytes.Count(first, []byte(string(reader.Comma))) {
+			reader.Comma = separator
+		}
+	}
+
+	// The reader skips blank lines; they are put back as empty rows so that
+	// row numbers match the line a row starts on.
+	var rows [][]string
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		for len(rows) < line-1 {
+			rows = append(rows, nil)
+		}
+		rows = append(rows, record)
+	}
+}
+
+// readXLSX reads the raw cell values of the worksheet, so that numbers are
+// not rounded or grouped by the cell's number format.
+func readXLSX(r io.Reader, sheet string) ([][]string, error) {
+	f, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+	}
+	defer f.Close()
+
+	if sheet == "" {
+		sheets := f.GetSheetList()
+		if len(sheets) == 0 {
+			return nil, nil
+		}
+		sheet = sheets[0]
+	}
+	return f.GetRows(sheet, excelize.Options{RawCellValue: true})
+}
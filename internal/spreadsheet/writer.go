@@ -0,0 +1,205 @@
+package spreadsheet
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Formats a Writer can write.
+const (
+	CSV  = "csv"
+	XLSX = "xlsx"
+	JSON = "json"
+)
+
+// flushEvery is the number of rows buffered before they are sent on.
+const flushEvery = 100
+
+// Writer writes rows of values, the first row being the column headers.
+// Values may be nil, strings, numbers, times or string slices, which are
+// joined by spaces except in JSON.
+type Writer interface {
+	WriteRow(values []any) error
+	// Close writes whatever is still buffered. The writer cannot be used
+	// afterwards.
+	Close() error
+}
+
+// NewWriter returns a writer of the given format.
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case CSV:
+		return &csvWriter{writer: csv.NewWriter(w)}, nil
+	case XLSX:
+		f := excelize.NewFile()
+		stream, err := f.NewStreamWriter(f.GetSheetName(0))
+		if err != nil {
+			return nil, err
+		}
+		return &xlsxWriter{file: f, stream: stream, w: w}, nil
+	case JSON:
+		return &jsonWriter{w: bufio.NewWriter(w)}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedFormat, format)
+	}
+}
+
+type csvWriter struct {
+	writer *csv.Writer
+	rows   int
+}
+
+func (c *csvWriter) WriteRow(values []any) error {
+	record := make([]string, len(values))
+	for i, value := range values {
+		record[i] = formatValue(value)
+	}
+	if err := c.writer.Write(record); err != nil {
+		return err
+	}
+	if c.rows++; c.rows%flushEvery == 0 {
+		c.writer.Flush()
+	}
+	return c.writer.Error()
+}
+
+func (c *csvWriter) Close() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+// xlsxWriter streams rows into a worksheet, which excelize keeps in a
+// temporary file once it grows large. The workbook is only written out on
+// Close, as the XLSX zip directory comes last.
+type xlsxWriter struct {
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	w      io.Writer
+	rows   int
+}
+
+func (x *xlsxWriter) WriteRow(values []any) error {
+	cells := make([]any, len(values))
+	for i, value := range values {
+		switch value := value.(type) {
+		case []string:
+			cells[i] = strings.Join(value, " ")
+		case time.Time:
+			cells[i] = value.UTC().Format(time.RFC3339)
+		default:
+			cells[i] = value
+		}
+	}
+	x.rows++
+	cell, err := excelize.CoordinatesToCellName(1, x.rows)
+	if err != nil {
+		return err
+	}
+	return x.stream.SetRow(cell, cells)
+}
+
+func (x *xlsxWriter) Close() error {
+	defer x.file.Close()
+	if err := x.stream.Flush(); err != nil {
+		return err
+	}
+	return x.file.Write(x.w)
+}
+
+// jsonWriter writes an array with one object per row, keyed by the headers
+// in column order.
+type jsonWriter struct {
+	w      *bufio.Writer
+	header []string
+	rows   int
+}
+
+func (j *jsonWriter) WriteRow(values []any) error {
+	if j.header == nil {
+		j.header = make([]string, len(values))
+		for i, value := range values {
+			j.header[i] = formatValue(value)
+		}
+		_, err := j.w.WriteString("[")
+		return err
+	}
+
+	if j.rows > 0 {
+		j.w.WriteString(",")
+	}
+	j.w.WriteString("\n{")
+	for i, value := range values {
+		if i > 0 {
+			j.w.WriteString(",")
+		}
+		key, _ := marshal(j.header[i])
+		encoded, err := marshal(value)
+		if err != nil {
+			return err
+		}
+		j.w.Write(key)
+		j.w.WriteString(":")
+		j.w.Write(encoded)
+	}
+	if _, err := j.w.WriteString("}"); err != nil {
+		return err
+	}
+	if j.rows++; j.rows%flushEvery == 0 {
+		return j.w.Flush()
+	}
+	return nil
+}
+
+func (j *jsonWriter) Close() error {
+	if j.header == nil {
+		j.w.WriteString("[")
+	}
+	if j.rows > 0 {
+		j.w.WriteString("\n")
+	}
+	j.w.WriteString("]\n")
+	return j.w.Flush()
+}
+
+// marshal encodes the value without escaping <, > and &, which are common
+// in category paths and product names.
+func marshal(value any) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// formatValue prints a value for a CSV cell.
+func formatValue(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case []string:
+		return strings.Join(value, " ")
+	case int:
+		return strconv.Itoa(value)
+	case float32:
+		return strconv.FormatFloat(float64(value), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case time.Time:
+		return value.UTC().Format(time.RFC3339)
+	default:
+		return fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,36 @@
+// Package storage keeps uploaded files, such as product images, behind a
+// backend-neutral interface.
+package storage
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+var (
+	ErrNotFound   = errors.New("file not found")
+	ErrInvalidKey = errors.New("invalid file key")
+)
+
+// Store saves files under flat keys such as product-1-9f8e.jpg and tells
+// clients where to fetch them from.
+type Store interface {
+	// Put saves the file, replacing any file with the same key.
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	// Get returns the file and its content type, or ErrNotFound.
+	Get(ctx context.Context, key string) ([]byte, string, error)
+	// Delete removes the file; removing a missing file is not an error.
+	Delete(ctx context.Context, key string) error
+	// URL returns the address clients load the file from.
+	URL(key string) string
+}
+
+// validKey rejects keys that are empty or could leave the store, e.g. with a
+// path separator or a leading dot.
+func validKey(key string) error {
+	if key == "" || strings.ContainsAny(key, `/\`) || strings.HasPrefix(key, ".") {
+		return ErrInvalidKey
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+// Package uom describes the units of measure products are sold in and the
+// precision quantities in each unit are kept to.
+package uom
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+var (
+	ErrUnknownUnit = errors.New("unknown unit of measure")
+	ErrPrecision   = errors.New("quantity has more decimals than the unit allows")
+)
+
+// Pieces is the unit of products that are counted rather than weighed or
+// measured, and the unit of products stored before units were introduced.
+const Pieces = "pcs"
+
+type unit struct {
+	decimals int
+	uqc      string // GST unit quantity code used on e-way bills and returns
+}
+
+var units = map[string]unit{
+	"pcs": {decimals: 0, uqc: "NOS"},
+	"kg":  {decimals: 3, uqc: "KGS"},
+	"g":   {decimals: 0, uqc: "GMS"},
+	"l":   {decimals: 3, uqc: "LTR"},
+	"ml":  {decimals: 0, uqc: "MLT"},
+	"m":   {decimals: 2, uqc: "MTR"},
+}
+
+// lookup returns the unit, treating an empty unit as pieces.
+func lookup(name string) (unit, error) {
+	if name == "" {
+		name = Pieces
+	}
+	u, ok := units[name]
+	if !ok {
+		return unit{}, fmt.Errorf("%w: %q", ErrUnknownUnit, name)
+	}
+	return u, nil
+}
+
+// Valid reports whether name is a known unit.
+func Valid(name string) bool {
+	_, err := lookup(name)
+	return err == nil
+}
+
+// Decimals returns the number of decimals quantities in the unit are kept
+// to. Unknown units are treated as pieces.
+func Decimals(name string) int {
+	u, err := lookup(name)
+	if err != nil {
+		return units[Pieces].decimals
+	}
+	return u.decimals
+}
+
+// Round rounds the quantity to the precision of the unit.
+func Round(quantity float64, name string) float64 {
+	scale := math.Pow10(Decimals(name))
+	return math.Round(quantity*scale) / scale
+}
+
+// Ceil rounds the quantity up to the precision of the unit, e.g. to whole
+// pieces when ordering.
+func Ceil(quantity float64, name string) float64 {
+	scale := math.Pow10(Decimals(name))
+	return math.Ceil(quantity*scale-1e-9) / scale
+}
+
+// Check makes sure the quantity has no more decimals than the unit allows,
+// e.g. that pieces are whole.
+func Check(quantity float64, name string) error {
+	if _, err := lookup(name); err != nil {
+		return err
+	}
+	if math.Abs(quantity-Round(quantity, name)) > 1e-9 {
+		return fmt.Errorf("%w: %s %s", ErrPrecision, strconv.FormatFloat(quantity, 'f', -1, 64), unitName(name))
+	}
+	return nil
+}
+
+// Format prints the quantity at the precision of the unit followed by the
+// unit, e.g. "1.250 kg" or "2 pcs".
+func Format(quantity float64, name string) string {
+	return strconv.FormatFloat(Round(quantity, name), 'f', Decimals(name), 64) + " " + unitName(name)
+}
+
+// UQC returns the GST unit quantity code of the unit.
+func UQC(name string) string {
+	u, err := lookup(name)
+	if err != nil {
+		return units[Pieces].uqc
+	}
+	return u.uqc
+}
+
+func unitName(name string) string {
+	if name == "" {
+		return Pieces
+	}
+	return name
+}